@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"net/http"
@@ -8,6 +9,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
@@ -19,23 +21,57 @@ import (
 type Client interface {
 	// SetDebugLogger when set will output the responses of requests to the
 	// logger
+	//
+	// Deprecated: use SetStructuredLogger instead
 	SetDebugLogger(logger Logger) Client
 	// SetInfoLogger when set will output which requests and params are used to
 	// the logger
+	//
+	// Deprecated: use SetStructuredLogger instead
 	SetInfoLogger(logger Logger) Client
+	// SetStructuredLogger when set will output the requests, its status and
+	// latency as fields, and responses on debug level
+	SetStructuredLogger(StructuredLogger) Client
+	// SetRetryPolicy changes how requests are retried when rate limited or
+	// the server is unavailable
+	SetRetryPolicy(RetryPolicy) Client
+	// SetRateLimit limits how many requests per second will be sent, zero
+	// disables the limit
+	SetRateLimit(perSecond int) Client
+	// SetRequestTimeout limits how long each request can take, zero disables
+	// the limit
+	SetRequestTimeout(time.Duration) Client
+	// WithContext returns a copy of the client which sends its requests with
+	// the context, so they can be cancelled or have a deadline
+	WithContext(context.Context) Client
+	// SetConcurrency sets how many pages are fetched at the same time when
+	// all pages are requested, values lower than 2 fetch one page at a time
+	SetConcurrency(pages int) Client
 
 	GetWorkspace(GetWorkspace) (dto.Workspace, error)
 	GetWorkspaces(GetWorkspaces) ([]dto.Workspace, error)
+	// GetWorkspaceCustomFields get the definitions of the custom fields of
+	// a workspace
+	GetWorkspaceCustomFields(GetWorkspaceCustomFieldsParam) (
+		[]dto.WorkspaceCustomField, error)
 
 	GetMe() (dto.User, error)
 	GetUser(GetUser) (dto.User, error)
 	WorkspaceUsers(WorkspaceUsersParam) ([]dto.User, error)
+	// GetUserGroups get the user groups of a workspace
+	GetUserGroups(GetUserGroupsParam) ([]dto.UserGroup, error)
 
 	AddClient(AddClientParam) (dto.Client, error)
 	GetClients(GetClientsParam) ([]dto.Client, error)
+	GetClient(GetClientParam) (dto.Client, error)
+	UpdateClient(UpdateClientParam) (dto.Client, error)
+	DeleteClient(DeleteClientParam) (dto.Client, error)
 
 	// GetProjects get all project of a workspace
 	GetProjects(GetProjectsParam) ([]dto.Project, error)
+	// GetProjectsByPage works like GetProjects, but calls fn with each page
+	// of projects as soon as it is loaded, in order
+	GetProjectsByPage(GetProjectsParam, func([]dto.Project) error) error
 	// GetProject get a single Project, if exists
 	GetProject(GetProjectParam) (*dto.Project, error)
 	// AddProject creates a new project
@@ -67,6 +103,9 @@ type Client interface {
 
 	GetTag(GetTagParam) (*dto.Tag, error)
 	GetTags(GetTagsParam) ([]dto.Tag, error)
+	AddTag(AddTagParam) (dto.Tag, error)
+	UpdateTag(UpdateTagParam) (dto.Tag, error)
+	DeleteTag(DeleteTagParam) (dto.Tag, error)
 
 	ChangeInvoiced(ChangeInvoicedParam) error
 	CreateTimeEntry(CreateTimeEntryParam) (dto.TimeEntryImpl, error)
@@ -79,6 +118,9 @@ type Client interface {
 	GetUsersHydratedTimeEntries(GetUserTimeEntriesParam) ([]dto.TimeEntry, error)
 	Log(LogParam) ([]dto.TimeEntry, error)
 	LogRange(LogRangeParam) ([]dto.TimeEntry, error)
+	// LogRangeByPage works like LogRange, but calls fn with each page of
+	// time entries as soon as it is loaded, newest first
+	LogRangeByPage(LogRangeParam, func([]dto.TimeEntry) error) error
 	UpdateTimeEntry(UpdateTimeEntryParam) (dto.TimeEntryImpl, error)
 	Out(OutParam) error
 }
@@ -86,8 +128,12 @@ type Client interface {
 type client struct {
 	baseURL *url.URL
 	http.Client
-	debugLogger Logger
-	infoLogger  Logger
+	logger      StructuredLogger
+	printf      printfLogger
+	retryPolicy RetryPolicy
+	limiter     *rateLimiter
+	ctx         context.Context
+	concurrency int
 }
 
 // baseURL is the Clockify API base URL
@@ -124,6 +170,9 @@ func NewClientFromUrlAndKey(
 				next:   http.DefaultTransport,
 			},
 		},
+		retryPolicy: DefaultRetryPolicy,
+		ctx:         context.Background(),
+		concurrency: 1,
 	}, nil
 }
 
@@ -171,6 +220,42 @@ func (c *client) GetWorkspaces(f GetWorkspaces) ([]dto.Workspace, error) {
 	return ws, nil
 }
 
+// GetWorkspaceCustomFieldsParam params to get the custom fields of a
+// workspace
+type GetWorkspaceCustomFieldsParam struct {
+	Workspace  string
+	Name       string
+	Status     dto.CustomFieldStatus
+	EntityType string
+}
+
+// GetWorkspaceCustomFields get the definitions of the custom fields of a
+// workspace
+func (c *client) GetWorkspaceCustomFields(p GetWorkspaceCustomFieldsParam) (
+	cfs []dto.WorkspaceCustomField, err error) {
+	defer wrapError(&err, "get custom fields")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return cfs, err
+	}
+
+	r, err := c.NewRequest(
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/custom-fields",
+		dto.GetCustomFieldsRequest{
+			Name:       p.Name,
+			Status:     p.Status,
+			EntityType: p.EntityType,
+		},
+	)
+	if err != nil {
+		return cfs, err
+	}
+
+	_, err = c.Do(r, &cfs, "GetWorkspaceCustomFields")
+	return cfs, err
+}
+
 type field string
 
 const (
@@ -181,6 +266,8 @@ const (
 	timeEntryIDField    = field("time entry id")
 	nameField           = field("name")
 	taskIDField         = field("task id")
+	tagIDField          = field("tag id")
+	clientIDField       = field("client id")
 	estimateMethodField = field("estimate method")
 	estimateTypeField   = field("estimate type")
 	resetOptionField    = field("reset option")
@@ -353,7 +440,7 @@ type LogParam struct {
 
 // Log list time entries from a date
 func (c *client) Log(p LogParam) ([]dto.TimeEntry, error) {
-	c.infof("Log - Date Param: %s", p.Date)
+	c.log(LogInfo, "log", Fields{"date": p.Date})
 
 	d := p.Date.Round(time.Hour)
 	d = d.Add(time.Hour * time.Duration(d.Hour()) * -1)
@@ -381,9 +468,24 @@ type LogRangeParam struct {
 
 // LogRange list time entries by date range
 func (c *client) LogRange(p LogRangeParam) ([]dto.TimeEntry, error) {
-	c.infof("LogRange - First Date Param: %s | Last Date Param: %s", p.FirstDate, p.LastDate)
+	var timeEntries []dto.TimeEntry
+	err := c.LogRangeByPage(p, func(tes []dto.TimeEntry) error {
+		timeEntries = append(timeEntries, tes...)
+		return nil
+	})
+
+	return timeEntries, err
+}
+
+// LogRangeByPage list time entries by date range, calling fn with each page
+func (c *client) LogRangeByPage(
+	p LogRangeParam, fn func([]dto.TimeEntry) error) error {
+	c.log(LogInfo, "log range", Fields{
+		"first_date": p.FirstDate,
+		"last_date":  p.LastDate,
+	})
 
-	return c.GetUsersHydratedTimeEntries(GetUserTimeEntriesParam{
+	return c.getUsersHydratedTimeEntriesByPage(GetUserTimeEntriesParam{
 		Workspace:       p.Workspace,
 		UserID:          p.UserID,
 		Start:           &p.FirstDate,
@@ -392,7 +494,7 @@ func (c *client) LogRange(p LogRangeParam) ([]dto.TimeEntry, error) {
 		ProjectID:       p.ProjectID,
 		TagIDs:          p.TagIDs,
 		PaginationParam: p.PaginationParam,
-	})
+	}, fn)
 }
 
 type GetUserTimeEntriesParam struct {
@@ -429,32 +531,37 @@ func (c *client) GetUserTimeEntries(p GetUserTimeEntriesParam) ([]dto.TimeEntryI
 // GetUsersHydratedTimeEntries will list hydrated time entries of a user on a workspace, can be paginated
 func (c *client) GetUsersHydratedTimeEntries(p GetUserTimeEntriesParam) ([]dto.TimeEntry, error) {
 	var timeEntries []dto.TimeEntry
-	var tes []dto.TimeEntry
-
-	err := c.getUserTimeEntriesImpl(p, true, &tes, func(res interface{}) (int, error) {
-		if res == nil {
-			return 0, nil
-		}
-
-		tes := res.(*[]dto.TimeEntry)
-		timeEntries = append(timeEntries, *tes...)
-		return len(*tes), nil
-	})
+	err := c.getUsersHydratedTimeEntriesByPage(p,
+		func(tes []dto.TimeEntry) error {
+			timeEntries = append(timeEntries, tes...)
+			return nil
+		})
 
-	if err != nil {
-		return timeEntries, err
-	}
+	return timeEntries, err
+}
 
+// getUsersHydratedTimeEntriesByPage loads the user before the time entries,
+// so each page can be sent to fn complete
+func (c *client) getUsersHydratedTimeEntriesByPage(
+	p GetUserTimeEntriesParam, fn func([]dto.TimeEntry) error) error {
 	user, err := c.GetUser(GetUser{p.Workspace, p.UserID})
 	if err != nil {
-		return timeEntries, err
+		return err
 	}
 
-	for i := 0; i < len(timeEntries); i++ {
-		timeEntries[i].User = &user
-	}
+	var tes []dto.TimeEntry
+	return c.getUserTimeEntriesImpl(p, true, &tes, func(res interface{}) (int, error) {
+		if res == nil {
+			return 0, nil
+		}
 
-	return timeEntries, err
+		tes := *res.(*[]dto.TimeEntry)
+		for i := range tes {
+			tes[i].User = &user
+		}
+
+		return len(tes), fn(tes)
+	})
 }
 
 func (c *client) getUserTimeEntriesImpl(
@@ -487,15 +594,13 @@ func (c *client) getUserTimeEntriesImpl(
 		}
 	}
 
-	c.infof(
-		"GetUserTimeEntries - Workspace: %s | User: %s | In Progress: %s "+
-			"| Description: %s | Project: %s",
-		p.Workspace,
-		p.UserID,
-		inProgressFilter,
-		p.Description,
-		p.ProjectID,
-	)
+	c.log(LogInfo, "get user time entries", Fields{
+		"workspace":   p.Workspace,
+		"user":        p.UserID,
+		"in_progress": inProgressFilter,
+		"description": p.Description,
+		"project":     p.ProjectID,
+	})
 
 	r := dto.UserTimeEntriesRequest{
 		OnlyInProgress: p.OnlyInProgress,
@@ -530,6 +635,18 @@ func (c *client) getUserTimeEntriesImpl(
 	return err
 }
 
+// SetConcurrency sets how many pages are fetched at the same time
+func (c *client) SetConcurrency(pages int) Client {
+	if pages < 1 {
+		pages = 1
+	}
+
+	c.concurrency = pages
+	return c
+}
+
+// paginate fetches the pages sending them to the reducer in order, when all
+// pages are requested the ones after the first are fetched concurrently
 func (c *client) paginate(
 	method, uri string,
 	p PaginationParam,
@@ -547,32 +664,75 @@ func (c *client) paginate(
 		p.PageSize = 50
 	}
 
-	stop := false
-	for !stop {
-		r, err := c.NewRequest(
-			method,
-			uri,
-			request.WithPagination(page, p.PageSize),
-		)
-		if err != nil {
-			return err
-		}
+	// the first page is fetched alone, most lists fit on it
+	batch := 1
+	for {
+		responses, err := c.fetchPages(
+			method, uri, request, bodyTempl, name, page, batch, p.PageSize)
 
-		response := reflect.New(reflect.TypeOf(bodyTempl).Elem()).Interface()
-		_, err = c.Do(r, &response, name)
-		if err != nil {
-			return err
+		for _, response := range responses {
+			count, err := reducer(response)
+			if err != nil {
+				return err
+			}
+
+			if count < p.PageSize || !p.AllPages {
+				return nil
+			}
 		}
 
-		count, err := reducer(response)
 		if err != nil {
 			return err
 		}
 
-		stop = count < p.PageSize || !p.AllPages
-		page++
+		page += batch
+		batch = c.concurrency
 	}
-	return nil
+}
+
+// fetchPages requests the pages from first to first+count-1 at the same
+// time, returning the responses in order until the first one that failed
+func (c *client) fetchPages(
+	method, uri string,
+	request dto.PaginatedRequest,
+	bodyTempl interface{},
+	name string,
+	first, count, pageSize int,
+) ([]interface{}, error) {
+	responses := make([]interface{}, count)
+	errs := make([]error, count)
+
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			r, err := c.NewRequest(
+				method,
+				uri,
+				request.WithPagination(first+i, pageSize),
+			)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			response := reflect.New(reflect.TypeOf(bodyTempl).Elem()).Interface()
+			if _, errs[i] = c.Do(r, &response, name); errs[i] == nil {
+				responses[i] = response
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range errs {
+		if errs[i] != nil {
+			return responses[:i], errs[i]
+		}
+	}
+
+	return responses, nil
 }
 
 // GetTimeEntryInProgressParam params to query entries
@@ -706,7 +866,8 @@ type GetTagParam struct {
 // GetTag get a single tag, if it exists
 func (c *client) GetTag(p GetTagParam) (*dto.Tag, error) {
 	tags, err := c.GetTags(GetTagsParam{
-		Workspace: p.Workspace,
+		Workspace:       p.Workspace,
+		PaginationParam: AllPages(),
 	})
 
 	if err != nil {
@@ -937,13 +1098,14 @@ const (
 
 // AddTaskParam param to add tasks to a project
 type AddTaskParam struct {
-	Workspace   string
-	ProjectID   string
-	Name        string
-	AssigneeIDs *[]string
-	Estimate    *time.Duration
-	Status      TaskStatus
-	Billable    *bool
+	Workspace    string
+	ProjectID    string
+	Name         string
+	AssigneeIDs  *[]string
+	UserGroupIDs *[]string
+	Estimate     *time.Duration
+	Status       TaskStatus
+	Billable     *bool
 }
 
 func (c *client) AddTask(p AddTaskParam) (task dto.Task, err error) {
@@ -965,9 +1127,10 @@ func (c *client) AddTask(p AddTaskParam) (task dto.Task, err error) {
 	}
 
 	r := dto.AddTaskRequest{
-		Name:        p.Name,
-		AssigneeIDs: p.AssigneeIDs,
-		Billable:    p.Billable,
+		Name:         p.Name,
+		AssigneeIDs:  p.AssigneeIDs,
+		UserGroupIDs: p.UserGroupIDs,
+		Billable:     p.Billable,
 	}
 
 	if p.Status != TaskStatus("") {
@@ -1000,14 +1163,15 @@ func (c *client) AddTask(p AddTaskParam) (task dto.Task, err error) {
 
 // UpdateTaskParam param to update tasks to a project
 type UpdateTaskParam struct {
-	Workspace   string
-	ProjectID   string
-	TaskID      string
-	Name        string
-	AssigneeIDs *[]string
-	Estimate    *time.Duration
-	Status      TaskStatus
-	Billable    *bool
+	Workspace    string
+	ProjectID    string
+	TaskID       string
+	Name         string
+	AssigneeIDs  *[]string
+	UserGroupIDs *[]string
+	Estimate     *time.Duration
+	Status       TaskStatus
+	Billable     *bool
 }
 
 func (c *client) UpdateTask(p UpdateTaskParam) (task dto.Task, err error) {
@@ -1031,9 +1195,10 @@ func (c *client) UpdateTask(p UpdateTaskParam) (task dto.Task, err error) {
 	}
 
 	r := dto.UpdateTaskRequest{
-		Name:        p.Name,
-		AssigneeIDs: p.AssigneeIDs,
-		Billable:    p.Billable,
+		Name:         p.Name,
+		AssigneeIDs:  p.AssigneeIDs,
+		UserGroupIDs: p.UserGroupIDs,
+		Billable:     p.Billable,
 	}
 
 	if p.Status != TaskStatus("") {
@@ -1118,6 +1283,9 @@ type CreateTimeEntryParam struct {
 	ProjectID   string
 	TaskID      string
 	TagIDs      []string
+	// CustomFields values to set on the time entry, only the id and value
+	// are sent
+	CustomFields []dto.CustomFieldValue
 }
 
 // CreateTimeEntry create a new time entry
@@ -1141,13 +1309,14 @@ func (c *client) CreateTimeEntry(p CreateTimeEntryParam) (
 			p.Workspace,
 		),
 		dto.CreateTimeEntryRequest{
-			Start:       dto.DateTime{Time: p.Start},
-			End:         end,
-			Billable:    p.Billable,
-			Description: p.Description,
-			ProjectID:   p.ProjectID,
-			TaskID:      p.TaskID,
-			TagIDs:      p.TagIDs,
+			Start:        dto.DateTime{Time: p.Start},
+			End:          end,
+			Billable:     p.Billable,
+			Description:  p.Description,
+			ProjectID:    p.ProjectID,
+			TaskID:       p.TaskID,
+			TagIDs:       p.TagIDs,
+			CustomFields: customFieldValues(p.CustomFields),
 		},
 	)
 
@@ -1202,6 +1371,137 @@ func (c *client) GetTags(p GetTagsParam) (ps []dto.Tag, err error) {
 	return ps, err
 }
 
+// AddTagParam param to add tags
+type AddTagParam struct {
+	Workspace string
+	Name      string
+}
+
+// AddTag adds a new tag to a workspace
+func (c *client) AddTag(p AddTagParam) (tag dto.Tag, err error) {
+	defer wrapError(&err, "add tag")
+
+	if err = required(map[field]string{
+		nameField:      p.Name,
+		workspaceField: p.Workspace,
+	}); err != nil {
+		return tag, err
+	}
+
+	if err = checkIDs(map[field]string{
+		workspaceField: p.Workspace,
+	}); err != nil {
+		return tag, err
+	}
+
+	req, err := c.NewRequest(
+		"POST",
+		"v1/workspaces/"+p.Workspace+"/tags",
+		dto.AddTagRequest{
+			Name: p.Name,
+		},
+	)
+	if err != nil {
+		return tag, err
+	}
+
+	_, err = c.Do(req, &tag, "AddTag")
+	return tag, err
+}
+
+// UpdateTagParam sets the properties to change on a tag
+type UpdateTagParam struct {
+	Workspace string
+	TagID     string
+	Name      string
+	Archived  *bool
+}
+
+// UpdateTag will change the name or archived status of a tag, leave the
+// property as nil or "empty" to not change it.
+//
+// Clockify requires the name on every change, so when it is not set the
+// current name of the tag is sent
+func (c *client) UpdateTag(p UpdateTagParam) (tag dto.Tag, err error) {
+	defer wrapError(&err, "update tag")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		tagIDField:     p.TagID,
+	}
+
+	if err = required(ids); err != nil {
+		return tag, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return tag, err
+	}
+
+	if p.Name == "" {
+		var t *dto.Tag
+		if t, err = c.GetTag(GetTagParam{
+			Workspace: p.Workspace,
+			TagID:     p.TagID,
+		}); err != nil {
+			return tag, err
+		}
+
+		p.Name = t.Name
+	}
+
+	req, err := c.NewRequest(
+		"PUT",
+		"v1/workspaces/"+p.Workspace+"/tags/"+p.TagID,
+		dto.UpdateTagRequest{
+			Name:     &p.Name,
+			Archived: p.Archived,
+		},
+	)
+	if err != nil {
+		return tag, err
+	}
+
+	_, err = c.Do(req, &tag, "UpdateTag")
+	return tag, err
+}
+
+// DeleteTagParam identifies the tag to be deleted
+type DeleteTagParam struct {
+	Workspace string
+	TagID     string
+}
+
+// DeleteTag removes a tag forever
+func (c *client) DeleteTag(p DeleteTagParam) (tag dto.Tag, err error) {
+	defer wrapError(&err, "delete tag")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		tagIDField:     p.TagID,
+	}
+
+	if err = required(ids); err != nil {
+		return tag, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return tag, err
+	}
+
+	req, err := c.NewRequest(
+		"DELETE",
+		"v1/workspaces/"+p.Workspace+"/tags/"+p.TagID,
+		nil,
+	)
+	if err != nil {
+		return tag, err
+	}
+
+	_, err = c.Do(req, &tag, "DeleteTag")
+	return tag, err
+}
+
 // GetClientsParam params to get all clients of a workspace
 type GetClientsParam struct {
 	Workspace string
@@ -1247,6 +1547,87 @@ func (c *client) GetClients(p GetClientsParam) (
 	return clients, err
 }
 
+// GetUserGroupsParam params to get the user groups of a workspace
+type GetUserGroupsParam struct {
+	Workspace string
+	Name      string
+	ProjectID string
+
+	PaginationParam
+}
+
+// GetUserGroups gets the user groups of a workspace
+func (c *client) GetUserGroups(p GetUserGroupsParam) (
+	groups []dto.UserGroup, err error) {
+	defer wrapError(&err, "get user groups")
+
+	var tmpl []dto.UserGroup
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return groups, err
+	}
+
+	err = c.paginate(
+		"GET",
+		fmt.Sprintf(
+			"v1/workspaces/%s/user-groups",
+			p.Workspace,
+		),
+		p.PaginationParam,
+		dto.GetUserGroupsRequest{
+			Name:      p.Name,
+			ProjectID: p.ProjectID,
+		},
+		&tmpl,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
+			ls := *res.(*[]dto.UserGroup)
+
+			groups = append(groups, ls...)
+			return len(ls), nil
+		},
+		"GetUserGroups",
+	)
+	return groups, err
+}
+
+// GetClientParam params to get a client of a workspace
+type GetClientParam struct {
+	Workspace string
+	ClientID  string
+}
+
+// GetClient gets a client of a workspace by its id
+func (c *client) GetClient(p GetClientParam) (client dto.Client, err error) {
+	defer wrapError(&err, "get client")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		clientIDField:  p.ClientID,
+	}
+
+	if err = required(ids); err != nil {
+		return client, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return client, err
+	}
+
+	req, err := c.NewRequest(
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/clients/"+p.ClientID,
+		nil,
+	)
+	if err != nil {
+		return client, err
+	}
+
+	_, err = c.Do(req, &client, "GetClient")
+	return client, err
+}
+
 type AddClientParam struct {
 	Workspace string
 	Name      string
@@ -1288,6 +1669,103 @@ func (c *client) AddClient(p AddClientParam) (client dto.Client, err error) {
 	return client, err
 }
 
+// UpdateClientParam sets the properties to change on a client
+type UpdateClientParam struct {
+	Workspace string
+	ClientID  string
+	Name      string
+	Note      *string
+	Archived  *bool
+}
+
+// UpdateClient will change the name, note or archived status of a client,
+// leave the property as nil or "empty" to not change it.
+//
+// Clockify requires the name on every change, so when it is not set the
+// current name of the client is sent
+func (c *client) UpdateClient(p UpdateClientParam) (
+	client dto.Client, err error) {
+	defer wrapError(&err, "update client")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		clientIDField:  p.ClientID,
+	}
+
+	if err = required(ids); err != nil {
+		return client, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return client, err
+	}
+
+	if p.Name == "" {
+		var cl dto.Client
+		if cl, err = c.GetClient(GetClientParam{
+			Workspace: p.Workspace,
+			ClientID:  p.ClientID,
+		}); err != nil {
+			return client, err
+		}
+
+		p.Name = cl.Name
+	}
+
+	req, err := c.NewRequest(
+		"PUT",
+		"v1/workspaces/"+p.Workspace+"/clients/"+p.ClientID,
+		dto.UpdateClientRequest{
+			Name:     &p.Name,
+			Note:     p.Note,
+			Archived: p.Archived,
+		},
+	)
+	if err != nil {
+		return client, err
+	}
+
+	_, err = c.Do(req, &client, "UpdateClient")
+	return client, err
+}
+
+// DeleteClientParam identifies the client to be deleted
+type DeleteClientParam struct {
+	Workspace string
+	ClientID  string
+}
+
+// DeleteClient removes a client forever, it must be archived first
+func (c *client) DeleteClient(p DeleteClientParam) (
+	client dto.Client, err error) {
+	defer wrapError(&err, "delete client")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		clientIDField:  p.ClientID,
+	}
+
+	if err = required(ids); err != nil {
+		return client, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return client, err
+	}
+
+	req, err := c.NewRequest(
+		"DELETE",
+		"v1/workspaces/"+p.Workspace+"/clients/"+p.ClientID,
+		nil,
+	)
+	if err != nil {
+		return client, err
+	}
+
+	_, err = c.Do(req, &client, "DeleteClient")
+	return client, err
+}
+
 // GetProjectsParam params to get all project of a workspace
 type GetProjectsParam struct {
 	Workspace string
@@ -1301,14 +1779,25 @@ type GetProjectsParam struct {
 
 // GetProjects get all project of a workspace
 func (c *client) GetProjects(p GetProjectsParam) (ps []dto.Project, err error) {
+	err = c.GetProjectsByPage(p, func(l []dto.Project) error {
+		ps = append(ps, l...)
+		return nil
+	})
+
+	return ps, err
+}
+
+// GetProjectsByPage get projects of a workspace, calling fn with each page
+func (c *client) GetProjectsByPage(
+	p GetProjectsParam, fn func([]dto.Project) error) (err error) {
 	defer wrapError(&err, "get projects")
 
 	var tmpl []dto.Project
 	if err = checkWorkspace(p.Workspace); err != nil {
-		return ps, err
+		return err
 	}
 
-	err = c.paginate(
+	return c.paginate(
 		"GET",
 		fmt.Sprintf(
 			"v1/workspaces/%s/projects",
@@ -1328,19 +1817,16 @@ func (c *client) GetProjects(p GetProjectsParam) (ps []dto.Project, err error) {
 			}
 			ls := *res.(*[]dto.Project)
 
-			ps = append(ps, ls...)
-			return len(ls), nil
+			if p.Hydrate {
+				for i := range ls {
+					ls[i].Hydrated = true
+				}
+			}
+
+			return len(ls), fn(ls)
 		},
 		"GetProjects",
 	)
-
-	if p.Hydrate {
-		for i := range ps {
-			ps[i].Hydrated = true
-		}
-	}
-
-	return ps, err
 }
 
 type AddProjectParam struct {
@@ -1495,10 +1981,16 @@ func (c *client) UpdateProject(p UpdateProjectParam) (
 }
 
 // UpdateMembership represents the membership of a User or User Group to a
-// project
+// project, rates not set are inherited from the workspace
 type UpdateMembership struct {
-	UserOrGroupID    string
+	UserOrGroupID string
+	// HourlyRateAmount is used as the hourly rate when it is not zero and
+	// HourlyRate is not set
+	//
+	// Deprecated: use HourlyRate
 	HourlyRateAmount int64
+	HourlyRate       *dto.Rate
+	CostRate         *dto.Rate
 }
 
 // UpdateProjectMembershipsParam will change which users and groups have
@@ -1542,7 +2034,13 @@ func (c *client) UpdateProjectMemberships(p UpdateProjectMembershipsParam) (
 		}
 
 		members[i].UserID = p.Memberships[i].UserOrGroupID
-		members[i].HourlyRate.Amount = p.Memberships[i].HourlyRateAmount
+		members[i].HourlyRate = p.Memberships[i].HourlyRate
+		members[i].CostRate = p.Memberships[i].CostRate
+		if members[i].HourlyRate == nil &&
+			p.Memberships[i].HourlyRateAmount != 0 {
+			members[i].HourlyRate = &dto.Rate{
+				Amount: p.Memberships[i].HourlyRateAmount}
+		}
 	}
 
 	req, err := c.NewRequest(
@@ -1760,6 +2258,9 @@ type UpdateProjectEstimateParam struct {
 	Type        EstimateType
 	ResetOption EstimateResetOption
 	Estimate    int64
+	// IncludeNonBillable sets if non-billable time should be counted, only
+	// used by the time method
+	IncludeNonBillable *bool
 }
 
 // UpdateProjectEstimate change how the estime of a project is measured
@@ -1836,6 +2337,7 @@ func (c *client) UpdateProjectEstimate(p UpdateProjectEstimateParam) (
 				b.TimeEstimate.Estimate = &dto.Duration{
 					Duration: time.Duration(p.Estimate)}
 			}
+			b.TimeEstimate.IncludeNonBillable = p.IncludeNonBillable
 		}
 	}
 
@@ -1970,6 +2472,9 @@ type UpdateTimeEntryParam struct {
 	ProjectID   string
 	TaskID      string
 	TagIDs      []string
+	// CustomFields values to set on the time entry, only the id and value
+	// are sent
+	CustomFields []dto.CustomFieldValue
 }
 
 // UpdateTimeEntry update a time entry
@@ -2003,13 +2508,14 @@ func (c *client) UpdateTimeEntry(p UpdateTimeEntryParam) (
 			p.TimeEntryID,
 		),
 		dto.UpdateTimeEntryRequest{
-			Start:       dto.DateTime{Time: p.Start},
-			End:         end,
-			Billable:    p.Billable,
-			Description: p.Description,
-			ProjectID:   p.ProjectID,
-			TaskID:      p.TaskID,
-			TagIDs:      p.TagIDs,
+			Start:        dto.DateTime{Time: p.Start},
+			End:          end,
+			Billable:     p.Billable,
+			Description:  p.Description,
+			ProjectID:    p.ProjectID,
+			TaskID:       p.TaskID,
+			TagIDs:       p.TagIDs,
+			CustomFields: customFieldValues(p.CustomFields),
 		},
 	)
 
@@ -2021,6 +2527,24 @@ func (c *client) UpdateTimeEntry(p UpdateTimeEntryParam) (
 	return t, err
 }
 
+// customFieldValues keeps only the id and value of the custom fields, which
+// is what the API accepts when creating or updating time entries
+func customFieldValues(cfs []dto.CustomFieldValue) []dto.CustomFieldValue {
+	if len(cfs) == 0 {
+		return nil
+	}
+
+	vs := make([]dto.CustomFieldValue, len(cfs))
+	for i := range cfs {
+		vs[i] = dto.CustomFieldValue{
+			CustomFieldID: cfs[i].CustomFieldID,
+			Value:         cfs[i].Value,
+		}
+	}
+
+	return vs
+}
+
 // DeleteTimeEntryParam params to update a new time entry
 type DeleteTimeEntryParam struct {
 	Workspace   string
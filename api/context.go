@@ -0,0 +1,350 @@
+package api
+
+import (
+	"context"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// ContextClient has variants of the Client methods receiving the context for
+// each call.
+//
+// It uses Client.WithContext for each call, so clients wrapping the Client
+// (like the cache) are respected.
+type ContextClient struct {
+	Client Client
+}
+
+// NewContextClient wraps the client to allow a context per call
+func NewContextClient(c Client) ContextClient {
+	return ContextClient{Client: c}
+}
+
+// GetWorkspace is Client.GetWorkspace using ctx
+func (c ContextClient) GetWorkspace(
+	ctx context.Context, p GetWorkspace,
+) (dto.Workspace, error) {
+	return c.Client.WithContext(ctx).GetWorkspace(p)
+}
+
+// GetWorkspaces is Client.GetWorkspaces using ctx
+func (c ContextClient) GetWorkspaces(
+	ctx context.Context, p GetWorkspaces,
+) ([]dto.Workspace, error) {
+	return c.Client.WithContext(ctx).GetWorkspaces(p)
+}
+
+// GetWorkspaceCustomFields is Client.GetWorkspaceCustomFields using ctx
+func (c ContextClient) GetWorkspaceCustomFields(
+	ctx context.Context, p GetWorkspaceCustomFieldsParam,
+) ([]dto.WorkspaceCustomField, error) {
+	return c.Client.WithContext(ctx).GetWorkspaceCustomFields(p)
+}
+
+// GetMe is Client.GetMe using ctx
+func (c ContextClient) GetMe(
+	ctx context.Context,
+) (dto.User, error) {
+	return c.Client.WithContext(ctx).GetMe()
+}
+
+// GetUser is Client.GetUser using ctx
+func (c ContextClient) GetUser(
+	ctx context.Context, p GetUser,
+) (dto.User, error) {
+	return c.Client.WithContext(ctx).GetUser(p)
+}
+
+// WorkspaceUsers is Client.WorkspaceUsers using ctx
+func (c ContextClient) WorkspaceUsers(
+	ctx context.Context, p WorkspaceUsersParam,
+) ([]dto.User, error) {
+	return c.Client.WithContext(ctx).WorkspaceUsers(p)
+}
+
+// GetUserGroups is Client.GetUserGroups using ctx
+func (c ContextClient) GetUserGroups(
+	ctx context.Context, p GetUserGroupsParam,
+) ([]dto.UserGroup, error) {
+	return c.Client.WithContext(ctx).GetUserGroups(p)
+}
+
+// AddClient is Client.AddClient using ctx
+func (c ContextClient) AddClient(
+	ctx context.Context, p AddClientParam,
+) (dto.Client, error) {
+	return c.Client.WithContext(ctx).AddClient(p)
+}
+
+// GetClients is Client.GetClients using ctx
+func (c ContextClient) GetClients(
+	ctx context.Context, p GetClientsParam,
+) ([]dto.Client, error) {
+	return c.Client.WithContext(ctx).GetClients(p)
+}
+
+// GetClient is Client.GetClient using ctx
+func (c ContextClient) GetClient(
+	ctx context.Context, p GetClientParam,
+) (dto.Client, error) {
+	return c.Client.WithContext(ctx).GetClient(p)
+}
+
+// UpdateClient is Client.UpdateClient using ctx
+func (c ContextClient) UpdateClient(
+	ctx context.Context, p UpdateClientParam,
+) (dto.Client, error) {
+	return c.Client.WithContext(ctx).UpdateClient(p)
+}
+
+// DeleteClient is Client.DeleteClient using ctx
+func (c ContextClient) DeleteClient(
+	ctx context.Context, p DeleteClientParam,
+) (dto.Client, error) {
+	return c.Client.WithContext(ctx).DeleteClient(p)
+}
+
+// GetProjects is Client.GetProjects using ctx
+func (c ContextClient) GetProjects(
+	ctx context.Context, p GetProjectsParam,
+) ([]dto.Project, error) {
+	return c.Client.WithContext(ctx).GetProjects(p)
+}
+
+// GetProjectsByPage is Client.GetProjectsByPage using ctx
+func (c ContextClient) GetProjectsByPage(
+	ctx context.Context, p GetProjectsParam, fn func([]dto.Project) error,
+) error {
+	return c.Client.WithContext(ctx).GetProjectsByPage(p, fn)
+}
+
+// GetProject is Client.GetProject using ctx
+func (c ContextClient) GetProject(
+	ctx context.Context, p GetProjectParam,
+) (*dto.Project, error) {
+	return c.Client.WithContext(ctx).GetProject(p)
+}
+
+// AddProject is Client.AddProject using ctx
+func (c ContextClient) AddProject(
+	ctx context.Context, p AddProjectParam,
+) (dto.Project, error) {
+	return c.Client.WithContext(ctx).AddProject(p)
+}
+
+// UpdateProject is Client.UpdateProject using ctx
+func (c ContextClient) UpdateProject(
+	ctx context.Context, p UpdateProjectParam,
+) (dto.Project, error) {
+	return c.Client.WithContext(ctx).UpdateProject(p)
+}
+
+// UpdateProjectUserBillableRate is Client.UpdateProjectUserBillableRate using ctx
+func (c ContextClient) UpdateProjectUserBillableRate(
+	ctx context.Context, p UpdateProjectUserRateParam,
+) (dto.Project, error) {
+	return c.Client.WithContext(ctx).UpdateProjectUserBillableRate(p)
+}
+
+// UpdateProjectUserCostRate is Client.UpdateProjectUserCostRate using ctx
+func (c ContextClient) UpdateProjectUserCostRate(
+	ctx context.Context, p UpdateProjectUserRateParam,
+) (dto.Project, error) {
+	return c.Client.WithContext(ctx).UpdateProjectUserCostRate(p)
+}
+
+// UpdateProjectEstimate is Client.UpdateProjectEstimate using ctx
+func (c ContextClient) UpdateProjectEstimate(
+	ctx context.Context, p UpdateProjectEstimateParam,
+) (dto.Project, error) {
+	return c.Client.WithContext(ctx).UpdateProjectEstimate(p)
+}
+
+// UpdateProjectMemberships is Client.UpdateProjectMemberships using ctx
+func (c ContextClient) UpdateProjectMemberships(
+	ctx context.Context, p UpdateProjectMembershipsParam,
+) (dto.Project, error) {
+	return c.Client.WithContext(ctx).UpdateProjectMemberships(p)
+}
+
+// UpdateProjectTemplate is Client.UpdateProjectTemplate using ctx
+func (c ContextClient) UpdateProjectTemplate(
+	ctx context.Context, p UpdateProjectTemplateParam,
+) (dto.Project, error) {
+	return c.Client.WithContext(ctx).UpdateProjectTemplate(p)
+}
+
+// DeleteProject is Client.DeleteProject using ctx
+func (c ContextClient) DeleteProject(
+	ctx context.Context, p DeleteProjectParam,
+) (dto.Project, error) {
+	return c.Client.WithContext(ctx).DeleteProject(p)
+}
+
+// AddTask is Client.AddTask using ctx
+func (c ContextClient) AddTask(
+	ctx context.Context, p AddTaskParam,
+) (dto.Task, error) {
+	return c.Client.WithContext(ctx).AddTask(p)
+}
+
+// DeleteTask is Client.DeleteTask using ctx
+func (c ContextClient) DeleteTask(
+	ctx context.Context, p DeleteTaskParam,
+) (dto.Task, error) {
+	return c.Client.WithContext(ctx).DeleteTask(p)
+}
+
+// GetTask is Client.GetTask using ctx
+func (c ContextClient) GetTask(
+	ctx context.Context, p GetTaskParam,
+) (dto.Task, error) {
+	return c.Client.WithContext(ctx).GetTask(p)
+}
+
+// GetTasks is Client.GetTasks using ctx
+func (c ContextClient) GetTasks(
+	ctx context.Context, p GetTasksParam,
+) ([]dto.Task, error) {
+	return c.Client.WithContext(ctx).GetTasks(p)
+}
+
+// UpdateTask is Client.UpdateTask using ctx
+func (c ContextClient) UpdateTask(
+	ctx context.Context, p UpdateTaskParam,
+) (dto.Task, error) {
+	return c.Client.WithContext(ctx).UpdateTask(p)
+}
+
+// GetTag is Client.GetTag using ctx
+func (c ContextClient) GetTag(
+	ctx context.Context, p GetTagParam,
+) (*dto.Tag, error) {
+	return c.Client.WithContext(ctx).GetTag(p)
+}
+
+// GetTags is Client.GetTags using ctx
+func (c ContextClient) GetTags(
+	ctx context.Context, p GetTagsParam,
+) ([]dto.Tag, error) {
+	return c.Client.WithContext(ctx).GetTags(p)
+}
+
+// AddTag is Client.AddTag using ctx
+func (c ContextClient) AddTag(
+	ctx context.Context, p AddTagParam,
+) (dto.Tag, error) {
+	return c.Client.WithContext(ctx).AddTag(p)
+}
+
+// UpdateTag is Client.UpdateTag using ctx
+func (c ContextClient) UpdateTag(
+	ctx context.Context, p UpdateTagParam,
+) (dto.Tag, error) {
+	return c.Client.WithContext(ctx).UpdateTag(p)
+}
+
+// DeleteTag is Client.DeleteTag using ctx
+func (c ContextClient) DeleteTag(
+	ctx context.Context, p DeleteTagParam,
+) (dto.Tag, error) {
+	return c.Client.WithContext(ctx).DeleteTag(p)
+}
+
+// ChangeInvoiced is Client.ChangeInvoiced using ctx
+func (c ContextClient) ChangeInvoiced(
+	ctx context.Context, p ChangeInvoicedParam,
+) error {
+	return c.Client.WithContext(ctx).ChangeInvoiced(p)
+}
+
+// CreateTimeEntry is Client.CreateTimeEntry using ctx
+func (c ContextClient) CreateTimeEntry(
+	ctx context.Context, p CreateTimeEntryParam,
+) (dto.TimeEntryImpl, error) {
+	return c.Client.WithContext(ctx).CreateTimeEntry(p)
+}
+
+// DeleteTimeEntry is Client.DeleteTimeEntry using ctx
+func (c ContextClient) DeleteTimeEntry(
+	ctx context.Context, p DeleteTimeEntryParam,
+) error {
+	return c.Client.WithContext(ctx).DeleteTimeEntry(p)
+}
+
+// GetHydratedTimeEntry is Client.GetHydratedTimeEntry using ctx
+func (c ContextClient) GetHydratedTimeEntry(
+	ctx context.Context, p GetTimeEntryParam,
+) (*dto.TimeEntry, error) {
+	return c.Client.WithContext(ctx).GetHydratedTimeEntry(p)
+}
+
+// GetHydratedTimeEntryInProgress is Client.GetHydratedTimeEntryInProgress using ctx
+func (c ContextClient) GetHydratedTimeEntryInProgress(
+	ctx context.Context, p GetTimeEntryInProgressParam,
+) (*dto.TimeEntry, error) {
+	return c.Client.WithContext(ctx).GetHydratedTimeEntryInProgress(p)
+}
+
+// GetTimeEntry is Client.GetTimeEntry using ctx
+func (c ContextClient) GetTimeEntry(
+	ctx context.Context, p GetTimeEntryParam,
+) (*dto.TimeEntryImpl, error) {
+	return c.Client.WithContext(ctx).GetTimeEntry(p)
+}
+
+// GetTimeEntryInProgress is Client.GetTimeEntryInProgress using ctx
+func (c ContextClient) GetTimeEntryInProgress(
+	ctx context.Context, p GetTimeEntryInProgressParam,
+) (*dto.TimeEntryImpl, error) {
+	return c.Client.WithContext(ctx).GetTimeEntryInProgress(p)
+}
+
+// GetUserTimeEntries is Client.GetUserTimeEntries using ctx
+func (c ContextClient) GetUserTimeEntries(
+	ctx context.Context, p GetUserTimeEntriesParam,
+) ([]dto.TimeEntryImpl, error) {
+	return c.Client.WithContext(ctx).GetUserTimeEntries(p)
+}
+
+// GetUsersHydratedTimeEntries is Client.GetUsersHydratedTimeEntries using ctx
+func (c ContextClient) GetUsersHydratedTimeEntries(
+	ctx context.Context, p GetUserTimeEntriesParam,
+) ([]dto.TimeEntry, error) {
+	return c.Client.WithContext(ctx).GetUsersHydratedTimeEntries(p)
+}
+
+// Log is Client.Log using ctx
+func (c ContextClient) Log(
+	ctx context.Context, p LogParam,
+) ([]dto.TimeEntry, error) {
+	return c.Client.WithContext(ctx).Log(p)
+}
+
+// LogRange is Client.LogRange using ctx
+func (c ContextClient) LogRange(
+	ctx context.Context, p LogRangeParam,
+) ([]dto.TimeEntry, error) {
+	return c.Client.WithContext(ctx).LogRange(p)
+}
+
+// LogRangeByPage is Client.LogRangeByPage using ctx
+func (c ContextClient) LogRangeByPage(
+	ctx context.Context, p LogRangeParam, fn func([]dto.TimeEntry) error,
+) error {
+	return c.Client.WithContext(ctx).LogRangeByPage(p, fn)
+}
+
+// UpdateTimeEntry is Client.UpdateTimeEntry using ctx
+func (c ContextClient) UpdateTimeEntry(
+	ctx context.Context, p UpdateTimeEntryParam,
+) (dto.TimeEntryImpl, error) {
+	return c.Client.WithContext(ctx).UpdateTimeEntry(p)
+}
+
+// Out is Client.Out using ctx
+func (c ContextClient) Out(
+	ctx context.Context, p OutParam,
+) error {
+	return c.Client.WithContext(ctx).Out(p)
+}
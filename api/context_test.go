@@ -0,0 +1,85 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRequestTimeout(t *testing.T) {
+	s := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			w.WriteHeader(200)
+		}))
+	defer s.Close()
+
+	c := newRetryClient(t, s.URL, 0).SetRequestTimeout(50 * time.Millisecond)
+
+	_, err := c.GetWorkspaces(api.GetWorkspaces{})
+	if assert.Error(t, err) {
+		assert.Regexp(t, "Client.Timeout exceeded", err.Error())
+	}
+}
+
+func TestClientWithContextCancelled(t *testing.T) {
+	s, bodies := newRetryServer(t, "GET", []response{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := newRetryClient(t, s.URL, 3).WithContext(ctx).
+		GetWorkspaces(api.GetWorkspaces{})
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Len(t, *bodies, 0)
+}
+
+func TestClientWithContextStopsRetries(t *testing.T) {
+	s, bodies := newRetryServer(t, "GET", []response{
+		{status: 429, retryAfter: "10"},
+	})
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := newRetryClient(t, s.URL, 3).
+		SetRetryPolicy(api.RetryPolicy{
+			MaxRetries: 3,
+			MinWait:    time.Millisecond,
+			MaxWait:    time.Minute,
+		}).
+		WithContext(ctx).
+		GetWorkspaces(api.GetWorkspaces{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Len(t, *bodies, 1)
+}
+
+func TestContextClientCancelled(t *testing.T) {
+	s, bodies := newRetryServer(t, "GET", []response{
+		{status: 200, body: "[]"},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := api.NewContextClient(newRetryClient(t, s.URL, 3))
+	_, err := c.GetWorkspaces(ctx, api.GetWorkspaces{})
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Len(t, *bodies, 0)
+
+	_, err = c.GetWorkspaces(context.Background(), api.GetWorkspaces{})
+	assert.NoError(t, err)
+	assert.Len(t, *bodies, 1)
+}
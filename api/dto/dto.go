@@ -1,7 +1,10 @@
 package dto
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -9,12 +12,53 @@ import (
 type Error struct {
 	Message string `json:"message"`
 	Code    int    `json:"code"`
+	// Status is the HTTP status of the response, Code is used if not set
+	Status int `json:"-"`
 }
 
 func (e Error) Error() string {
 	return fmt.Sprintf("%s (code: %d)", e.Message, e.Code)
 }
 
+// Kinds of Error, they can be used with errors.Is to check why a request
+// failed
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+// Is reports whether the Error is of the kind target, based on its status
+func (e Error) Is(target error) bool {
+	s := e.Status
+	if s == 0 {
+		s = e.Code
+	}
+
+	switch target {
+	case ErrBadRequest:
+		return s == 400
+	case ErrUnauthorized:
+		return s == 401
+	case ErrForbidden:
+		return s == 403
+	case ErrNotFound:
+		return s == 404
+	case ErrConflict:
+		return s == 409
+	case ErrRateLimited:
+		return s == 429
+	case ErrServer:
+		return s >= 500 && s < 600
+	default:
+		return false
+	}
+}
+
 // Workspace DTO
 type Workspace struct {
 	ID          string            `json:"id"`
@@ -22,6 +66,7 @@ type Workspace struct {
 	ImageURL    string            `json:"imageUrl"`
 	Settings    WorkspaceSettings `json:"workspaceSettings"`
 	HourlyRate  Rate              `json:"hourlyRate"`
+	CostRate    *Rate             `json:"costRate"`
 	Memberships []Membership
 }
 
@@ -114,8 +159,12 @@ type TimeEntry struct {
 	Task          *Task        `json:"task"`
 	TimeInterval  TimeInterval `json:"timeInterval"`
 	TotalBillable int64        `json:"totalBillable"`
+	CostRate      *Rate        `json:"costRate,omitempty"`
+	TotalCost     int64        `json:"totalCost,omitempty"`
 	User          *User        `json:"user"`
 	WorkspaceID   string       `json:"workspaceId"`
+
+	CustomFields []CustomFieldValue `json:"customFieldValues,omitempty"`
 }
 
 // NewTimeInterval will create a TimeInterval from start and end times
@@ -152,6 +201,7 @@ type Tag struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	WorkspaceID string `json:"workspaceId"`
+	Archived    bool   `json:"archived"`
 }
 
 func (e Tag) GetID() string   { return e.ID }
@@ -186,12 +236,24 @@ type Task struct {
 func (e Task) GetID() string   { return e.ID }
 func (e Task) GetName() string { return e.Name }
 
+// UserGroup DTO
+type UserGroup struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	WorkspaceID string   `json:"workspaceId"`
+	UserIDs     []string `json:"userIds"`
+}
+
+func (e UserGroup) GetID() string   { return e.ID }
+func (e UserGroup) GetName() string { return e.Name }
+
 // Client DTO
 type Client struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	WorkspaceID string `json:"workspaceId"`
 	Archived    bool   `json:"archived"`
+	Note        string `json:"note"`
 }
 
 func (e Client) GetID() string   { return e.ID }
@@ -206,6 +268,75 @@ type CustomField struct {
 	Value         string `json:"value"`
 }
 
+// CustomFieldType is the kind of value a custom field accepts
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText             = CustomFieldType("TXT")
+	CustomFieldTypeNumber           = CustomFieldType("NUMBER")
+	CustomFieldTypeLink             = CustomFieldType("LINK")
+	CustomFieldTypeCheckbox         = CustomFieldType("CHECKBOX")
+	CustomFieldTypeDropdownSingle   = CustomFieldType("DROPDOWN_SINGLE")
+	CustomFieldTypeDropdownMultiple = CustomFieldType("DROPDOWN_MULTIPLE")
+)
+
+// CustomFieldStatus tells if and how a custom field is used
+type CustomFieldStatus string
+
+const (
+	CustomFieldStatusInactive  = CustomFieldStatus("INACTIVE")
+	CustomFieldStatusVisible   = CustomFieldStatus("VISIBLE")
+	CustomFieldStatusInvisible = CustomFieldStatus("INVISIBLE")
+)
+
+// WorkspaceCustomField is the definition of a custom field on a workspace
+type WorkspaceCustomField struct {
+	ID            string            `json:"id"`
+	WorkspaceID   string            `json:"workspaceId"`
+	Name          string            `json:"name"`
+	Type          CustomFieldType   `json:"type"`
+	Status        CustomFieldStatus `json:"status"`
+	EntityType    string            `json:"entityType"`
+	Required      bool              `json:"required"`
+	Placeholder   string            `json:"placeholder"`
+	AllowedValues []string          `json:"allowedValues"`
+}
+
+func (e WorkspaceCustomField) GetID() string   { return e.ID }
+func (e WorkspaceCustomField) GetName() string { return e.Name }
+
+// CustomFieldValue is the value of a custom field on a time entry, the value
+// is a string, number, bool or list of strings depending on the field type
+type CustomFieldValue struct {
+	CustomFieldID string          `json:"customFieldId"`
+	TimeEntryID   string          `json:"timeEntryId,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Type          CustomFieldType `json:"type,omitempty"`
+	Value         interface{}     `json:"value"`
+}
+
+// String formats the value of the custom field to be shown
+func (v CustomFieldValue) String() string {
+	switch t := v.Value.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case []string:
+		return strings.Join(t, ", ")
+	case []interface{}:
+		s := make([]string, len(t))
+		for i := range t {
+			s[i] = fmt.Sprint(t[i])
+		}
+		return strings.Join(s, ", ")
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
 // Project DTO
 type Project struct {
 	WorkspaceID string `json:"workspaceId"`
@@ -222,9 +353,9 @@ type Project struct {
 	CostRate   *Rate `json:"costRate"`
 	Billable   bool  `json:"billable"`
 
-	TimeEstimate   TimeEstimate `json:"timeEstimate"`
-	BudgetEstimate BaseEstimate `json:"budgetEstimate"`
-	Duration       *Duration    `json:"duration"`
+	TimeEstimate   TimeEstimate   `json:"timeEstimate"`
+	BudgetEstimate BudgetEstimate `json:"budgetEstimate"`
+	Duration       *Duration      `json:"duration"`
 
 	Archived bool `json:"archived"`
 	Template bool `json:"template"`
@@ -261,7 +392,7 @@ const EstimateResetOptionMonthly = EstimateResetOption("MONTHLY")
 type BaseEstimate struct {
 	Type         EstimateType         `json:"type"`
 	Active       bool                 `json:"active"`
-	ResetOptions *EstimateResetOption `json:"resetOptions"`
+	ResetOptions *EstimateResetOption `json:"resetOption"`
 }
 
 // TimeEstimate DTO
@@ -397,4 +528,6 @@ type TimeEntryImpl struct {
 	TimeInterval TimeInterval `json:"timeInterval"`
 	UserID       string       `json:"userId"`
 	WorkspaceID  string       `json:"workspaceId"`
+
+	CustomFields []CustomFieldValue `json:"customFieldValues,omitempty"`
 }
@@ -75,6 +75,10 @@ func (d *Duration) UnmarshalJSON(b []byte) error {
 }
 
 func (d Duration) String() string {
+	if d.Duration < 0 {
+		return "-PT" + strings.ToUpper((-d.Duration).String())
+	}
+
 	return "PT" + strings.ToUpper(d.Duration.String())
 }
 
@@ -225,15 +229,6 @@ type CreateTimeEntryRequest struct {
 	CustomFields []CustomFieldValue `json:"customFields,omitempty"`
 }
 
-// CustomFieldValue DTO
-type CustomFieldValue struct {
-	CustomFieldID string `json:"customFieldId"`
-	Status        string `json:"status"`
-	Name          string `json:"name"`
-	Type          string `json:"type"`
-	Value         string `json:"value"`
-}
-
 // UpdateTimeEntryRequest to update a time entry
 type UpdateTimeEntryRequest struct {
 	Start        DateTime           `json:"start,omitempty"`
@@ -246,6 +241,33 @@ type UpdateTimeEntryRequest struct {
 	CustomFields []CustomFieldValue `json:"customFields,omitempty"`
 }
 
+// GetCustomFieldsRequest filters the custom fields of a workspace
+type GetCustomFieldsRequest struct {
+	Name       string
+	Status     CustomFieldStatus
+	EntityType string
+}
+
+// AppendToQuery decorates the URL with the query string needed for this
+// Request
+func (r GetCustomFieldsRequest) AppendToQuery(u *url.URL) *url.URL {
+	v := u.Query()
+	if r.Name != "" {
+		v.Add("name", r.Name)
+	}
+
+	if r.Status != "" {
+		v.Add("status", string(r.Status))
+	}
+
+	if r.EntityType != "" {
+		v.Add("entity-type", r.EntityType)
+	}
+
+	u.RawQuery = v.Encode()
+	return u
+}
+
 type GetClientsRequest struct {
 	Name     string
 	Archived *bool
@@ -278,10 +300,62 @@ func (r GetClientsRequest) AppendToQuery(u *url.URL) *url.URL {
 	return u
 }
 
+// GetUserGroupsRequest filters the user groups of a workspace
+type GetUserGroupsRequest struct {
+	Name      string
+	ProjectID string
+
+	pagination
+}
+
+// WithPagination add pagination to the GetUserGroupsRequest
+func (r GetUserGroupsRequest) WithPagination(
+	page, size int) PaginatedRequest {
+	r.pagination = newPagination(page, size)
+	return r
+}
+
+// AppendToQuery decorates the URL with the query string needed for this Request
+func (r GetUserGroupsRequest) AppendToQuery(u *url.URL) *url.URL {
+	u = r.pagination.AppendToQuery(u)
+
+	v := u.Query()
+
+	if r.Name != "" {
+		v.Add("name", r.Name)
+	}
+
+	if r.ProjectID != "" {
+		v.Add("projectId", r.ProjectID)
+	}
+
+	u.RawQuery = v.Encode()
+
+	return u
+}
+
 type AddClientRequest struct {
 	Name string `json:"name"`
 }
 
+// UpdateClientRequest represents the body to change a client
+type UpdateClientRequest struct {
+	Name     *string `json:"name,omitempty"`
+	Note     *string `json:"note,omitempty"`
+	Archived *bool   `json:"archived,omitempty"`
+}
+
+// AddTagRequest represents the body to create a tag
+type AddTagRequest struct {
+	Name string `json:"name"`
+}
+
+// UpdateTagRequest represents the body to change a tag
+type UpdateTagRequest struct {
+	Name     *string `json:"name,omitempty"`
+	Archived *bool   `json:"archived,omitempty"`
+}
+
 type GetProjectsRequest struct {
 	Name     string
 	Archived *bool
@@ -376,10 +450,11 @@ type UpdateProjectMembershipsRequest struct {
 }
 
 // UpdateProjectMembership sets which user or group has access, and their
-// hourly rate
+// hourly and cost rates, without rates the ones of the workspace are used
 type UpdateProjectMembership struct {
 	UserID     string `json:"userId"`
-	HourlyRate Rate   `json:"hourlyRate"`
+	HourlyRate *Rate  `json:"hourlyRate,omitempty"`
+	CostRate   *Rate  `json:"costRate,omitempty"`
 }
 
 // UpdateProjectTemplateRequest represents a request to change isTemplate flag
@@ -449,19 +524,21 @@ func (r GetTasksRequest) AppendToQuery(u *url.URL) *url.URL {
 }
 
 type AddTaskRequest struct {
-	Name        string    `json:"name"`
-	AssigneeIDs *[]string `json:"assigneeIds,omitempty"`
-	Billable    *bool     `json:"billable,omitempty"`
-	Estimate    *Duration `json:"estimate,omitempty"`
-	Status      *string   `json:"status,omitempty"`
+	Name         string    `json:"name"`
+	AssigneeIDs  *[]string `json:"assigneeIds,omitempty"`
+	UserGroupIDs *[]string `json:"userGroupIds,omitempty"`
+	Billable     *bool     `json:"billable,omitempty"`
+	Estimate     *Duration `json:"estimate,omitempty"`
+	Status       *string   `json:"status,omitempty"`
 }
 
 type UpdateTaskRequest struct {
-	Name        string    `json:"name"`
-	AssigneeIDs *[]string `json:"assigneeIds,omitempty"`
-	Billable    *bool     `json:"billable,omitempty"`
-	Estimate    *Duration `json:"estimate,omitempty"`
-	Status      *string   `json:"status,omitempty"`
+	Name         string    `json:"name"`
+	AssigneeIDs  *[]string `json:"assigneeIds,omitempty"`
+	UserGroupIDs *[]string `json:"userGroupIds,omitempty"`
+	Billable     *bool     `json:"billable,omitempty"`
+	Estimate     *Duration `json:"estimate,omitempty"`
+	Status       *string   `json:"status,omitempty"`
 }
 
 type ChangeTimeEntriesInvoicedRequest struct {
@@ -512,7 +589,8 @@ type BaseEstimateRequest struct {
 // TimeEstimateRequest set parameters for time estimate on a project
 type TimeEstimateRequest struct {
 	BaseEstimateRequest
-	Estimate *Duration `json:"estimate,omitempty"`
+	Estimate           *Duration `json:"estimate,omitempty"`
+	IncludeNonBillable *bool     `json:"includeNonBillable,omitempty"`
 }
 
 // BudgetEstimateRequest set parameters for time estimate on a project
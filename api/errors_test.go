@@ -0,0 +1,69 @@
+package api_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientErrorKinds(t *testing.T) {
+	kinds := []error{
+		dto.ErrBadRequest,
+		dto.ErrUnauthorized,
+		dto.ErrForbidden,
+		dto.ErrNotFound,
+		dto.ErrConflict,
+		dto.ErrRateLimited,
+		dto.ErrServer,
+	}
+
+	tts := []struct {
+		name   string
+		status int
+		body   string
+		kind   error
+	}{
+		{
+			name:   "validation uses the http status, not the code",
+			status: 400,
+			body:   `{"code":501,"message":"Project doesn't belong"}`,
+			kind:   dto.ErrBadRequest,
+		},
+		{name: "unauthorized", status: 401, kind: dto.ErrUnauthorized},
+		{name: "forbidden", status: 403, kind: dto.ErrForbidden},
+		{name: "not found", status: 404, kind: dto.ErrNotFound},
+		{name: "conflict", status: 409, kind: dto.ErrConflict},
+		{name: "rate limited", status: 429, kind: dto.ErrRateLimited},
+		{name: "unavailable", status: 503, kind: dto.ErrServer},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newRetryServer(t, "GET", []response{
+				{status: tt.status, body: tt.body},
+			})
+
+			_, err := newRetryClient(t, s.URL, 0).
+				GetWorkspaces(api.GetWorkspaces{})
+
+			var apiErr dto.Error
+			if assert.ErrorAs(t, err, &apiErr) {
+				assert.Equal(t, tt.status, apiErr.Status)
+			}
+
+			for _, k := range kinds {
+				assert.Equal(t, k == tt.kind, errors.Is(err, k),
+					"errors.Is(err, %s)", k)
+			}
+		})
+	}
+}
+
+func TestEntityNotFoundIsNotFound(t *testing.T) {
+	err := api.EntityNotFound{EntityName: "project", ID: "p1"}
+	assert.ErrorIs(t, err, dto.ErrNotFound)
+	assert.NotErrorIs(t, err, dto.ErrServer)
+}
@@ -2,10 +2,12 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/pkg/errors"
@@ -33,6 +35,19 @@ func (t transport) RoundTrip(r *http.Request) (*http.Response, error) {
 	return t.next.RoundTrip(r)
 }
 
+// SetRequestTimeout limits how long each request can take
+func (c *client) SetRequestTimeout(d time.Duration) Client {
+	c.Client.Timeout = d
+	return c
+}
+
+// WithContext returns a copy of the client using the context on its requests
+func (c *client) WithContext(ctx context.Context) Client {
+	nc := *c
+	nc.ctx = ctx
+	return &nc
+}
+
 // NewRequest to be used in Client
 func (c *client) NewRequest(method, uri string, body interface{}) (*http.Request, error) {
 	u, err := c.baseURL.Parse(c.baseURL.Path + "/" + uri)
@@ -55,10 +70,9 @@ func (c *client) NewRequest(method, uri string, body interface{}) (*http.Request
 		if err != nil {
 			return nil, err
 		}
-		c.infof("request body: %s", buf.(*bytes.Buffer))
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(c.ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -71,29 +85,14 @@ func (c *client) NewRequest(method, uri string, body interface{}) (*http.Request
 	return req, nil
 }
 
-// Do executes a http.Request inside the Clockify's Client
+// Do executes a http.Request inside the Clockify's Client, retrying it when
+// rate limited or the server is unavailable, see RetryPolicy
 func (c *client) Do(
 	req *http.Request, v interface{}, name string) (*http.Response, error) {
-	r, err := c.Client.Do(req)
+	r, buf, err := c.doWithRetry(req, name)
 	if err != nil {
 		return r, err
 	}
-	defer r.Body.Close()
-
-	buf := new(bytes.Buffer)
-
-	_, err = io.Copy(buf, r.Body)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-
-	if c.debugLogger != nil {
-		c.debugf("name: %s, method: %s, url: %s, status: %d, response: \"%s\"",
-			name, req.Method, req.URL.String(), r.StatusCode, buf)
-	} else {
-		c.infof("name: %s, method: %s, url: %s, status: %d",
-			name, req.Method, req.URL.String(), r.StatusCode)
-	}
 
 	decoder := json.NewDecoder(buf)
 
@@ -116,6 +115,8 @@ func (c *client) Do(
 			apiErr.Message = "No response"
 		}
 
+		apiErr.Status = r.StatusCode
+
 		return r, errors.WithStack(apiErr)
 	}
 
@@ -129,3 +130,32 @@ func (c *client) Do(
 
 	return r, errors.WithStack(decoder.Decode(v))
 }
+
+// send executes the request once, reading all the response body
+func (c *client) send(req *http.Request, name string) (
+	*http.Response, *bytes.Buffer, error) {
+	start := time.Now()
+	r, err := c.Client.Do(req)
+	if err != nil {
+		c.log(LogInfo, "request failed", Fields{
+			"name":       name,
+			"method":     req.Method,
+			"url":        req.URL.String(),
+			"latency_ms": time.Since(start).Milliseconds(),
+			"error":      err.Error(),
+		})
+		return r, nil, err
+	}
+	defer r.Body.Close()
+
+	buf := new(bytes.Buffer)
+
+	_, err = io.Copy(buf, r.Body)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	c.logRequest(name, req, r, buf, time.Since(start))
+
+	return r, buf, nil
+}
@@ -1,36 +1,173 @@
 package api
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
 // Logger for the Client
+//
+// Deprecated: use StructuredLogger instead
 type Logger interface {
 	Print(v ...interface{})
 	Printf(format string, v ...interface{})
 	Println(v ...interface{})
 }
 
-// SetDebugLogger debug logger
+// LogLevel is the level of a structured log entry
+type LogLevel string
+
+// Levels of the structured log entries
+const (
+	LogInfo  LogLevel = "info"
+	LogDebug LogLevel = "debug"
+)
+
+// Fields are the values of a structured log entry
+type Fields map[string]interface{}
+
+// StructuredLogger receives the log entries of the Client with its values as
+// fields, so they can be written as JSON or filtered
+type StructuredLogger interface {
+	// Enabled tells if entries of the level will be logged
+	Enabled(LogLevel) bool
+	Log(level LogLevel, msg string, f Fields)
+}
+
+// RedactedValue replaces secrets, like the X-Api-Key header, on log entries
+const RedactedValue = "[REDACTED]"
+
+// SetStructuredLogger sets a logger to receive the entries of the client as
+// fields
+func (c *client) SetStructuredLogger(logger StructuredLogger) Client {
+	c.logger = logger
+	return c
+}
+
+// SetDebugLogger sets a logger to receive the entries of the client as
+// lines, including the responses
+//
+// Deprecated: use SetStructuredLogger instead
 func (c *client) SetDebugLogger(logger Logger) Client {
-	c.debugLogger = logger
+	c.printf.debug = logger
 	return c
 }
 
-func (c *client) debugf(format string, v ...interface{}) {
-	if c.debugLogger == nil {
-		return
+// SetInfoLogger sets a logger to receive the entries of the client as lines
+//
+// Deprecated: use SetStructuredLogger instead
+func (c *client) SetInfoLogger(logger Logger) Client {
+	c.printf.info = logger
+	return c
+}
+
+// printfLogger adapts the loggers of SetInfoLogger and SetDebugLogger into a
+// StructuredLogger, when there is a debug logger the entries are only sent to
+// it
+type printfLogger struct {
+	info  Logger
+	debug Logger
+}
+
+func (l printfLogger) Enabled(level LogLevel) bool {
+	if level == LogDebug {
+		return l.debug != nil
 	}
 
-	c.debugLogger.Printf(format, v...)
+	return l.info != nil || l.debug != nil
 }
 
-// SetInfoLogger info logger
-func (c *client) SetInfoLogger(logger Logger) Client {
-	c.infoLogger = logger
-	return c
+func (l printfLogger) Log(level LogLevel, msg string, f Fields) {
+	logger := l.info
+	if l.debug != nil {
+		logger = l.debug
+	}
+
+	keys := make([]string, 0, len(f))
+	for k := range f {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	b := strings.Builder{}
+	b.WriteString(msg)
+	for _, k := range keys {
+		b.WriteString(fmt.Sprintf(" %s=%v", k, f[k]))
+	}
+
+	logger.Print(b.String())
 }
 
-func (c *client) infof(format string, v ...interface{}) {
-	if c.infoLogger == nil {
+// loggers returns the loggers that will receive the entries of the client
+func (c *client) loggers() []StructuredLogger {
+	ls := make([]StructuredLogger, 0, 2)
+	if c.logger != nil {
+		ls = append(ls, c.logger)
+	}
+
+	if c.printf.Enabled(LogInfo) {
+		ls = append(ls, c.printf)
+	}
+
+	return ls
+}
+
+func (c *client) log(level LogLevel, msg string, f Fields) {
+	for _, l := range c.loggers() {
+		if l.Enabled(level) {
+			l.Log(level, msg, f)
+		}
+	}
+}
+
+// logRequest logs the request and its response, the bodies and headers are
+// only logged on debug level
+func (c *client) logRequest(
+	name string, req *http.Request, r *http.Response, buf *bytes.Buffer,
+	latency time.Duration,
+) {
+	for _, l := range c.loggers() {
+		if l.Enabled(LogInfo) {
+			logRequest(l, name, req, r, buf, latency)
+		}
+	}
+}
+
+func logRequest(
+	l StructuredLogger, name string, req *http.Request, r *http.Response,
+	buf *bytes.Buffer, latency time.Duration,
+) {
+	f := Fields{
+		"name":       name,
+		"method":     req.Method,
+		"url":        req.URL.String(),
+		"status":     r.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+	}
+
+	if !l.Enabled(LogDebug) {
+		l.Log(LogInfo, "request", f)
 		return
 	}
 
-	c.infoLogger.Printf(format, v...)
+	h := req.Header.Clone()
+	if h.Get("X-Api-Key") != "" {
+		h.Set("X-Api-Key", RedactedValue)
+	}
+	f["request_headers"] = h
+
+	if req.GetBody != nil {
+		if b, err := req.GetBody(); err == nil {
+			body, _ := io.ReadAll(b)
+			f["request_body"] = string(body)
+		}
+	}
+
+	f["response_body"] = buf.String()
+	l.Log(LogDebug, "request", f)
 }
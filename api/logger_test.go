@@ -0,0 +1,130 @@
+package api_test
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/stretchr/testify/assert"
+)
+
+type entry struct {
+	level  api.LogLevel
+	msg    string
+	fields api.Fields
+}
+
+type recordLogger struct {
+	m       sync.Mutex
+	debug   bool
+	entries []entry
+}
+
+func (l *recordLogger) Enabled(level api.LogLevel) bool {
+	return level == api.LogInfo || l.debug
+}
+
+func (l *recordLogger) Log(level api.LogLevel, msg string, f api.Fields) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.entries = append(l.entries, entry{level: level, msg: msg, fields: f})
+}
+
+func TestClientStructuredLogger(t *testing.T) {
+	s, _ := newRetryServer(t, "GET", []response{
+		{status: 429, retryAfter: "0"},
+		{status: 200, body: "[]"},
+	})
+
+	l := &recordLogger{}
+	_, err := newRetryClient(t, s.URL, 1).
+		SetStructuredLogger(l).
+		GetWorkspaces(api.GetWorkspaces{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if !assert.Len(t, l.entries, 3) {
+		return
+	}
+
+	assert.Equal(t, "request", l.entries[0].msg)
+	assert.Equal(t, 429, l.entries[0].fields["status"])
+	assert.Equal(t, "retrying", l.entries[1].msg)
+	assert.Equal(t, "status 429", l.entries[1].fields["reason"])
+
+	e := l.entries[2]
+	assert.Equal(t, api.LogInfo, e.level)
+	assert.Equal(t, "GetWorkspaces", e.fields["name"])
+	assert.Equal(t, "GET", e.fields["method"])
+	assert.Equal(t, s.URL+"/v1/workspaces", e.fields["url"])
+	assert.Equal(t, 200, e.fields["status"])
+	assert.Contains(t, e.fields, "latency_ms")
+	assert.NotContains(t, e.fields, "response_body")
+}
+
+func TestClientStructuredLoggerDebug(t *testing.T) {
+	s, _ := newRetryServer(t, "GET", []response{
+		{status: 200, body: "[]"},
+	})
+
+	l := &recordLogger{debug: true}
+	_, err := newRetryClient(t, s.URL, 0).
+		SetStructuredLogger(l).
+		GetWorkspaces(api.GetWorkspaces{})
+	if !assert.NoError(t, err) || !assert.Len(t, l.entries, 1) {
+		return
+	}
+
+	e := l.entries[0]
+	assert.Equal(t, api.LogDebug, e.level)
+	assert.Equal(t, "[]", e.fields["response_body"])
+	assert.Contains(t, e.fields["request_headers"], "X-Api-Key")
+	assert.NotContains(t, e.fields["request_headers"], "a-key")
+}
+
+func TestClientDeprecatedLoggers(t *testing.T) {
+	s, _ := newRetryServer(t, "GET", []response{
+		{status: 429, retryAfter: "0"},
+		{status: 200, body: "[]"},
+		{status: 200, body: "[]"},
+	})
+
+	info := new(bytes.Buffer)
+	l := &recordLogger{}
+	c := newRetryClient(t, s.URL, 1).
+		SetStructuredLogger(l).
+		SetInfoLogger(log.New(info, "", 0))
+
+	_, err := c.GetWorkspaces(api.GetWorkspaces{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	lines := strings.Split(strings.TrimSpace(info.String()), "\n")
+	if !assert.Len(t, lines, 3) {
+		return
+	}
+
+	assert.Contains(t, lines[0], "request")
+	assert.Contains(t, lines[0], "status=429")
+	assert.Contains(t, lines[1], "retrying")
+	assert.Contains(t, lines[2], "name=GetWorkspaces")
+	assert.NotContains(t, lines[2], "response_body")
+	assert.Len(t, l.entries, 3, "structured logger should still be used")
+
+	debug := new(bytes.Buffer)
+	info.Reset()
+	_, err = c.SetDebugLogger(log.New(debug, "", 0)).
+		GetWorkspaces(api.GetWorkspaces{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Empty(t, info.String())
+	assert.Contains(t, debug.String(), "response_body=[]")
+	assert.NotContains(t, debug.String(), "a-key")
+}
@@ -0,0 +1,163 @@
+package api_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+// newPagesServer serves total entities split in pages, failing the page
+// failPage with a server error; it returns how many requests were at the
+// same time at most
+func newPagesServer(t *testing.T, total, failPage int) (
+	*httptest.Server, func() int) {
+	var m sync.Mutex
+	running, max := 0, 0
+
+	s := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			m.Lock()
+			running++
+			if running > max {
+				max = running
+			}
+			m.Unlock()
+
+			defer func() {
+				m.Lock()
+				running--
+				m.Unlock()
+			}()
+
+			time.Sleep(10 * time.Millisecond)
+
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			size, _ := strconv.Atoi(r.URL.Query().Get("page-size"))
+			if page == failPage {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"code":400,"message":"failed"}`))
+				return
+			}
+
+			b := "["
+			for i := (page - 1) * size; i < page*size && i < total; i++ {
+				if b != "[" {
+					b = b + ","
+				}
+				b = b + fmt.Sprintf(`{"id":"%03d","name":"e%03d"}`, i, i)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(b + "]"))
+		}))
+	t.Cleanup(s.Close)
+
+	return s, func() int {
+		m.Lock()
+		defer m.Unlock()
+		return max
+	}
+}
+
+func newPagesClient(t *testing.T, url string, concurrency int) api.Client {
+	c, err := api.NewClientFromUrlAndKey("a-key", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c.SetRetryPolicy(api.RetryPolicy{}).SetConcurrency(concurrency)
+}
+
+func TestPaginateConcurrently(t *testing.T) {
+	s, max := newPagesServer(t, 95, 0)
+	c := newPagesClient(t, s.URL, 3)
+
+	tags, err := c.GetTags(api.GetTagsParam{
+		Workspace:       exampleID,
+		PaginationParam: api.PaginationParam{AllPages: true, PageSize: 10},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if !assert.Len(t, tags, 95) {
+		return
+	}
+	for i := range tags {
+		assert.Equal(t, fmt.Sprintf("%03d", i), tags[i].ID)
+	}
+
+	assert.Equal(t, 3, max())
+}
+
+func TestPaginateWithoutConcurrency(t *testing.T) {
+	s, max := newPagesServer(t, 25, 0)
+	c := newPagesClient(t, s.URL, 0)
+
+	tags, err := c.GetTags(api.GetTagsParam{
+		Workspace:       exampleID,
+		PaginationParam: api.PaginationParam{AllPages: true, PageSize: 10},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Len(t, tags, 25)
+	assert.Equal(t, 1, max())
+}
+
+func TestPaginateStopsOnError(t *testing.T) {
+	s, _ := newPagesServer(t, 95, 4)
+	c := newPagesClient(t, s.URL, 4)
+
+	pages := 0
+	err := c.GetProjectsByPage(api.GetProjectsParam{
+		Workspace:       exampleID,
+		PaginationParam: api.PaginationParam{AllPages: true, PageSize: 10},
+	}, func(ps []dto.Project) error {
+		pages++
+		assert.Equal(t, fmt.Sprintf("%03d", (pages-1)*10), ps[0].ID)
+		return nil
+	})
+
+	var apiErr dto.Error
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, 400, apiErr.Code)
+	}
+	assert.Equal(t, 3, pages, "pages before the failed one are sent")
+}
+
+func TestGetProjectsByPage(t *testing.T) {
+	s, _ := newPagesServer(t, 35, 0)
+	c := newPagesClient(t, s.URL, 2)
+
+	stop := errors.New("stop")
+	ids := []string{}
+	err := c.GetProjectsByPage(api.GetProjectsParam{
+		Workspace:       exampleID,
+		PaginationParam: api.PaginationParam{AllPages: true, PageSize: 10},
+	}, func(ps []dto.Project) error {
+		for _, p := range ps {
+			ids = append(ids, p.ID)
+		}
+
+		if len(ids) == 20 {
+			return stop
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, stop)
+	if assert.Len(t, ids, 20) {
+		assert.Equal(t, "019", ids[19])
+	}
+}
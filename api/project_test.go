@@ -10,6 +10,7 @@ import (
 
 func TestUpdateProjectMemberships(t *testing.T) {
 	exampleID2 := "62f2af744a912b05acc7c792"
+	exampleID3 := "62f2af744a912b05acc7c793"
 	errPrefix := `update project memberships: `
 	uri := "/v1/workspaces/" + exampleID +
 		"/projects/" + exampleID +
@@ -107,7 +108,7 @@ func TestUpdateProjectMemberships(t *testing.T) {
 			requestMethod: "patch",
 			requestUrl:    uri,
 			requestBody: `{"memberships":[{
-				"userId":"` + exampleID + `", "hourlyRate":{"amount":0}
+				"userId":"` + exampleID + `"
 			}]}`,
 
 			responseStatus: 200,
@@ -121,6 +122,11 @@ func TestUpdateProjectMemberships(t *testing.T) {
 				Memberships: []api.UpdateMembership{
 					{UserOrGroupID: exampleID},
 					{UserOrGroupID: exampleID2, HourlyRateAmount: 10},
+					{
+						UserOrGroupID: exampleID3,
+						HourlyRate:    &dto.Rate{Amount: 20, Currency: "USD"},
+						CostRate:      &dto.Rate{Amount: 5},
+					},
 				},
 			},
 
@@ -129,8 +135,11 @@ func TestUpdateProjectMemberships(t *testing.T) {
 			requestMethod: "patch",
 			requestUrl:    uri,
 			requestBody: `{"memberships":[
-				{"userId":"` + exampleID + `", "hourlyRate":{"amount":0}},
-				{"userId":"` + exampleID2 + `", "hourlyRate":{"amount":10}}
+				{"userId":"` + exampleID + `"},
+				{"userId":"` + exampleID2 + `", "hourlyRate":{"amount":10}},
+				{"userId":"` + exampleID3 + `",
+					"hourlyRate":{"amount":20,"currency":"USD"},
+					"costRate":{"amount":5}}
 			]}`,
 
 			responseStatus: 200,
@@ -629,6 +638,7 @@ func TestUpdateProjectTemplate(t *testing.T) {
 }
 
 func TestUpdateProjectEstimate(t *testing.T) {
+	bTrue := true
 	errPrefix := "update project estimate: "
 	tts := []simpleTestCase{
 		{
@@ -819,6 +829,34 @@ func TestUpdateProjectEstimate(t *testing.T) {
 
 			responseStatus: 200,
 		},
+		{
+			name: "set monthly estimate with time including non-billable",
+			param: api.UpdateProjectEstimateParam{
+				ProjectID:          exampleID,
+				Workspace:          exampleID,
+				Method:             api.EstimateMethodTime,
+				Type:               api.EstimateTypeProject,
+				ResetOption:        api.EstimateResetOptionMonthly,
+				Estimate:           int64(time.Hour) * 20,
+				IncludeNonBillable: &bTrue,
+			},
+
+			requestMethod: "patch",
+			requestUrl: "/v1/workspaces/" + exampleID +
+				"/projects/" + exampleID + "/estimate",
+			requestBody: `{
+				"budgetEstimate": {"active": false},
+				"timeEstimate": {
+					"active": true,
+					"estimate": "PT20H0M0S",
+					"type": "MANUAL",
+					"resetOption": "MONTHLY",
+					"includeNonBillable": true
+				}
+			}`,
+
+			responseStatus: 200,
+		},
 		{
 			name: "set estimate to none for project",
 			param: api.UpdateProjectEstimateParam{
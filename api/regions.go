@@ -0,0 +1,40 @@
+package api
+
+// Region is a Clockify data region, each one has its own API endpoints
+type Region struct {
+	Name       string
+	APIURL     string
+	ReportsURL string
+}
+
+// GlobalRegion is the region used when no other is set
+var GlobalRegion = Region{
+	Name:       "Global",
+	APIURL:     baseURL,
+	ReportsURL: "https://reports.api.clockify.me",
+}
+
+// Regions lists the known Clockify regions
+var Regions = []Region{
+	GlobalRegion,
+	{
+		Name:       "EU (Germany)",
+		APIURL:     "https://euc1.clockify.me/api",
+		ReportsURL: "https://euc1.clockify.me/report",
+	},
+	{
+		Name:       "USA",
+		APIURL:     "https://use2.clockify.me/api",
+		ReportsURL: "https://use2.clockify.me/report",
+	},
+	{
+		Name:       "UK",
+		APIURL:     "https://euw2.clockify.me/api",
+		ReportsURL: "https://euw2.clockify.me/report",
+	},
+	{
+		Name:       "AU",
+		APIURL:     "https://apse2.clockify.me/api",
+		ReportsURL: "https://apse2.clockify.me/report",
+	},
+}
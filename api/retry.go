@@ -0,0 +1,196 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy sets how the client retries requests that failed because of
+// the rate limit (429) or a temporary failure of the server (502, 503 and
+// 504 or network errors)
+type RetryPolicy struct {
+	// MaxRetries is how many times a request will be retried, zero disables
+	// the retries
+	MaxRetries int
+	// MinWait is how long to wait before the first retry, it doubles on
+	// each retry
+	MinWait time.Duration
+	// MaxWait limits how long to wait between retries, even when the server
+	// asks to wait longer with Retry-After
+	MaxWait time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy used by new clients
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinWait:    500 * time.Millisecond,
+	MaxWait:    30 * time.Second,
+}
+
+// SetRetryPolicy changes how requests are retried
+func (c *client) SetRetryPolicy(p RetryPolicy) Client {
+	c.retryPolicy = p
+	return c
+}
+
+// SetRateLimit limits how many requests per second the client will send,
+// zero or less disables it
+func (c *client) SetRateLimit(perSecond int) Client {
+	c.limiter = nil
+	if perSecond > 0 {
+		c.limiter = newRateLimiter(perSecond)
+	}
+
+	return c
+}
+
+// doWithRetry sends the request, retrying it while the policy allows
+func (c *client) doWithRetry(req *http.Request, name string) (
+	*http.Response, *bytes.Buffer, error) {
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.wait(req.Context()); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		r, buf, err := c.send(req, name)
+		wait, reason, retry := c.shouldRetry(req, r, err, attempt)
+		if !retry {
+			return r, buf, err
+		}
+
+		c.log(LogInfo, "retrying", Fields{
+			"name":        name,
+			"wait":        wait.String(),
+			"attempt":     attempt + 1,
+			"max_retries": c.retryPolicy.MaxRetries,
+			"reason":      reason,
+		})
+
+		if req.GetBody != nil {
+			body, bErr := req.GetBody()
+			if bErr != nil {
+				return r, buf, err
+			}
+			req.Body = body
+		}
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return r, buf, err
+		}
+	}
+}
+
+// sleep waits for the duration, unless the context is done before it
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// shouldRetry tells if the request should be sent again, how long to wait
+// before it and why
+func (c *client) shouldRetry(
+	req *http.Request, r *http.Response, err error, attempt int,
+) (time.Duration, string, bool) {
+	if attempt >= c.retryPolicy.MaxRetries || req.Context().Err() != nil {
+		return 0, "", false
+	}
+
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, "", false
+	}
+
+	if err != nil {
+		if !isIdempotent(req.Method) {
+			return 0, "", false
+		}
+
+		return c.backoff(attempt), err.Error(), true
+	}
+
+	switch r.StatusCode {
+	case http.StatusTooManyRequests:
+	case http.StatusBadGateway, http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		if !isIdempotent(req.Method) {
+			return 0, "", false
+		}
+	default:
+		return 0, "", false
+	}
+
+	reason := fmt.Sprintf("status %d", r.StatusCode)
+	if wait, ok := retryAfter(r.Header.Get("Retry-After")); ok {
+		c.log(LogDebug, "retry after", Fields{
+			"reason":      reason,
+			"retry_after": r.Header.Get("Retry-After"),
+		})
+
+		if wait > c.retryPolicy.MaxWait {
+			wait = c.retryPolicy.MaxWait
+		}
+		return wait, reason, true
+	}
+
+	return c.backoff(attempt), reason, true
+}
+
+// backoff is the exponential wait for the attempt, with jitter to avoid
+// retrying multiple requests at the same time
+func (c *client) backoff(attempt int) time.Duration {
+	wait := c.retryPolicy.MinWait << attempt
+	if wait <= 0 || wait > c.retryPolicy.MaxWait {
+		wait = c.retryPolicy.MaxWait
+	}
+
+	if wait <= 1 {
+		return wait
+	}
+
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(wait-half)))
+}
+
+// retryAfter reads the Retry-After header, which can be in seconds or a date
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions,
+		http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,244 @@
+package api_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/stretchr/testify/assert"
+)
+
+type response struct {
+	status     int
+	retryAfter string
+	body       string
+}
+
+func newRetryServer(t *testing.T, method string, rs []response) (
+	*httptest.Server, *[]string) {
+	bodies := make([]string, 0)
+	s := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, method, r.Method)
+			b, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+
+			if len(bodies) > len(rs) {
+				t.Error("should not be called again")
+				w.WriteHeader(500)
+				return
+			}
+
+			res := rs[len(bodies)-1]
+			if res.retryAfter != "" {
+				w.Header().Set("Retry-After", res.retryAfter)
+			}
+			w.WriteHeader(res.status)
+			_, _ = w.Write([]byte(res.body))
+		}))
+	t.Cleanup(s.Close)
+
+	return s, &bodies
+}
+
+func newRetryClient(t *testing.T, url string, maxRetries int) api.Client {
+	c, err := api.NewClientFromUrlAndKey("a-key", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c.SetRetryPolicy(api.RetryPolicy{
+		MaxRetries: maxRetries,
+		MinWait:    time.Millisecond,
+		MaxWait:    5 * time.Millisecond,
+	})
+}
+
+func TestClientRetry(t *testing.T) {
+	getWorkspaces := func(c api.Client) error {
+		_, err := c.GetWorkspaces(api.GetWorkspaces{})
+		return err
+	}
+
+	addClient := func(c api.Client) error {
+		_, err := c.AddClient(api.AddClientParam{
+			Workspace: exampleID,
+			Name:      "Acme",
+		})
+		return err
+	}
+
+	tts := []struct {
+		name       string
+		method     string
+		maxRetries int
+		responses  []response
+		call       func(api.Client) error
+		err        string
+	}{
+		{
+			name:       "retries when rate limited",
+			method:     "GET",
+			maxRetries: 3,
+			responses: []response{
+				{status: 429, retryAfter: "0", body: `{"message":"slow down"}`},
+				{status: 429, body: `{"message":"slow down"}`},
+				{status: 200, body: "[]"},
+			},
+			call: getWorkspaces,
+		},
+		{
+			name:       "retries when unavailable",
+			method:     "GET",
+			maxRetries: 3,
+			responses: []response{
+				{status: 503},
+				{status: 502},
+				{status: 504},
+				{status: 200, body: "[]"},
+			},
+			call: getWorkspaces,
+		},
+		{
+			name:       "gives up after max retries",
+			method:     "GET",
+			maxRetries: 2,
+			responses: []response{
+				{status: 429, body: `{"message":"slow down"}`},
+				{status: 429, body: `{"message":"slow down"}`},
+				{status: 429, body: `{"message":"slow down", "code": 429}`},
+			},
+			call: getWorkspaces,
+			err:  "slow down",
+		},
+		{
+			name:       "does not retry when disabled",
+			method:     "GET",
+			maxRetries: 0,
+			responses: []response{
+				{status: 503, body: `{"message":"unavailable"}`},
+			},
+			call: getWorkspaces,
+			err:  "unavailable",
+		},
+		{
+			name:       "does not retry other errors",
+			method:     "GET",
+			maxRetries: 3,
+			responses: []response{
+				{status: 500, body: `{"message":"server error"}`},
+			},
+			call: getWorkspaces,
+			err:  "server error",
+		},
+		{
+			name:       "does not retry non idempotent request when unavailable",
+			method:     "POST",
+			maxRetries: 3,
+			responses: []response{
+				{status: 503, body: `{"message":"unavailable"}`},
+			},
+			call: addClient,
+			err:  "unavailable",
+		},
+		{
+			name:       "retries non idempotent request when rate limited",
+			method:     "POST",
+			maxRetries: 3,
+			responses: []response{
+				{status: 429, retryAfter: "0"},
+				{status: 201, body: `{"id":"c1","name":"Acme"}`},
+			},
+			call: addClient,
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			s, bodies := newRetryServer(t, tt.method, tt.responses)
+			err := tt.call(newRetryClient(t, s.URL, tt.maxRetries))
+
+			assert.Len(t, *bodies, len(tt.responses))
+			for _, b := range *bodies {
+				assert.Equal(t, (*bodies)[0], b, "should resend the body")
+			}
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			if assert.Error(t, err) {
+				assert.Regexp(t, tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestClientRetryIsLogged(t *testing.T) {
+	s, _ := newRetryServer(t, "GET", []response{
+		{status: 429, retryAfter: "0"},
+		{status: 200, body: "[]"},
+	})
+
+	l := &recordLogger{}
+	c := newRetryClient(t, s.URL, 1).SetStructuredLogger(l)
+
+	_, err := c.GetWorkspaces(api.GetWorkspaces{})
+	assert.NoError(t, err)
+	assert.Contains(t, l.entries, entry{
+		level: api.LogInfo,
+		msg:   "retrying",
+		fields: api.Fields{
+			"name":        "GetWorkspaces",
+			"wait":        "0s",
+			"attempt":     1,
+			"max_retries": 1,
+			"reason":      "status 429",
+		},
+	})
+}
+
+func TestClientRetryAfterIsLimitedByMaxWait(t *testing.T) {
+	s, _ := newRetryServer(t, "GET", []response{
+		{status: 429, retryAfter: "3600"},
+		{status: 200, body: "[]"},
+	})
+
+	l := &recordLogger{}
+	c := newRetryClient(t, s.URL, 1).SetStructuredLogger(l)
+
+	start := time.Now()
+	_, err := c.GetWorkspaces(api.GetWorkspaces{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Less(t, time.Since(start), time.Second)
+	if assert.Len(t, l.entries, 3) {
+		assert.Equal(t, "retrying", l.entries[1].msg)
+		assert.Equal(t, "5ms", l.entries[1].fields["wait"])
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	rs := make([]response, 4)
+	for i := range rs {
+		rs[i] = response{status: 200, body: "[]"}
+	}
+
+	s, _ := newRetryServer(t, "GET", rs)
+	c := newRetryClient(t, s.URL, 0).SetRateLimit(2)
+
+	start := time.Now()
+	for range rs {
+		_, err := c.GetWorkspaces(api.GetWorkspaces{})
+		assert.NoError(t, err)
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond,
+		"should wait for tokens after the first two requests")
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2/terminal"
@@ -21,23 +23,26 @@ var (
 	date    = "unknown"
 )
 
-const (
-	exitOK     = 0
-	exitError  = 1
-	exitCancel = 2
-)
-
 func main() {
 	exitCode := execute()
 	os.Exit(exitCode)
 }
 
 func execute() int {
-	f := cmdutil.NewFactory(cmdutil.Version{
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		// a second interrupt should stop the CLI at once
+		<-ctx.Done()
+		stop()
+	}()
+
+	f := cmdutil.NewFactory(ctx, cmdutil.Version{
 		Tag:    version,
 		Commit: commit,
 		Date:   date,
 	})
+	defer f.Close()
 
 	rootCmd := cmd.NewCmdRoot(f)
 	rootCmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
@@ -48,24 +53,36 @@ func execute() int {
 	err := bindViper(rootCmd)
 
 	if err == nil {
-		cmd, err = rootCmd.ExecuteC()
+		cmd, err = rootCmd.ExecuteContextC(ctx)
 	}
 
 	if err == nil {
-		return exitOK
+		return cmdutil.ExitOK
 	}
 
 	stderr := cmd.ErrOrStderr()
 	if errors.Is(err, terminal.InterruptErr) {
 		fmt.Fprintln(stderr)
-		return exitCancel
+		return cmdutil.ExitCancel
+	}
+
+	if ctx.Err() != nil {
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "operation was cancelled")
+		return cmdutil.ExitCancel
+	}
+
+	if errors.Is(f.Context().Err(), context.DeadlineExceeded) {
+		fmt.Fprintf(stderr, "operation took longer than the timeout (%s)\n",
+			f.Config().GetString(cmdutil.CONF_TIMEOUT))
+		return cmdutil.ExitError
 	}
 
 	var flagError *cmdutil.FlagError
 	if errors.As(err, &flagError) {
 		fmt.Fprintln(stderr, flagError.Error())
 		fmt.Fprintln(stderr, cmd.UsageString())
-		return exitError
+		return cmdutil.ExitError
 	}
 
 	if f.Config().IsDebuging() {
@@ -74,7 +91,16 @@ func execute() int {
 		fmt.Fprintln(stderr, err.Error())
 	}
 
-	return exitError
+	code := cmdutil.ExitCode(err)
+	if hint := cmdutil.ErrorHint(err); hint != "" {
+		fmt.Fprintln(stderr, hint)
+	} else if code == cmdutil.ExitNetworkError &&
+		!f.Config().GetBool(cmdutil.CONF_OFFLINE) {
+		fmt.Fprintln(stderr, "could not reach clockify, use --offline to "+
+			"record the changes and run sync later")
+	}
+
+	return code
 }
 
 func bindViper(rootCmd *cobra.Command) error {
@@ -110,6 +136,15 @@ func bindViper(rootCmd *cobra.Command) error {
 		return err
 	}
 
+	err = bind(l("log-format"), cmdutil.CONF_LOG_FORMAT, "LOG_FORMAT")
+	if err != nil {
+		return err
+	}
+
+	if err = bind(l("log-file"), cmdutil.CONF_LOG_FILE, "LOG_FILE"); err != nil {
+		return err
+	}
+
 	viper.RegisterAlias(cmdutil.CONF_ALLOW_NAME_FOR_ID, "allow-project-name")
 	if err = bind(l("allow-name-for-id"), cmdutil.CONF_ALLOW_NAME_FOR_ID,
 		"ALLOW_NAME_FOR_ID"); err != nil {
@@ -127,6 +162,15 @@ func bindViper(rootCmd *cobra.Command) error {
 		return err
 	}
 
+	if err = bind(l("no-cache"), cmdutil.CONF_NO_CACHE,
+		"NO_CACHE"); err != nil {
+		return err
+	}
+
+	if err = bind(l("offline"), cmdutil.CONF_OFFLINE, "OFFLINE"); err != nil {
+		return err
+	}
+
 	f := l("interactive")
 	f.Usage = f.Usage + "\n" +
 		"You can be disable it temporally by setting it to 0 " +
@@ -177,6 +221,16 @@ func bindViper(rootCmd *cobra.Command) error {
 		viper.SetEnvPrefix(envPrefix)
 		viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 		viper.AutomaticEnv()
+		_ = viper.BindEnv(cmdutil.CONF_LOG_LEVEL, envPrefix+"_LOG_LEVEL")
+		_ = viper.BindEnv(cmdutil.CONF_LOG_FORMAT, envPrefix+"_LOG_FORMAT")
+		_ = viper.BindEnv(cmdutil.CONF_LOG_FILE, envPrefix+"_LOG_FILE")
+		_ = viper.BindEnv(cmdutil.CONF_API_URL, envPrefix+"_API_URL")
+		_ = viper.BindEnv(
+			cmdutil.CONF_REPORTS_API_URL, envPrefix+"_REPORTS_API_URL")
+		_ = viper.BindEnv(cmdutil.CONF_CACHE_TTL, envPrefix+"_CACHE_TTL")
+		_ = viper.BindEnv(cmdutil.CONF_CACHE_DIR, envPrefix+"_CACHE_DIR")
+		_ = viper.BindEnv(
+			cmdutil.CONF_JOURNAL_FILE, envPrefix+"_JOURNAL_FILE")
 
 		err := viper.ReadInConfig()
 		if errors.As(err, &viper.ConfigFileNotFoundError{}) {
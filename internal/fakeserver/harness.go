@@ -0,0 +1,132 @@
+package fakeserver
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/spf13/viper"
+)
+
+// Run executes the CLI with the arguments against the server, returning
+// what was printed by the command
+func (s *Server) Run(c cmdutil.Config, args ...string) (string, error) {
+	root := cmd.NewCmdRoot(s.Factory(c))
+	if err := root.PersistentFlags().Set("token", s.Token); err != nil {
+		return "", err
+	}
+
+	out := bytes.NewBufferString("")
+	root.SetOut(out)
+	root.SetErr(out)
+	root.SetArgs(args)
+
+	_, err := root.ExecuteC()
+	return out.String(), err
+}
+
+// NewConfig creates a config with the values set, which is saved on a
+// temporary directory
+func NewConfig(t testing.TB, values map[string]interface{}) cmdutil.Config {
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(t.TempDir(), ".clockify-cli.yaml"))
+	for k := range values {
+		v.Set(k, values[k])
+	}
+
+	return cmdutil.NewConfig(v)
+}
+
+// Factory creates a cmdutil.Factory whose client talks with the server
+func (s *Server) Factory(c cmdutil.Config) cmdutil.Factory {
+	return &factory{server: s, config: c}
+}
+
+type factory struct {
+	server *Server
+	config cmdutil.Config
+	client api.Client
+}
+
+func (f *factory) Version() cmdutil.Version {
+	return cmdutil.Version{Tag: "fake"}
+}
+
+func (f *factory) Config() cmdutil.Config {
+	return f.config
+}
+
+func (f *factory) Context() context.Context {
+	return context.Background()
+}
+
+func (f *factory) Client() (api.Client, error) {
+	if f.client != nil {
+		return f.client, nil
+	}
+
+	c, err := api.NewClientFromUrlAndKey(f.server.Token, f.server.URL())
+	if err != nil {
+		return nil, err
+	}
+
+	f.client = c.SetRetryPolicy(api.RetryPolicy{})
+	return f.client, nil
+}
+
+func (f *factory) UI() ui.UI {
+	return ui.NewUI(os.Stdin, os.Stdout, os.Stderr)
+}
+
+func (f *factory) GetUserID() (string, error) {
+	if id := f.config.GetString(cmdutil.CONF_USER_ID); id != "" {
+		return id, nil
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return "", err
+	}
+
+	u, err := c.GetMe()
+	return u.ID, err
+}
+
+func (f *factory) GetWorkspaceID() (string, error) {
+	if id := f.config.GetString(cmdutil.CONF_WORKSPACE); id != "" {
+		return id, nil
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return "", err
+	}
+
+	u, err := c.GetMe()
+	return u.DefaultWorkspace, err
+}
+
+func (f *factory) GetWorkspace() (dto.Workspace, error) {
+	id, err := f.GetWorkspaceID()
+	if err != nil {
+		return dto.Workspace{}, err
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return dto.Workspace{}, err
+	}
+
+	return c.GetWorkspace(api.GetWorkspace{ID: id})
+}
+
+func (f *factory) Close() error {
+	return nil
+}
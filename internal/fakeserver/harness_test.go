@@ -0,0 +1,264 @@
+package fakeserver_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/fakeserver"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	s := fakeserver.New(t)
+	sd := newSeed(s)
+
+	c := fakeserver.NewConfig(t, map[string]interface{}{
+		cmdutil.CONF_WORKSPACE: sd.workspace.ID,
+		cmdutil.CONF_USER_ID:   sd.user.ID,
+	})
+
+	out, err := s.Run(c, "project", "list", "-q")
+	if assert.NoError(t, err) {
+		assert.Equal(t, sd.project.ID+"\n", out)
+	}
+
+	out, err = s.Run(c, "in", "-q",
+		"-p", sd.project.ID,
+		"--task", sd.task.ID,
+		"-T", sd.tag.ID,
+		"-d", "writing tests",
+		"-s", "2022-12-01 09:00",
+	)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tes := s.TimeEntries()
+	if !assert.Len(t, tes, 1) {
+		return
+	}
+	assert.Equal(t, tes[0].ID+"\n", out)
+
+	out, err = s.Run(c, "show", "current",
+		"--format", "{{ .Project.Name }}/{{ .Task.Name }}: {{ .Description }}")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "CLI/Tests: writing tests\n", out)
+	}
+
+	_, err = s.Run(c, "out", "--when", "2022-12-01 10:30")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	i := s.TimeEntries()[0].TimeInterval
+	if assert.NotNil(t, i.End) {
+		assert.Equal(t, 90*time.Minute, i.End.Sub(i.Start))
+	}
+
+	_, err = s.Run(c, "show", "current")
+	assert.Error(t, err, "there is no time entry running")
+}
+
+func TestRunWithCustomFields(t *testing.T) {
+	s := fakeserver.New(t)
+	sd := newSeed(s)
+	ticket := s.AddCustomField(dto.WorkspaceCustomField{
+		WorkspaceID: sd.workspace.ID,
+		Name:        "Ticket",
+		Type:        dto.CustomFieldTypeText,
+		Required:    true,
+	})
+	priority := s.AddCustomField(dto.WorkspaceCustomField{
+		WorkspaceID:   sd.workspace.ID,
+		Name:          "Priority",
+		Type:          dto.CustomFieldTypeDropdownSingle,
+		AllowedValues: []string{"Low", "High"},
+	})
+
+	c := fakeserver.NewConfig(t, map[string]interface{}{
+		cmdutil.CONF_WORKSPACE:         sd.workspace.ID,
+		cmdutil.CONF_USER_ID:           sd.user.ID,
+		cmdutil.CONF_ALLOW_NAME_FOR_ID: true,
+	})
+
+	_, err := s.Run(c, "in", "-q", "-p", "cli", "-d", "no ticket",
+		"-s", "2022-12-01 09:00")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(),
+			`workspace requires custom field "Ticket"`)
+	}
+
+	_, err = s.Run(c, "manual", "-q", "-p", "cli", "-d", "bad priority",
+		"--custom-field", "ticket=TK-1", "--custom-field", "priority=max",
+		"-s", "2022-12-01 09:00", "-e", "2022-12-01 10:00")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(),
+			`value "max" is not allowed for custom field "Priority"`)
+	}
+
+	id, err := s.Run(c, "manual", "-q", "-p", "cli", "-d", "with ticket",
+		"--custom-field", "ticket=TK-1",
+		"-s", "2022-12-01 09:00", "-e", "2022-12-01 10:00")
+	if !assert.NoError(t, err) {
+		return
+	}
+	id = strings.TrimSpace(id)
+
+	_, err = s.Run(c, "edit", id, "-q", "--custom-field", "Priority=high")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tes := s.TimeEntries()
+	if assert.Len(t, tes, 1) {
+		assert.ElementsMatch(t, []dto.CustomFieldValue{
+			{CustomFieldID: ticket.ID, Value: "TK-1"},
+			{CustomFieldID: priority.ID, Value: "High"},
+		}, tes[0].CustomFields)
+	}
+
+	out, err := s.Run(c, "show", id, "--csv")
+	if assert.NoError(t, err) {
+		lines := strings.Split(out, "\n")
+		assert.Contains(t, lines[0], "customField.Ticket")
+		assert.Contains(t, lines[1], "TK-1,High")
+	}
+
+	out, err = s.Run(c, "report", "2022-12-01", "2022-12-01", "-q",
+		"--custom-field", "priority=low")
+	if assert.NoError(t, err) {
+		assert.Empty(t, out)
+	}
+
+	out, err = s.Run(c, "report", "2022-12-01", "2022-12-01", "-q",
+		"--custom-field", "ticket=tk-1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, id+"\n", out)
+	}
+}
+
+func TestRunSplit(t *testing.T) {
+	s := fakeserver.New(t)
+	sd := newSeed(s)
+
+	c := fakeserver.NewConfig(t, map[string]interface{}{
+		cmdutil.CONF_WORKSPACE: sd.workspace.ID,
+		cmdutil.CONF_USER_ID:   sd.user.ID,
+	})
+
+	id, err := s.Run(c, "in", "-q",
+		"-p", sd.project.ID,
+		"-T", sd.tag.ID,
+		"-d", "writing tests",
+		"-s", "2022-12-01 09:00",
+	)
+	if !assert.NoError(t, err) {
+		return
+	}
+	id = strings.TrimSpace(id)
+
+	_, err = s.Run(c, "split", id, "--at", "2022-12-01 08:00")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "split point 2022-12-01 08:00:00")
+	}
+
+	out, err := s.Run(c, "split", "current", "-q",
+		"--at", "2022-12-01 10:00",
+		"--at", "2022-12-01 09:30",
+		"-d", "splitting",
+	)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tes := s.TimeEntries()
+	if !assert.Len(t, tes, 3) {
+		return
+	}
+
+	ids := strings.Split(strings.TrimSpace(out), "\n")
+	assert.Equal(t, []string{tes[0].ID, tes[1].ID, tes[2].ID}, ids)
+	assert.Equal(t, id, tes[0].ID)
+
+	at := func(s string) time.Time {
+		v, _ := time.ParseInLocation("2006-01-02 15:04", s, time.Local)
+		return v
+	}
+
+	assert.Equal(t, "writing tests", tes[0].Description)
+	assert.True(t, at("2022-12-01 09:00").Equal(tes[0].TimeInterval.Start))
+	if assert.NotNil(t, tes[0].TimeInterval.End) {
+		assert.True(t, at("2022-12-01 09:30").Equal(*tes[0].TimeInterval.End))
+	}
+
+	assert.True(t, at("2022-12-01 09:30").Equal(tes[1].TimeInterval.Start))
+	if assert.NotNil(t, tes[1].TimeInterval.End) {
+		assert.True(t, at("2022-12-01 10:00").Equal(*tes[1].TimeInterval.End))
+	}
+
+	assert.True(t, at("2022-12-01 10:00").Equal(tes[2].TimeInterval.Start))
+	assert.Nil(t, tes[2].TimeInterval.End, "last part should be running")
+
+	for _, te := range tes[1:] {
+		assert.Equal(t, "splitting", te.Description)
+		assert.Equal(t, sd.project.ID, te.ProjectID)
+		assert.Equal(t, []string{sd.tag.ID}, te.TagIDs)
+		assert.True(t, te.Billable)
+	}
+
+	out, err = s.Run(c, "show", "current", "-q")
+	if assert.NoError(t, err) {
+		assert.Equal(t, tes[2].ID+"\n", out)
+	}
+}
+
+func TestRunProjectMembersKeepsRates(t *testing.T) {
+	s := fakeserver.New(t)
+	sd := newSeed(s)
+	other := s.AddUser(sd.workspace.ID, dto.User{Name: "Joana D'Arc"})
+	added := s.AddUser(sd.workspace.ID, dto.User{Name: "John Smith"})
+
+	pr := s.AddProject(dto.Project{
+		WorkspaceID: sd.workspace.ID,
+		Name:        "Rates",
+		Memberships: []dto.Membership{
+			{
+				UserID:     sd.user.ID,
+				HourlyRate: &dto.Rate{Amount: 100, Currency: "USD"},
+				CostRate:   &dto.Rate{Amount: 50, Currency: "USD"},
+			},
+			{UserID: other.ID},
+		},
+	})
+
+	c := fakeserver.NewConfig(t, map[string]interface{}{
+		cmdutil.CONF_WORKSPACE: sd.workspace.ID,
+		cmdutil.CONF_USER_ID:   sd.user.ID,
+	})
+
+	if _, err := s.Run(c, "project", "members", "add", "-q",
+		pr.ID, added.ID); !assert.NoError(t, err) {
+		return
+	}
+
+	var ms []dto.Membership
+	for _, p := range s.Projects() {
+		if p.ID == pr.ID {
+			ms = p.Memberships
+		}
+	}
+
+	if !assert.Len(t, ms, 3) {
+		return
+	}
+
+	assert.Equal(t, &dto.Rate{Amount: 100, Currency: "USD"}, ms[0].HourlyRate)
+	assert.Equal(t, &dto.Rate{Amount: 50, Currency: "USD"}, ms[0].CostRate)
+	for _, m := range ms[1:] {
+		assert.Nil(t, m.HourlyRate, "rates of %s should be inherited", m.UserID)
+		assert.Nil(t, m.CostRate, "rates of %s should be inherited", m.UserID)
+	}
+}
@@ -0,0 +1,478 @@
+package fakeserver
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+)
+
+// hydrateProject adds the tasks of the project to it
+func (s *Server) hydrateProject(p dto.Project) dto.Project {
+	p.Tasks = []dto.Task{}
+	for _, t := range s.tasks {
+		if t.ProjectID == p.ID {
+			p.Tasks = append(p.Tasks, t)
+		}
+	}
+
+	return p
+}
+
+func (s *Server) getProjects(
+	w http.ResponseWriter, r *http.Request, p params) {
+	q := r.URL.Query()
+	name := q.Get("name")
+	archived := boolParam(r, "archived")
+	hydrated := boolParam(r, "hydrated")
+
+	var clients []string
+	if c := q.Get("clients"); c != "" {
+		clients = strings.Split(c, ",")
+	}
+
+	ps := []dto.Project{}
+	for _, pr := range s.projects {
+		if pr.WorkspaceID != p["ws"] || !containsFold(pr.Name, name) ||
+			(archived != nil && pr.Archived != *archived) {
+			continue
+		}
+
+		if len(clients) > 0 && !strhlp.InSlice(pr.ClientID, clients) {
+			continue
+		}
+
+		if hydrated != nil && *hydrated {
+			pr = s.hydrateProject(pr)
+		}
+
+		ps = append(ps, pr)
+	}
+
+	sort.SliceStable(ps, func(i, j int) bool {
+		return strings.ToLower(ps[i].Name) < strings.ToLower(ps[j].Name)
+	})
+
+	start, end := page(r, len(ps))
+	writeJSON(w, http.StatusOK, ps[start:end])
+}
+
+func (s *Server) addProject(w http.ResponseWriter, r *http.Request, p params) {
+	var b dto.AddProjectRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	if strings.TrimSpace(b.Name) == "" {
+		writeError(w, http.StatusBadRequest, "Project name is required")
+		return
+	}
+
+	pr := dto.Project{
+		ID:          s.newID(),
+		WorkspaceID: p["ws"],
+		Name:        b.Name,
+		Note:        b.Note,
+		Color:       b.Color,
+		Billable:    b.Billable,
+		Public:      b.IsPublic || b.Public,
+		HourlyRate:  s.workspace(p["ws"]).HourlyRate,
+	}
+
+	if b.ClientId != "" {
+		c := s.client(p["ws"], b.ClientId)
+		if c == nil {
+			writeError(w, http.StatusBadRequest,
+				"Client doesn't belong to Workspace")
+			return
+		}
+
+		pr.ClientID = c.ID
+		pr.ClientName = c.Name
+	}
+
+	for _, o := range s.projects {
+		if o.WorkspaceID == pr.WorkspaceID && o.ClientID == pr.ClientID &&
+			strings.EqualFold(o.Name, pr.Name) {
+			writeError(w, http.StatusBadRequest,
+				"Project with name '"+b.Name+"' already exists")
+			return
+		}
+	}
+
+	s.projects = append(s.projects, pr)
+	writeJSON(w, http.StatusCreated, pr)
+}
+
+// findProject writes a not found error if the project does not exist
+func (s *Server) findProject(w http.ResponseWriter, p params) *dto.Project {
+	pr := s.project(p["ws"], p["project"])
+	if pr == nil {
+		writeError(w, http.StatusNotFound,
+			"Project with id "+p["project"]+" doesn't exist")
+	}
+
+	return pr
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request, p params) {
+	pr := s.findProject(w, p)
+	if pr == nil {
+		return
+	}
+
+	if h := boolParam(r, "hydrated"); h != nil && *h {
+		writeJSON(w, http.StatusOK, s.hydrateProject(*pr))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pr)
+}
+
+func (s *Server) updateProject(
+	w http.ResponseWriter, r *http.Request, p params) {
+	pr := s.findProject(w, p)
+	if pr == nil {
+		return
+	}
+
+	var b dto.UpdateProjectRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	if b.ClientId != nil {
+		c := s.client(p["ws"], *b.ClientId)
+		if *b.ClientId != "" && c == nil {
+			writeError(w, http.StatusBadRequest,
+				"Client doesn't belong to Workspace")
+			return
+		}
+
+		pr.ClientID = *b.ClientId
+		pr.ClientName = ""
+		if c != nil {
+			pr.ClientName = c.Name
+		}
+	}
+
+	if b.Name != nil {
+		pr.Name = *b.Name
+	}
+	if b.IsPublic != nil {
+		pr.Public = *b.IsPublic
+	}
+	if b.Color != nil {
+		pr.Color = *b.Color
+	}
+	if b.Note != nil {
+		pr.Note = *b.Note
+	}
+	if b.Billable != nil {
+		pr.Billable = *b.Billable
+	}
+	if b.Archived != nil {
+		pr.Archived = *b.Archived
+	}
+
+	writeJSON(w, http.StatusOK, pr)
+}
+
+func (s *Server) deleteProject(
+	w http.ResponseWriter, _ *http.Request, p params) {
+	pr := s.findProject(w, p)
+	if pr == nil {
+		return
+	}
+
+	if !pr.Archived {
+		writeError(w, http.StatusBadRequest, "Cannot delete an active project")
+		return
+	}
+
+	d := *pr
+	ps := s.projects[:0]
+	for _, o := range s.projects {
+		if o.ID != d.ID {
+			ps = append(ps, o)
+		}
+	}
+	s.projects = ps
+
+	ts := s.tasks[:0]
+	for _, t := range s.tasks {
+		if t.ProjectID != d.ID {
+			ts = append(ts, t)
+		}
+	}
+	s.tasks = ts
+
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) updateProjectMemberships(
+	w http.ResponseWriter, r *http.Request, p params) {
+	pr := s.findProject(w, p)
+	if pr == nil {
+		return
+	}
+
+	var b dto.UpdateProjectMembershipsRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	ms := make([]dto.Membership, len(b.Memberships))
+	for i, m := range b.Memberships {
+		ms[i] = dto.Membership{
+			UserID:     m.UserID,
+			HourlyRate: m.HourlyRate,
+			CostRate:   m.CostRate,
+			Status:     dto.MembershipStatusActive,
+			Type:       "PROJECT",
+			TargetID:   pr.ID,
+		}
+	}
+	pr.Memberships = ms
+
+	writeJSON(w, http.StatusOK, pr)
+}
+
+func (s *Server) updateProjectTemplate(
+	w http.ResponseWriter, r *http.Request, p params) {
+	pr := s.findProject(w, p)
+	if pr == nil {
+		return
+	}
+
+	var b dto.UpdateProjectTemplateRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	pr.Template = b.IsTemplate
+	writeJSON(w, http.StatusOK, pr)
+}
+
+func baseEstimate(b dto.BaseEstimateRequest) dto.BaseEstimate {
+	e := dto.BaseEstimate{
+		Active:       b.Active,
+		ResetOptions: b.ResetOptions,
+	}
+
+	if b.Type != nil {
+		e.Type = *b.Type
+	}
+
+	return e
+}
+
+func (s *Server) updateProjectEstimate(
+	w http.ResponseWriter, r *http.Request, p params) {
+	pr := s.findProject(w, p)
+	if pr == nil {
+		return
+	}
+
+	var b dto.UpdateProjectEstimateRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	pr.TimeEstimate = dto.TimeEstimate{
+		BaseEstimate: baseEstimate(b.TimeEstimate.BaseEstimateRequest),
+	}
+	if b.TimeEstimate.Estimate != nil {
+		pr.TimeEstimate.Estimate = *b.TimeEstimate.Estimate
+	}
+	if b.TimeEstimate.IncludeNonBillable != nil {
+		pr.TimeEstimate.IncludeNonBillable = *b.TimeEstimate.IncludeNonBillable
+	}
+
+	pr.BudgetEstimate = dto.BudgetEstimate{
+		BaseEstimate: baseEstimate(b.BudgetEstimate.BaseEstimateRequest),
+	}
+	if b.BudgetEstimate.Estimate != nil {
+		pr.BudgetEstimate.Estimate = uint(*b.BudgetEstimate.Estimate)
+	}
+
+	writeJSON(w, http.StatusOK, pr)
+}
+
+func (s *Server) updateProjectUserRate(cost bool) func(
+	http.ResponseWriter, *http.Request, params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		pr := s.findProject(w, p)
+		if pr == nil {
+			return
+		}
+
+		if !s.isMember(p["ws"], p["user"]) {
+			writeError(w, http.StatusBadRequest,
+				"User doesn't belong to Workspace")
+			return
+		}
+
+		var b dto.UpdateProjectUserRateRequest
+		if !decode(w, r, &b) {
+			return
+		}
+
+		i := -1
+		for j := range pr.Memberships {
+			if pr.Memberships[j].UserID == p["user"] {
+				i = j
+				break
+			}
+		}
+
+		if i == -1 {
+			pr.Memberships = append(pr.Memberships, dto.Membership{
+				UserID:   p["user"],
+				Status:   dto.MembershipStatusActive,
+				Type:     "PROJECT",
+				TargetID: pr.ID,
+			})
+			i = len(pr.Memberships) - 1
+		}
+
+		rate := &dto.Rate{
+			Amount:   int64(b.Amount),
+			Currency: s.workspace(p["ws"]).HourlyRate.Currency,
+		}
+		if cost {
+			pr.Memberships[i].CostRate = rate
+		} else {
+			pr.Memberships[i].HourlyRate = rate
+		}
+
+		writeJSON(w, http.StatusOK, pr)
+	}
+}
+
+func (s *Server) getTasks(w http.ResponseWriter, r *http.Request, p params) {
+	if s.findProject(w, p) == nil {
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	active := boolParam(r, "is-active")
+
+	ts := []dto.Task{}
+	for _, t := range s.tasks {
+		if t.ProjectID != p["project"] || !containsFold(t.Name, name) ||
+			(active != nil && *active && t.Status != dto.TaskStatusActive) {
+			continue
+		}
+
+		ts = append(ts, t)
+	}
+
+	start, end := page(r, len(ts))
+	writeJSON(w, http.StatusOK, ts[start:end])
+}
+
+// findTask writes a not found error if the task does not exist
+func (s *Server) findTask(w http.ResponseWriter, p params) *dto.Task {
+	if s.findProject(w, p) == nil {
+		return nil
+	}
+
+	t := s.task(p["project"], p["task"])
+	if t == nil {
+		writeError(w, http.StatusNotFound,
+			"Task with id "+p["task"]+" doesn't exist")
+	}
+
+	return t
+}
+
+func (s *Server) getTask(w http.ResponseWriter, _ *http.Request, p params) {
+	if t := s.findTask(w, p); t != nil {
+		writeJSON(w, http.StatusOK, t)
+	}
+}
+
+func (s *Server) addTask(w http.ResponseWriter, r *http.Request, p params) {
+	pr := s.findProject(w, p)
+	if pr == nil {
+		return
+	}
+
+	var b dto.AddTaskRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	for _, t := range s.tasks {
+		if t.ProjectID == pr.ID && strings.EqualFold(t.Name, b.Name) {
+			writeError(w, http.StatusBadRequest,
+				"Task with name '"+b.Name+"' already exists")
+			return
+		}
+	}
+
+	t := dto.Task{
+		ID:        s.newID(),
+		ProjectID: pr.ID,
+		Billable:  pr.Billable,
+		Status:    dto.TaskStatusActive,
+	}
+	s.applyTask(&t, dto.UpdateTaskRequest(b))
+	s.tasks = append(s.tasks, t)
+
+	writeJSON(w, http.StatusCreated, t)
+}
+
+func (s *Server) applyTask(t *dto.Task, b dto.UpdateTaskRequest) {
+	t.Name = b.Name
+	t.Estimate = b.Estimate
+
+	if b.AssigneeIDs != nil {
+		t.AssigneeIDs = *b.AssigneeIDs
+	}
+	if b.UserGroupIDs != nil {
+		t.UserGroupIDs = *b.UserGroupIDs
+	}
+	if b.Billable != nil {
+		t.Billable = *b.Billable
+	}
+	if b.Status != nil {
+		t.Status = dto.TaskStatus(*b.Status)
+	}
+}
+
+func (s *Server) updateTask(w http.ResponseWriter, r *http.Request, p params) {
+	t := s.findTask(w, p)
+	if t == nil {
+		return
+	}
+
+	var b dto.UpdateTaskRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	s.applyTask(t, b)
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteTask(w http.ResponseWriter, _ *http.Request, p params) {
+	t := s.findTask(w, p)
+	if t == nil {
+		return
+	}
+
+	d := *t
+	ts := s.tasks[:0]
+	for _, o := range s.tasks {
+		if o.ID != d.ID {
+			ts = append(ts, o)
+		}
+	}
+	s.tasks = ts
+
+	writeJSON(w, http.StatusOK, d)
+}
@@ -0,0 +1,280 @@
+// Package fakeserver provides an in-memory stand-in of Clockify's API, so
+// the CLI can be tested end-to-end using its real HTTP client.
+package fakeserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// DefaultToken is the API key accepted by a server created with New
+const DefaultToken = "fake-token"
+
+// Server is an in-memory Clockify API, it serves the endpoints used by
+// api.Client from the entities added to it
+type Server struct {
+	// Token is the API key expected on the X-Api-Key header
+	Token string
+
+	url    string
+	routes []route
+
+	mu           sync.Mutex
+	seq          int
+	me           string
+	workspaces   []dto.Workspace
+	users        []dto.User
+	members      map[string][]string
+	userGroups   []dto.UserGroup
+	clients      []dto.Client
+	projects     []dto.Project
+	tasks        []dto.Task
+	tags         []dto.Tag
+	customFields []dto.WorkspaceCustomField
+	timeEntries  []dto.TimeEntryImpl
+	invoiced     map[string]bool
+}
+
+// New starts a fake server which will be closed when the test ends
+func New(t testing.TB) *Server {
+	s := NewServer(DefaultToken)
+
+	hs := httptest.NewServer(s)
+	t.Cleanup(hs.Close)
+	s.url = hs.URL + "/api"
+
+	return s
+}
+
+// NewServer creates a fake server without listening to any port, it can be
+// used as a http.Handler
+func NewServer(token string) *Server {
+	s := &Server{
+		Token:    token,
+		members:  map[string][]string{},
+		invoiced: map[string]bool{},
+	}
+	s.routes = s.newRoutes()
+
+	return s
+}
+
+// URL is the base URL of the fake API, to be used with
+// api.NewClientFromUrlAndKey
+func (s *Server) URL() string {
+	return s.url
+}
+
+type params map[string]string
+
+type route struct {
+	method  string
+	path    []string
+	handler func(http.ResponseWriter, *http.Request, params)
+}
+
+func (s *Server) newRoutes() []route {
+	rs := []struct {
+		method  string
+		path    string
+		handler func(http.ResponseWriter, *http.Request, params)
+	}{
+		{"GET", "v1/user", s.getMe},
+		{"GET", "v1/workspaces", s.getWorkspaces},
+		{"GET", "v1/workspaces/{ws}/users", s.getUsers},
+		{"GET", "v1/workspaces/{ws}/user-groups", s.getUserGroups},
+
+		{"GET", "v1/workspaces/{ws}/clients", s.getClients},
+		{"POST", "v1/workspaces/{ws}/clients", s.addClient},
+		{"GET", "v1/workspaces/{ws}/clients/{client}", s.getClient},
+		{"PUT", "v1/workspaces/{ws}/clients/{client}", s.updateClient},
+		{"DELETE", "v1/workspaces/{ws}/clients/{client}", s.deleteClient},
+
+		{"GET", "v1/workspaces/{ws}/tags", s.getTags},
+		{"POST", "v1/workspaces/{ws}/tags", s.addTag},
+		{"PUT", "v1/workspaces/{ws}/tags/{tag}", s.updateTag},
+		{"DELETE", "v1/workspaces/{ws}/tags/{tag}", s.deleteTag},
+
+		{"GET", "v1/workspaces/{ws}/custom-fields", s.getCustomFields},
+
+		{"GET", "v1/workspaces/{ws}/projects", s.getProjects},
+		{"POST", "v1/workspaces/{ws}/projects", s.addProject},
+		{"GET", "v1/workspaces/{ws}/projects/{project}", s.getProject},
+		{"PUT", "v1/workspaces/{ws}/projects/{project}", s.updateProject},
+		{"DELETE", "v1/workspaces/{ws}/projects/{project}", s.deleteProject},
+		{"PATCH", "v1/workspaces/{ws}/projects/{project}/memberships",
+			s.updateProjectMemberships},
+		{"PATCH", "v1/workspaces/{ws}/projects/{project}/template",
+			s.updateProjectTemplate},
+		{"PATCH", "v1/workspaces/{ws}/projects/{project}/estimate",
+			s.updateProjectEstimate},
+		{"PUT", "v1/workspaces/{ws}/projects/{project}/users/{user}/hourly-rate",
+			s.updateProjectUserRate(false)},
+		{"PUT", "v1/workspaces/{ws}/projects/{project}/users/{user}/cost-rate",
+			s.updateProjectUserRate(true)},
+
+		{"GET", "v1/workspaces/{ws}/projects/{project}/tasks", s.getTasks},
+		{"POST", "v1/workspaces/{ws}/projects/{project}/tasks", s.addTask},
+		{"GET", "v1/workspaces/{ws}/projects/{project}/tasks/{task}",
+			s.getTask},
+		{"PUT", "v1/workspaces/{ws}/projects/{project}/tasks/{task}",
+			s.updateTask},
+		{"DELETE", "v1/workspaces/{ws}/projects/{project}/tasks/{task}",
+			s.deleteTask},
+
+		{"GET", "v1/workspaces/{ws}/user/{user}/time-entries",
+			s.getUserTimeEntries},
+		{"PATCH", "v1/workspaces/{ws}/user/{user}/time-entries",
+			s.stopTimeEntry},
+		{"POST", "v1/workspaces/{ws}/time-entries", s.addTimeEntry},
+		{"PATCH", "v1/workspaces/{ws}/time-entries/invoiced",
+			s.changeInvoiced},
+		{"GET", "v1/workspaces/{ws}/time-entries/{id}", s.getTimeEntry},
+		{"PUT", "v1/workspaces/{ws}/time-entries/{id}", s.updateTimeEntry},
+		{"DELETE", "v1/workspaces/{ws}/time-entries/{id}",
+			s.deleteTimeEntry},
+	}
+
+	routes := make([]route, len(rs))
+	for i := range rs {
+		routes[i] = route{
+			method:  rs[i].method,
+			path:    strings.Split(rs[i].path, "/"),
+			handler: rs[i].handler,
+		}
+	}
+
+	return routes
+}
+
+func (r route) match(path []string) (params, bool) {
+	if len(path) != len(r.path) {
+		return nil, false
+	}
+
+	p := params{}
+	for i := range r.path {
+		if strings.HasPrefix(r.path[i], "{") {
+			p[strings.Trim(r.path[i], "{}")] = path[i]
+			continue
+		}
+
+		if r.path[i] != path[i] {
+			return nil, false
+		}
+	}
+
+	return p, true
+}
+
+// ServeHTTP answers requests as Clockify's API would
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Api-Key") != s.Token {
+		writeError(w, http.StatusUnauthorized,
+			"Full authentication is required to access this resource")
+		return
+	}
+
+	path := strings.Split(strings.Trim(
+		strings.TrimPrefix(r.URL.Path, "/api"), "/"), "/")
+
+	found := false
+	for _, rt := range s.routes {
+		p, ok := rt.match(path)
+		if !ok {
+			continue
+		}
+
+		found = true
+		if rt.method != r.Method {
+			continue
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if ws, ok := p["ws"]; ok && s.workspace(ws) == nil {
+			writeError(w, http.StatusForbidden,
+				"Access Denied, workspace "+ws+" was not found")
+			return
+		}
+
+		rt.handler(w, r, p)
+		return
+	}
+
+	if found {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "Not found")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, dto.Error{Message: message, Code: status})
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid body: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+// page returns the range of a list with n items that was asked for, using
+// the "page" and "page-size" query parameters
+func page(r *http.Request, n int) (int, int) {
+	p, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if p < 1 {
+		p = 1
+	}
+
+	size, _ := strconv.Atoi(r.URL.Query().Get("page-size"))
+	if size < 1 {
+		size = 50
+	}
+
+	start := (p - 1) * size
+	if start > n {
+		start = n
+	}
+
+	end := start + size
+	if end > n {
+		end = n
+	}
+
+	return start, end
+}
+
+// containsFold checks if s contains sub, ignoring the case
+func containsFold(s, sub string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(sub))
+}
+
+// boolParam reads a query parameter as a bool, returning nil if not set
+func boolParam(r *http.Request, name string) *bool {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return nil
+	}
+
+	b := v == "true" || v == "1"
+	return &b
+}
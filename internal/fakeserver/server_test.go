@@ -0,0 +1,435 @@
+package fakeserver_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/fakeserver"
+	"github.com/stretchr/testify/assert"
+)
+
+func newClient(t *testing.T, s *fakeserver.Server) api.Client {
+	c, err := api.NewClientFromUrlAndKey(s.Token, s.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.SetRetryPolicy(api.RetryPolicy{})
+}
+
+type seed struct {
+	workspace dto.Workspace
+	user      dto.User
+	project   dto.Project
+	task      dto.Task
+	tag       dto.Tag
+}
+
+func newSeed(s *fakeserver.Server) seed {
+	var sd seed
+	sd.workspace = s.AddWorkspace(dto.Workspace{Name: "Work"})
+	sd.user = s.AddUser(sd.workspace.ID, dto.User{
+		Name: "John Due", Email: "john@due.com"})
+	sd.project = s.AddProject(dto.Project{
+		WorkspaceID: sd.workspace.ID, Name: "CLI", Billable: true})
+	sd.task = s.AddTask(dto.Task{ProjectID: sd.project.ID, Name: "Tests"})
+	sd.tag = s.AddTag(dto.Tag{WorkspaceID: sd.workspace.ID, Name: "Dev"})
+
+	return sd
+}
+
+func TestUnauthorized(t *testing.T) {
+	s := fakeserver.New(t)
+	newSeed(s)
+
+	c, err := api.NewClientFromUrlAndKey("wrong", s.URL())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = c.GetMe()
+	var apiErr dto.Error
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, 401, apiErr.Code)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	s := fakeserver.New(t)
+	sd := newSeed(s)
+	for i := 1; i <= 20; i++ {
+		s.AddTag(dto.Tag{
+			WorkspaceID: sd.workspace.ID,
+			Name:        fmt.Sprintf("tag %02d", i),
+		})
+	}
+
+	c := newClient(t, s)
+
+	tags, err := c.GetTags(api.GetTagsParam{
+		Workspace: sd.workspace.ID,
+		Name:      "tag",
+		PaginationParam: api.PaginationParam{
+			AllPages: true,
+			PageSize: 7,
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, tags, 20)
+	assert.Equal(t, "tag 01", tags[0].Name)
+	assert.Equal(t, "tag 20", tags[19].Name)
+
+	tags, err = c.GetTags(api.GetTagsParam{
+		Workspace:       sd.workspace.ID,
+		Name:            "tag",
+		PaginationParam: api.PaginationParam{Page: 3, PageSize: 7},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, tags, 6) {
+		assert.Equal(t, "tag 15", tags[0].Name)
+	}
+}
+
+func TestTimeEntries(t *testing.T) {
+	s := fakeserver.New(t)
+	sd := newSeed(s)
+	c := newClient(t, s)
+
+	start := time.Date(2022, 12, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	s.AddTimeEntry(dto.TimeEntryImpl{
+		WorkspaceID:  sd.workspace.ID,
+		UserID:       sd.user.ID,
+		Description:  "yesterday",
+		TimeInterval: dto.TimeInterval{Start: start, End: &end},
+	})
+
+	te, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace:   sd.workspace.ID,
+		Start:       start.Add(24 * time.Hour),
+		Description: "today",
+		ProjectID:   sd.project.ID,
+		TaskID:      sd.task.ID,
+		TagIDs:      []string{sd.tag.ID},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, te.Billable, "should use project's billable")
+
+	_, err = c.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace: sd.workspace.ID,
+		Start:     start,
+		ProjectID: "000000000000000000000999",
+	})
+	assert.Error(t, err)
+
+	running, err := c.GetHydratedTimeEntryInProgress(
+		api.GetTimeEntryInProgressParam{
+			Workspace: sd.workspace.ID,
+			UserID:    sd.user.ID,
+		})
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.NotNil(t, running) {
+		assert.Equal(t, te.ID, running.ID)
+		assert.Equal(t, "CLI", running.Project.Name)
+		assert.Equal(t, "Tests", running.Task.Name)
+		assert.Equal(t, []dto.Tag{sd.tag}, running.Tags)
+		assert.Equal(t, "John Due", running.User.Name)
+	}
+
+	tes, err := c.LogRange(api.LogRangeParam{
+		Workspace:       sd.workspace.ID,
+		UserID:          sd.user.ID,
+		FirstDate:       start.Add(-time.Hour),
+		LastDate:        start.Add(time.Hour),
+		PaginationParam: api.AllPages(),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, tes, 1) {
+		assert.Equal(t, "yesterday", tes[0].Description)
+	}
+
+	if !assert.NoError(t, c.Out(api.OutParam{
+		Workspace: sd.workspace.ID,
+		UserID:    sd.user.ID,
+		End:       start.Add(25 * time.Hour),
+	})) {
+		return
+	}
+
+	running, err = c.GetHydratedTimeEntryInProgress(
+		api.GetTimeEntryInProgressParam{
+			Workspace: sd.workspace.ID,
+			UserID:    sd.user.ID,
+		})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Nil(t, running)
+
+	err = c.Out(api.OutParam{
+		Workspace: sd.workspace.ID,
+		UserID:    sd.user.ID,
+		End:       start.Add(26 * time.Hour),
+	})
+	var apiErr dto.Error
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, 404, apiErr.Code)
+	}
+
+	if !assert.NoError(t, c.ChangeInvoiced(api.ChangeInvoicedParam{
+		Workspace:    sd.workspace.ID,
+		TimeEntryIDs: []string{te.ID},
+		Invoiced:     true,
+	})) {
+		return
+	}
+	assert.True(t, s.IsInvoiced(te.ID))
+
+	if !assert.NoError(t, c.DeleteTimeEntry(api.DeleteTimeEntryParam{
+		Workspace:   sd.workspace.ID,
+		TimeEntryID: te.ID,
+	})) {
+		return
+	}
+	assert.Len(t, s.TimeEntries(), 1)
+}
+
+func TestProjects(t *testing.T) {
+	s := fakeserver.New(t)
+	sd := newSeed(s)
+	c := newClient(t, s)
+
+	cl, err := c.AddClient(api.AddClientParam{
+		Workspace: sd.workspace.ID, Name: "Special"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	p, err := c.AddProject(api.AddProjectParam{
+		Workspace: sd.workspace.ID,
+		Name:      "Other",
+		ClientId:  cl.ID,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Special", p.ClientName)
+
+	ps, err := c.GetProjects(api.GetProjectsParam{
+		Workspace: sd.workspace.ID,
+		Clients:   []string{cl.ID},
+		Hydrate:   true,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, ps, 1) {
+		assert.Equal(t, p.ID, ps[0].ID)
+	}
+
+	pr, err := c.GetProject(api.GetProjectParam{
+		Workspace: sd.workspace.ID,
+		ProjectID: sd.project.ID,
+		Hydrate:   true,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []dto.Task{sd.task}, pr.Tasks)
+
+	_, err = c.DeleteProject(api.DeleteProjectParam{
+		Workspace: sd.workspace.ID, ProjectID: p.ID})
+	assert.Error(t, err, "active projects can't be deleted")
+
+	b := true
+	_, err = c.UpdateProject(api.UpdateProjectParam{
+		Workspace: sd.workspace.ID, ProjectID: p.ID, Archived: &b})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = c.DeleteProject(api.DeleteProjectParam{
+		Workspace: sd.workspace.ID, ProjectID: p.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, s.Projects(), 1)
+}
+
+func TestTags(t *testing.T) {
+	s := fakeserver.New(t)
+	sd := newSeed(s)
+	c := newClient(t, s)
+
+	tg, err := c.AddTag(api.AddTagParam{
+		Workspace: sd.workspace.ID, Name: "Meeting"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Meeting", tg.Name)
+
+	_, err = c.AddTag(api.AddTagParam{
+		Workspace: sd.workspace.ID, Name: "Meeting"})
+	assert.Error(t, err, "tag names should be unique")
+
+	b := true
+	tg, err = c.UpdateTag(api.UpdateTagParam{
+		Workspace: sd.workspace.ID,
+		TagID:     tg.ID,
+		Name:      "Meetings",
+		Archived:  &b,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Meetings", tg.Name)
+	assert.True(t, tg.Archived)
+
+	tags, err := c.GetTags(api.GetTagsParam{
+		Workspace:       sd.workspace.ID,
+		Archived:        &b,
+		PaginationParam: api.AllPages(),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, tags, 1) {
+		assert.Equal(t, tg.ID, tags[0].ID)
+	}
+
+	b = false
+	tg, err = c.UpdateTag(api.UpdateTagParam{
+		Workspace: sd.workspace.ID,
+		TagID:     tg.ID,
+		Archived:  &b,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Meetings", tg.Name, "name should be kept")
+	assert.False(t, tg.Archived)
+
+	_, err = c.DeleteTag(api.DeleteTagParam{
+		Workspace: sd.workspace.ID, TagID: tg.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, s.Tags(), 1)
+}
+
+func TestClients(t *testing.T) {
+	s := fakeserver.New(t)
+	sd := newSeed(s)
+	c := newClient(t, s)
+
+	cl, err := c.AddClient(api.AddClientParam{
+		Workspace: sd.workspace.ID, Name: "Special"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = c.UpdateProject(api.UpdateProjectParam{
+		Workspace: sd.workspace.ID,
+		ProjectID: sd.project.ID,
+		ClientId:  &cl.ID,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	note := "old contracts"
+	cl, err = c.UpdateClient(api.UpdateClientParam{
+		Workspace: sd.workspace.ID,
+		ClientID:  cl.ID,
+		Name:      "Very Special",
+		Note:      &note,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Very Special", cl.Name)
+	assert.Equal(t, note, cl.Note)
+
+	got, err := c.GetClient(api.GetClientParam{
+		Workspace: sd.workspace.ID, ClientID: cl.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, cl, got)
+
+	pr, err := c.GetProject(api.GetProjectParam{
+		Workspace: sd.workspace.ID, ProjectID: sd.project.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Very Special", pr.ClientName)
+
+	_, err = c.DeleteClient(api.DeleteClientParam{
+		Workspace: sd.workspace.ID, ClientID: cl.ID})
+	assert.Error(t, err, "active clients can't be deleted")
+
+	b := true
+	if cl, err = c.UpdateClient(api.UpdateClientParam{
+		Workspace: sd.workspace.ID, ClientID: cl.ID, Archived: &b,
+	}); !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Very Special", cl.Name)
+	assert.True(t, cl.Archived)
+
+	_, err = c.DeleteClient(api.DeleteClientParam{
+		Workspace: sd.workspace.ID, ClientID: cl.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, s.Clients(), 0)
+}
+
+func TestUserGroups(t *testing.T) {
+	s := fakeserver.New(t)
+	sd := newSeed(s)
+	c := newClient(t, s)
+
+	dev := s.AddUserGroup(dto.UserGroup{WorkspaceID: sd.workspace.ID,
+		Name: "Developers", UserIDs: []string{sd.user.ID}})
+	s.AddUserGroup(dto.UserGroup{WorkspaceID: sd.workspace.ID, Name: "Admins"})
+	s.AddUserGroup(dto.UserGroup{WorkspaceID: "other", Name: "Developers"})
+
+	gs, err := c.GetUserGroups(api.GetUserGroupsParam{
+		Workspace:       sd.workspace.ID,
+		PaginationParam: api.AllPages(),
+	})
+	if assert.NoError(t, err) && assert.Len(t, gs, 2) {
+		assert.Equal(t, "Admins", gs[0].Name)
+		assert.Equal(t, dev, gs[1])
+	}
+
+	gs, err = c.GetUserGroups(api.GetUserGroupsParam{
+		Workspace: sd.workspace.ID, Name: "dev"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []dto.UserGroup{dev}, gs)
+	}
+
+	groups := []string{dev.ID}
+	tk, err := c.AddTask(api.AddTaskParam{
+		Workspace:    sd.workspace.ID,
+		ProjectID:    sd.project.ID,
+		Name:         "Grouped",
+		UserGroupIDs: &groups,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, groups, tk.UserGroupIDs)
+	}
+}
@@ -0,0 +1,289 @@
+package fakeserver
+
+import (
+	"fmt"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// newID creates a unique id with the same format used by Clockify
+func (s *Server) newID() string {
+	s.seq++
+	return fmt.Sprintf("%024x", s.seq)
+}
+
+func (s *Server) idOrNew(id string) string {
+	if id != "" {
+		return id
+	}
+
+	return s.newID()
+}
+
+// AddWorkspace stores the workspace, creating a id if it has none
+func (s *Server) AddWorkspace(w dto.Workspace) dto.Workspace {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.ID = s.idOrNew(w.ID)
+	s.workspaces = append(s.workspaces, w)
+	return w
+}
+
+// AddUser stores the user as a member of the workspace, the first user added
+// will be the owner of the token (see SetMe)
+func (s *Server) AddUser(workspace string, u dto.User) dto.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u.ID = s.idOrNew(u.ID)
+	if u.DefaultWorkspace == "" {
+		u.DefaultWorkspace = workspace
+	}
+	if u.ActiveWorkspace == "" {
+		u.ActiveWorkspace = workspace
+	}
+	if u.Status == "" {
+		u.Status = dto.UserStatusActive
+	}
+
+	if s.user(u.ID) == nil {
+		s.users = append(s.users, u)
+	}
+	s.members[workspace] = append(s.members[workspace], u.ID)
+
+	if s.me == "" {
+		s.me = u.ID
+	}
+
+	return u
+}
+
+// SetMe changes which user is the owner of the token
+func (s *Server) SetMe(userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.me = userID
+}
+
+// AddUserGroup stores the user group, creating a id if it has none
+func (s *Server) AddUserGroup(g dto.UserGroup) dto.UserGroup {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g.ID = s.idOrNew(g.ID)
+	s.userGroups = append(s.userGroups, g)
+	return g
+}
+
+// AddClient stores the client, creating a id if it has none
+func (s *Server) AddClient(c dto.Client) dto.Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c.ID = s.idOrNew(c.ID)
+	s.clients = append(s.clients, c)
+	return c
+}
+
+// AddProject stores the project, creating a id if it has none
+func (s *Server) AddProject(p dto.Project) dto.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p.ID = s.idOrNew(p.ID)
+	if c := s.client(p.WorkspaceID, p.ClientID); c != nil {
+		p.ClientName = c.Name
+	}
+	s.projects = append(s.projects, p)
+	return p
+}
+
+// AddTask stores the task on its project, creating a id if it has none
+func (s *Server) AddTask(t dto.Task) dto.Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t.ID = s.idOrNew(t.ID)
+	if t.Status == "" {
+		t.Status = dto.TaskStatusActive
+	}
+	s.tasks = append(s.tasks, t)
+	return t
+}
+
+// AddTag stores the tag, creating a id if it has none
+func (s *Server) AddTag(t dto.Tag) dto.Tag {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t.ID = s.idOrNew(t.ID)
+	s.tags = append(s.tags, t)
+	return t
+}
+
+// AddCustomField stores the definition of the custom field, creating a id if
+// it has none, custom fields without status or entity type are visible on
+// time entries
+func (s *Server) AddCustomField(
+	cf dto.WorkspaceCustomField) dto.WorkspaceCustomField {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cf.ID = s.idOrNew(cf.ID)
+	if cf.Status == "" {
+		cf.Status = dto.CustomFieldStatusVisible
+	}
+	if cf.EntityType == "" {
+		cf.EntityType = "TIMEENTRY"
+	}
+	s.customFields = append(s.customFields, cf)
+	return cf
+}
+
+// AddTimeEntry stores the time entry, creating a id if it has none, time
+// entries without a end are "in progress"
+func (s *Server) AddTimeEntry(te dto.TimeEntryImpl) dto.TimeEntryImpl {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	te.ID = s.idOrNew(te.ID)
+	te.TimeInterval = dto.NewTimeInterval(
+		te.TimeInterval.Start, te.TimeInterval.End)
+	s.timeEntries = append(s.timeEntries, te)
+	return te
+}
+
+// TimeEntries returns the time entries currently stored
+func (s *Server) TimeEntries() []dto.TimeEntryImpl {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]dto.TimeEntryImpl{}, s.timeEntries...)
+}
+
+// Tags returns the tags currently stored
+func (s *Server) Tags() []dto.Tag {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]dto.Tag{}, s.tags...)
+}
+
+// Clients returns the clients currently stored
+func (s *Server) Clients() []dto.Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]dto.Client{}, s.clients...)
+}
+
+// Projects returns the projects currently stored
+func (s *Server) Projects() []dto.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]dto.Project{}, s.projects...)
+}
+
+// IsInvoiced tells if the time entry was marked as invoiced
+func (s *Server) IsInvoiced(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.invoiced[id]
+}
+
+func (s *Server) workspace(id string) *dto.Workspace {
+	for i := range s.workspaces {
+		if s.workspaces[i].ID == id {
+			return &s.workspaces[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) user(id string) *dto.User {
+	for i := range s.users {
+		if s.users[i].ID == id {
+			return &s.users[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) isMember(workspace, userID string) bool {
+	for _, id := range s.members[workspace] {
+		if id == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) client(workspace, id string) *dto.Client {
+	for i := range s.clients {
+		if s.clients[i].ID == id && s.clients[i].WorkspaceID == workspace {
+			return &s.clients[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) project(workspace, id string) *dto.Project {
+	for i := range s.projects {
+		if s.projects[i].ID == id && s.projects[i].WorkspaceID == workspace {
+			return &s.projects[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) task(project, id string) *dto.Task {
+	for i := range s.tasks {
+		if s.tasks[i].ID == id && s.tasks[i].ProjectID == project {
+			return &s.tasks[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) tag(workspace, id string) *dto.Tag {
+	for i := range s.tags {
+		if s.tags[i].ID == id && s.tags[i].WorkspaceID == workspace {
+			return &s.tags[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) customField(
+	workspace, id string) *dto.WorkspaceCustomField {
+	for i := range s.customFields {
+		if s.customFields[i].ID == id &&
+			s.customFields[i].WorkspaceID == workspace {
+			return &s.customFields[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) timeEntry(workspace, id string) *dto.TimeEntryImpl {
+	for i := range s.timeEntries {
+		if s.timeEntries[i].ID == id &&
+			s.timeEntries[i].WorkspaceID == workspace {
+			return &s.timeEntries[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,387 @@
+package fakeserver
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+)
+
+// hydrateTimeEntry fills the project, task, tags, user and custom fields of
+// the time entry
+func (s *Server) hydrateTimeEntry(te dto.TimeEntryImpl) dto.TimeEntry {
+	h := dto.TimeEntry{
+		ID:           te.ID,
+		Billable:     te.Billable,
+		Description:  te.Description,
+		IsLocked:     te.IsLocked,
+		ProjectID:    te.ProjectID,
+		TimeInterval: te.TimeInterval,
+		WorkspaceID:  te.WorkspaceID,
+		Tags:         []dto.Tag{},
+		User:         s.user(te.UserID),
+	}
+
+	if p := s.project(te.WorkspaceID, te.ProjectID); p != nil {
+		h.Project = p
+		h.HourlyRate = p.HourlyRate
+		h.Task = s.task(p.ID, te.TaskID)
+	}
+
+	for _, id := range te.TagIDs {
+		if t := s.tag(te.WorkspaceID, id); t != nil {
+			h.Tags = append(h.Tags, *t)
+		}
+	}
+
+	for _, v := range te.CustomFields {
+		if cf := s.customField(te.WorkspaceID, v.CustomFieldID); cf != nil {
+			v.Name = cf.Name
+			v.Type = cf.Type
+		}
+		h.CustomFields = append(h.CustomFields, v)
+	}
+
+	return h
+}
+
+func (s *Server) getUserTimeEntries(
+	w http.ResponseWriter, r *http.Request, p params) {
+	if !s.isMember(p["ws"], p["user"]) {
+		writeError(w, http.StatusForbidden,
+			"User doesn't belong to Workspace")
+		return
+	}
+
+	q := r.URL.Query()
+	inProgress := boolParam(r, "in-progress")
+	hydrated := boolParam(r, "hydrated")
+
+	start, err := timeParam(r, "start")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	end, err := timeParam(r, "end")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tes := []dto.TimeEntryImpl{}
+	for _, te := range s.timeEntries {
+		if te.WorkspaceID != p["ws"] || te.UserID != p["user"] {
+			continue
+		}
+
+		if inProgress != nil && *inProgress && te.TimeInterval.End != nil {
+			continue
+		}
+
+		if start != nil && te.TimeInterval.Start.Before(*start) {
+			continue
+		}
+
+		if end != nil && te.TimeInterval.Start.After(*end) {
+			continue
+		}
+
+		if !containsFold(te.Description, q.Get("description")) {
+			continue
+		}
+
+		if v := q.Get("project"); v != "" && te.ProjectID != v {
+			continue
+		}
+
+		if v := q.Get("task"); v != "" && te.TaskID != v {
+			continue
+		}
+
+		hasTags := true
+		for _, t := range q["tags"] {
+			hasTags = hasTags && strhlp.InSlice(t, te.TagIDs)
+		}
+		if !hasTags {
+			continue
+		}
+
+		tes = append(tes, te)
+	}
+
+	sort.SliceStable(tes, func(i, j int) bool {
+		return tes[i].TimeInterval.Start.After(tes[j].TimeInterval.Start)
+	})
+
+	i, j := page(r, len(tes))
+	tes = tes[i:j]
+
+	if hydrated == nil || !*hydrated {
+		writeJSON(w, http.StatusOK, tes)
+		return
+	}
+
+	hs := make([]dto.TimeEntry, len(tes))
+	for i := range tes {
+		hs[i] = s.hydrateTimeEntry(tes[i])
+	}
+	writeJSON(w, http.StatusOK, hs)
+}
+
+// timeParam reads a query parameter as a time, returning nil if not set
+func timeParam(r *http.Request, name string) (*time.Time, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, errors.New("Invalid " + name + " date: " + err.Error())
+	}
+
+	return &t, nil
+}
+
+// stopRunning ends the time entry in progress of the user, returning false
+// if there is none
+func (s *Server) stopRunning(workspace, user string, end time.Time) bool {
+	for i := range s.timeEntries {
+		te := &s.timeEntries[i]
+		if te.WorkspaceID != workspace || te.UserID != user ||
+			te.TimeInterval.End != nil {
+			continue
+		}
+
+		te.TimeInterval = dto.NewTimeInterval(te.TimeInterval.Start, &end)
+		return true
+	}
+
+	return false
+}
+
+func (s *Server) stopTimeEntry(
+	w http.ResponseWriter, r *http.Request, p params) {
+	var b dto.OutTimeEntryRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	if !s.stopRunning(p["ws"], p["user"], b.End.Time) {
+		writeError(w, http.StatusNotFound, "No time entry in progress")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// validateTimeEntry checks if the project, task, tags and custom fields of
+// the time entry
+// exist on its workspace, writing the error if not
+func (s *Server) validateTimeEntry(
+	w http.ResponseWriter, te dto.TimeEntryImpl) bool {
+	if te.ProjectID != "" && s.project(te.WorkspaceID, te.ProjectID) == nil {
+		writeError(w, http.StatusBadRequest,
+			"Project doesn't belong to Workspace")
+		return false
+	}
+
+	if te.TaskID != "" && s.task(te.ProjectID, te.TaskID) == nil {
+		writeError(w, http.StatusBadRequest,
+			"Task doesn't belong to Project")
+		return false
+	}
+
+	for _, id := range te.TagIDs {
+		if s.tag(te.WorkspaceID, id) == nil {
+			writeError(w, http.StatusBadRequest,
+				"Tag doesn't belong to Workspace")
+			return false
+		}
+	}
+
+	for _, v := range te.CustomFields {
+		if s.customField(te.WorkspaceID, v.CustomFieldID) == nil {
+			writeError(w, http.StatusBadRequest,
+				"Custom field doesn't belong to Workspace")
+			return false
+		}
+	}
+
+	e := te.TimeInterval.End
+	if e != nil && e.Before(te.TimeInterval.Start) {
+		writeError(w, http.StatusBadRequest,
+			"End time must be after the start time")
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) addTimeEntry(
+	w http.ResponseWriter, r *http.Request, p params) {
+	var b dto.CreateTimeEntryRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	var end *time.Time
+	if b.End != nil {
+		end = &b.End.Time
+	}
+
+	te := dto.TimeEntryImpl{
+		ID:           s.newID(),
+		WorkspaceID:  p["ws"],
+		UserID:       s.me,
+		Description:  b.Description,
+		ProjectID:    b.ProjectID,
+		TaskID:       b.TaskID,
+		TagIDs:       b.TagIDs,
+		CustomFields: b.CustomFields,
+		TimeInterval: dto.NewTimeInterval(b.Start.Time, end),
+	}
+
+	if !s.validateTimeEntry(w, te) {
+		return
+	}
+
+	if b.Billable != nil {
+		te.Billable = *b.Billable
+	} else if pr := s.project(te.WorkspaceID, te.ProjectID); pr != nil {
+		te.Billable = pr.Billable
+	}
+
+	if end == nil {
+		s.stopRunning(te.WorkspaceID, te.UserID, b.Start.Time)
+	}
+
+	s.timeEntries = append(s.timeEntries, te)
+	writeJSON(w, http.StatusCreated, te)
+}
+
+// findTimeEntry writes a not found error if the time entry does not exist
+func (s *Server) findTimeEntry(
+	w http.ResponseWriter, p params) *dto.TimeEntryImpl {
+	te := s.timeEntry(p["ws"], p["id"])
+	if te == nil {
+		writeError(w, http.StatusNotFound,
+			"TimeEntry with id "+p["id"]+" doesn't exist")
+	}
+
+	return te
+}
+
+func (s *Server) getTimeEntry(
+	w http.ResponseWriter, r *http.Request, p params) {
+	te := s.findTimeEntry(w, p)
+	if te == nil {
+		return
+	}
+
+	if h := boolParam(r, "hydrated"); h != nil && *h {
+		writeJSON(w, http.StatusOK, s.hydrateTimeEntry(*te))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, te)
+}
+
+func (s *Server) updateTimeEntry(
+	w http.ResponseWriter, r *http.Request, p params) {
+	te := s.findTimeEntry(w, p)
+	if te == nil {
+		return
+	}
+
+	var b dto.UpdateTimeEntryRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	var end *time.Time
+	if b.End != nil {
+		end = &b.End.Time
+	}
+
+	u := *te
+	u.Billable = b.Billable
+	u.Description = b.Description
+	u.ProjectID = b.ProjectID
+	u.TaskID = b.TaskID
+	u.TagIDs = b.TagIDs
+	u.CustomFields = mergeCustomFields(u.CustomFields, b.CustomFields)
+	u.TimeInterval = dto.NewTimeInterval(b.Start.Time, end)
+
+	if !s.validateTimeEntry(w, u) {
+		return
+	}
+
+	*te = u
+	writeJSON(w, http.StatusOK, te)
+}
+
+// mergeCustomFields changes the values of the custom fields present on the
+// update, keeping the others as they were
+func mergeCustomFields(
+	current, update []dto.CustomFieldValue) []dto.CustomFieldValue {
+	cfs := append([]dto.CustomFieldValue{}, current...)
+	for _, u := range update {
+		found := false
+		for i := range cfs {
+			if cfs[i].CustomFieldID == u.CustomFieldID {
+				cfs[i].Value = u.Value
+				found = true
+			}
+		}
+
+		if !found {
+			cfs = append(cfs, u)
+		}
+	}
+
+	return cfs
+}
+
+func (s *Server) deleteTimeEntry(
+	w http.ResponseWriter, _ *http.Request, p params) {
+	if s.findTimeEntry(w, p) == nil {
+		return
+	}
+
+	tes := s.timeEntries[:0]
+	for _, te := range s.timeEntries {
+		if te.ID != p["id"] {
+			tes = append(tes, te)
+		}
+	}
+	s.timeEntries = tes
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) changeInvoiced(
+	w http.ResponseWriter, r *http.Request, p params) {
+	var b dto.ChangeTimeEntriesInvoicedRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	for _, id := range b.TimeEntryIDs {
+		if s.timeEntry(p["ws"], id) == nil {
+			writeError(w, http.StatusBadRequest,
+				"TimeEntry with id "+id+" doesn't exist")
+			return
+		}
+	}
+
+	for _, id := range b.TimeEntryIDs {
+		s.invoiced[id] = b.Invoiced
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,362 @@
+package fakeserver
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func (s *Server) getMe(w http.ResponseWriter, _ *http.Request, _ params) {
+	u := s.user(s.me)
+	if u == nil {
+		writeError(w, http.StatusUnauthorized, "User not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, u)
+}
+
+func (s *Server) getWorkspaces(
+	w http.ResponseWriter, _ *http.Request, _ params) {
+	ws := []dto.Workspace{}
+	for _, wk := range s.workspaces {
+		if s.isMember(wk.ID, s.me) {
+			ws = append(ws, wk)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, ws)
+}
+
+func (s *Server) getUsers(w http.ResponseWriter, r *http.Request, p params) {
+	email := r.URL.Query().Get("email")
+
+	us := []dto.User{}
+	for _, id := range s.members[p["ws"]] {
+		u := s.user(id)
+		if email != "" && !strings.EqualFold(u.Email, email) {
+			continue
+		}
+
+		us = append(us, *u)
+	}
+
+	sort.SliceStable(us, func(i, j int) bool {
+		return strings.ToLower(us[i].Name) < strings.ToLower(us[j].Name)
+	})
+
+	start, end := page(r, len(us))
+	writeJSON(w, http.StatusOK, us[start:end])
+}
+
+func (s *Server) getUserGroups(
+	w http.ResponseWriter, r *http.Request, p params) {
+	name := r.URL.Query().Get("name")
+
+	gs := []dto.UserGroup{}
+	for _, g := range s.userGroups {
+		if g.WorkspaceID != p["ws"] || !containsFold(g.Name, name) {
+			continue
+		}
+
+		gs = append(gs, g)
+	}
+
+	sort.SliceStable(gs, func(i, j int) bool {
+		return strings.ToLower(gs[i].Name) < strings.ToLower(gs[j].Name)
+	})
+
+	start, end := page(r, len(gs))
+	writeJSON(w, http.StatusOK, gs[start:end])
+}
+
+func (s *Server) getClients(
+	w http.ResponseWriter, r *http.Request, p params) {
+	name := r.URL.Query().Get("name")
+	archived := boolParam(r, "archived")
+
+	cs := []dto.Client{}
+	for _, c := range s.clients {
+		if c.WorkspaceID != p["ws"] || !containsFold(c.Name, name) ||
+			(archived != nil && c.Archived != *archived) {
+			continue
+		}
+
+		cs = append(cs, c)
+	}
+
+	sort.SliceStable(cs, func(i, j int) bool {
+		return strings.ToLower(cs[i].Name) < strings.ToLower(cs[j].Name)
+	})
+
+	start, end := page(r, len(cs))
+	writeJSON(w, http.StatusOK, cs[start:end])
+}
+
+func (s *Server) addClient(w http.ResponseWriter, r *http.Request, p params) {
+	var b dto.AddClientRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	if strings.TrimSpace(b.Name) == "" {
+		writeError(w, http.StatusBadRequest, "Client name is required")
+		return
+	}
+
+	for _, c := range s.clients {
+		if c.WorkspaceID == p["ws"] && strings.EqualFold(c.Name, b.Name) {
+			writeError(w, http.StatusBadRequest,
+				"Client with name '"+b.Name+"' already exists")
+			return
+		}
+	}
+
+	c := dto.Client{
+		ID:          s.newID(),
+		Name:        b.Name,
+		WorkspaceID: p["ws"],
+	}
+	s.clients = append(s.clients, c)
+
+	writeJSON(w, http.StatusCreated, c)
+}
+
+// findClient writes a not found error if the client does not exist
+func (s *Server) findClient(w http.ResponseWriter, p params) *dto.Client {
+	c := s.client(p["ws"], p["client"])
+	if c == nil {
+		writeError(w, http.StatusNotFound,
+			"Client with id "+p["client"]+" doesn't exist")
+	}
+
+	return c
+}
+
+func (s *Server) getClient(w http.ResponseWriter, _ *http.Request, p params) {
+	if c := s.findClient(w, p); c != nil {
+		writeJSON(w, http.StatusOK, c)
+	}
+}
+
+func (s *Server) updateClient(
+	w http.ResponseWriter, r *http.Request, p params) {
+	c := s.findClient(w, p)
+	if c == nil {
+		return
+	}
+
+	var b dto.UpdateClientRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	if b.Name == nil || *b.Name == "" {
+		writeError(w, http.StatusBadRequest, "Client name is required")
+		return
+	}
+
+	for _, o := range s.clients {
+		if o.WorkspaceID == c.WorkspaceID && o.ID != c.ID &&
+			strings.EqualFold(o.Name, *b.Name) {
+			writeError(w, http.StatusBadRequest,
+				"Client with name '"+*b.Name+"' already exists")
+			return
+		}
+	}
+
+	c.Name = *b.Name
+	for i := range s.projects {
+		if s.projects[i].ClientID == c.ID {
+			s.projects[i].ClientName = c.Name
+		}
+	}
+
+	if b.Note != nil {
+		c.Note = *b.Note
+	}
+
+	if b.Archived != nil {
+		c.Archived = *b.Archived
+	}
+
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) deleteClient(
+	w http.ResponseWriter, _ *http.Request, p params) {
+	c := s.findClient(w, p)
+	if c == nil {
+		return
+	}
+
+	if !c.Archived {
+		writeError(w, http.StatusBadRequest, "Cannot delete an active client")
+		return
+	}
+	deleted := *c
+
+	cs := s.clients[:0]
+	for _, c := range s.clients {
+		if c.ID != deleted.ID {
+			cs = append(cs, c)
+		}
+	}
+	s.clients = cs
+
+	for i := range s.projects {
+		if s.projects[i].ClientID == deleted.ID {
+			s.projects[i].ClientID = ""
+			s.projects[i].ClientName = ""
+		}
+	}
+
+	writeJSON(w, http.StatusOK, deleted)
+}
+
+func (s *Server) getTags(w http.ResponseWriter, r *http.Request, p params) {
+	name := r.URL.Query().Get("name")
+	archived := boolParam(r, "archived")
+
+	ts := []dto.Tag{}
+	for _, t := range s.tags {
+		if t.WorkspaceID != p["ws"] || !containsFold(t.Name, name) ||
+			(archived != nil && t.Archived != *archived) {
+			continue
+		}
+
+		ts = append(ts, t)
+	}
+
+	sort.SliceStable(ts, func(i, j int) bool {
+		return strings.ToLower(ts[i].Name) < strings.ToLower(ts[j].Name)
+	})
+
+	start, end := page(r, len(ts))
+	writeJSON(w, http.StatusOK, ts[start:end])
+}
+
+// hasTagNamed tells if other tag of the workspace already uses the name
+func (s *Server) hasTagNamed(workspace, id, name string) bool {
+	for _, t := range s.tags {
+		if t.WorkspaceID == workspace && t.ID != id &&
+			strings.EqualFold(t.Name, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) addTag(w http.ResponseWriter, r *http.Request, p params) {
+	var b dto.AddTagRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	if strings.TrimSpace(b.Name) == "" {
+		writeError(w, http.StatusBadRequest, "Tag name is required")
+		return
+	}
+
+	if s.hasTagNamed(p["ws"], "", b.Name) {
+		writeError(w, http.StatusBadRequest,
+			"Tag with name '"+b.Name+"' already exists")
+		return
+	}
+
+	t := dto.Tag{
+		ID:          s.newID(),
+		Name:        b.Name,
+		WorkspaceID: p["ws"],
+	}
+	s.tags = append(s.tags, t)
+
+	writeJSON(w, http.StatusCreated, t)
+}
+
+// findTag writes a not found error if the tag does not exist
+func (s *Server) findTag(w http.ResponseWriter, p params) *dto.Tag {
+	t := s.tag(p["ws"], p["tag"])
+	if t == nil {
+		writeError(w, http.StatusNotFound,
+			"Tag with id "+p["tag"]+" doesn't exist")
+	}
+
+	return t
+}
+
+func (s *Server) updateTag(w http.ResponseWriter, r *http.Request, p params) {
+	t := s.findTag(w, p)
+	if t == nil {
+		return
+	}
+
+	var b dto.UpdateTagRequest
+	if !decode(w, r, &b) {
+		return
+	}
+
+	if b.Name == nil || *b.Name == "" {
+		writeError(w, http.StatusBadRequest, "Tag name is required")
+		return
+	}
+
+	if s.hasTagNamed(p["ws"], t.ID, *b.Name) {
+		writeError(w, http.StatusBadRequest,
+			"Tag with name '"+*b.Name+"' already exists")
+		return
+	}
+
+	t.Name = *b.Name
+
+	if b.Archived != nil {
+		t.Archived = *b.Archived
+	}
+
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteTag(w http.ResponseWriter, _ *http.Request, p params) {
+	t := s.findTag(w, p)
+	if t == nil {
+		return
+	}
+	deleted := *t
+
+	ts := s.tags[:0]
+	for _, t := range s.tags {
+		if t.ID != deleted.ID {
+			ts = append(ts, t)
+		}
+	}
+	s.tags = ts
+
+	writeJSON(w, http.StatusOK, deleted)
+}
+
+func (s *Server) getCustomFields(
+	w http.ResponseWriter, r *http.Request, p params) {
+	q := r.URL.Query()
+
+	cfs := []dto.WorkspaceCustomField{}
+	for _, cf := range s.customFields {
+		if cf.WorkspaceID != p["ws"] || !containsFold(cf.Name, q.Get("name")) {
+			continue
+		}
+
+		if v := q.Get("status"); v != "" && string(cf.Status) != v {
+			continue
+		}
+
+		if v := q.Get("entity-type"); v != "" && cf.EntityType != v {
+			continue
+		}
+
+		cfs = append(cfs, cf)
+	}
+
+	writeJSON(w, http.StatusOK, cfs)
+}
@@ -3,9 +3,11 @@
 package mocks
 
 import (
+	context "context"
+	time "time"
+
 	api "github.com/lucassabreu/clockify-cli/api"
 	dto "github.com/lucassabreu/clockify-cli/api/dto"
-
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -110,6 +112,50 @@ func (_c *MockClient_AddProject_Call) Return(_a0 dto.Project, _a1 error) *MockCl
 	return _c
 }
 
+// AddTag provides a mock function with given fields: _a0
+func (_m *MockClient) AddTag(_a0 api.AddTagParam) (dto.Tag, error) {
+	ret := _m.Called(_a0)
+
+	var r0 dto.Tag
+	if rf, ok := ret.Get(0).(func(api.AddTagParam) dto.Tag); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(dto.Tag)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(api.AddTagParam) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_AddTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTag'
+type MockClient_AddTag_Call struct {
+	*mock.Call
+}
+
+// AddTag is a helper method to define mock.On call
+//   - _a0 api.AddTagParam
+func (_e *MockClient_Expecter) AddTag(_a0 interface{}) *MockClient_AddTag_Call {
+	return &MockClient_AddTag_Call{Call: _e.mock.On("AddTag", _a0)}
+}
+
+func (_c *MockClient_AddTag_Call) Run(run func(_a0 api.AddTagParam)) *MockClient_AddTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.AddTagParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_AddTag_Call) Return(_a0 dto.Tag, _a1 error) *MockClient_AddTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // AddTask provides a mock function with given fields: _a0
 func (_m *MockClient) AddTask(_a0 api.AddTaskParam) (dto.Task, error) {
 	ret := _m.Called(_a0)
@@ -235,6 +281,50 @@ func (_c *MockClient_CreateTimeEntry_Call) Return(_a0 dto.TimeEntryImpl, _a1 err
 	return _c
 }
 
+// DeleteClient provides a mock function with given fields: _a0
+func (_m *MockClient) DeleteClient(_a0 api.DeleteClientParam) (dto.Client, error) {
+	ret := _m.Called(_a0)
+
+	var r0 dto.Client
+	if rf, ok := ret.Get(0).(func(api.DeleteClientParam) dto.Client); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(dto.Client)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(api.DeleteClientParam) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_DeleteClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteClient'
+type MockClient_DeleteClient_Call struct {
+	*mock.Call
+}
+
+// DeleteClient is a helper method to define mock.On call
+//   - _a0 api.DeleteClientParam
+func (_e *MockClient_Expecter) DeleteClient(_a0 interface{}) *MockClient_DeleteClient_Call {
+	return &MockClient_DeleteClient_Call{Call: _e.mock.On("DeleteClient", _a0)}
+}
+
+func (_c *MockClient_DeleteClient_Call) Run(run func(_a0 api.DeleteClientParam)) *MockClient_DeleteClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.DeleteClientParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteClient_Call) Return(_a0 dto.Client, _a1 error) *MockClient_DeleteClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // DeleteProject provides a mock function with given fields: _a0
 func (_m *MockClient) DeleteProject(_a0 api.DeleteProjectParam) (dto.Project, error) {
 	ret := _m.Called(_a0)
@@ -279,6 +369,50 @@ func (_c *MockClient_DeleteProject_Call) Return(_a0 dto.Project, _a1 error) *Moc
 	return _c
 }
 
+// DeleteTag provides a mock function with given fields: _a0
+func (_m *MockClient) DeleteTag(_a0 api.DeleteTagParam) (dto.Tag, error) {
+	ret := _m.Called(_a0)
+
+	var r0 dto.Tag
+	if rf, ok := ret.Get(0).(func(api.DeleteTagParam) dto.Tag); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(dto.Tag)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(api.DeleteTagParam) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type MockClient_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - _a0 api.DeleteTagParam
+func (_e *MockClient_Expecter) DeleteTag(_a0 interface{}) *MockClient_DeleteTag_Call {
+	return &MockClient_DeleteTag_Call{Call: _e.mock.On("DeleteTag", _a0)}
+}
+
+func (_c *MockClient_DeleteTag_Call) Run(run func(_a0 api.DeleteTagParam)) *MockClient_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.DeleteTagParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteTag_Call) Return(_a0 dto.Tag, _a1 error) *MockClient_DeleteTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // DeleteTask provides a mock function with given fields: _a0
 func (_m *MockClient) DeleteTask(_a0 api.DeleteTaskParam) (dto.Task, error) {
 	ret := _m.Called(_a0)
@@ -360,6 +494,50 @@ func (_c *MockClient_DeleteTimeEntry_Call) Return(_a0 error) *MockClient_DeleteT
 	return _c
 }
 
+// GetClient provides a mock function with given fields: _a0
+func (_m *MockClient) GetClient(_a0 api.GetClientParam) (dto.Client, error) {
+	ret := _m.Called(_a0)
+
+	var r0 dto.Client
+	if rf, ok := ret.Get(0).(func(api.GetClientParam) dto.Client); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(dto.Client)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(api.GetClientParam) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClient'
+type MockClient_GetClient_Call struct {
+	*mock.Call
+}
+
+// GetClient is a helper method to define mock.On call
+//   - _a0 api.GetClientParam
+func (_e *MockClient_Expecter) GetClient(_a0 interface{}) *MockClient_GetClient_Call {
+	return &MockClient_GetClient_Call{Call: _e.mock.On("GetClient", _a0)}
+}
+
+func (_c *MockClient_GetClient_Call) Run(run func(_a0 api.GetClientParam)) *MockClient_GetClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.GetClientParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetClient_Call) Return(_a0 dto.Client, _a1 error) *MockClient_GetClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetClients provides a mock function with given fields: _a0
 func (_m *MockClient) GetClients(_a0 api.GetClientsParam) ([]dto.Client, error) {
 	ret := _m.Called(_a0)
@@ -633,6 +811,44 @@ func (_c *MockClient_GetProjects_Call) Return(_a0 []dto.Project, _a1 error) *Moc
 	return _c
 }
 
+// GetProjectsByPage provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetProjectsByPage(_a0 api.GetProjectsParam, _a1 func([]dto.Project) error) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(api.GetProjectsParam, func([]dto.Project) error) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_GetProjectsByPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectsByPage'
+type MockClient_GetProjectsByPage_Call struct {
+	*mock.Call
+}
+
+// GetProjectsByPage is a helper method to define mock.On call
+//   - _a0 api.GetProjectsParam
+//   - _a1 func([]dto.Project) error
+func (_e *MockClient_Expecter) GetProjectsByPage(_a0 interface{}, _a1 interface{}) *MockClient_GetProjectsByPage_Call {
+	return &MockClient_GetProjectsByPage_Call{Call: _e.mock.On("GetProjectsByPage", _a0, _a1)}
+}
+
+func (_c *MockClient_GetProjectsByPage_Call) Run(run func(_a0 api.GetProjectsParam, _a1 func([]dto.Project) error)) *MockClient_GetProjectsByPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.GetProjectsParam), args[1].(func([]dto.Project) error))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetProjectsByPage_Call) Return(_a0 error) *MockClient_GetProjectsByPage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // GetTag provides a mock function with given fields: _a0
 func (_m *MockClient) GetTag(_a0 api.GetTagParam) (*dto.Tag, error) {
 	ret := _m.Called(_a0)
@@ -951,6 +1167,52 @@ func (_c *MockClient_GetUser_Call) Return(_a0 dto.User, _a1 error) *MockClient_G
 	return _c
 }
 
+// GetUserGroups provides a mock function with given fields: _a0
+func (_m *MockClient) GetUserGroups(_a0 api.GetUserGroupsParam) ([]dto.UserGroup, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []dto.UserGroup
+	if rf, ok := ret.Get(0).(func(api.GetUserGroupsParam) []dto.UserGroup); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(api.GetUserGroupsParam) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetUserGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserGroups'
+type MockClient_GetUserGroups_Call struct {
+	*mock.Call
+}
+
+// GetUserGroups is a helper method to define mock.On call
+//   - _a0 api.GetUserGroupsParam
+func (_e *MockClient_Expecter) GetUserGroups(_a0 interface{}) *MockClient_GetUserGroups_Call {
+	return &MockClient_GetUserGroups_Call{Call: _e.mock.On("GetUserGroups", _a0)}
+}
+
+func (_c *MockClient_GetUserGroups_Call) Run(run func(_a0 api.GetUserGroupsParam)) *MockClient_GetUserGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.GetUserGroupsParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetUserGroups_Call) Return(_a0 []dto.UserGroup, _a1 error) *MockClient_GetUserGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetUserTimeEntries provides a mock function with given fields: _a0
 func (_m *MockClient) GetUserTimeEntries(_a0 api.GetUserTimeEntriesParam) ([]dto.TimeEntryImpl, error) {
 	ret := _m.Called(_a0)
@@ -1087,6 +1349,52 @@ func (_c *MockClient_GetWorkspace_Call) Return(_a0 dto.Workspace, _a1 error) *Mo
 	return _c
 }
 
+// GetWorkspaceCustomFields provides a mock function with given fields: _a0
+func (_m *MockClient) GetWorkspaceCustomFields(_a0 api.GetWorkspaceCustomFieldsParam) ([]dto.WorkspaceCustomField, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []dto.WorkspaceCustomField
+	if rf, ok := ret.Get(0).(func(api.GetWorkspaceCustomFieldsParam) []dto.WorkspaceCustomField); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.WorkspaceCustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(api.GetWorkspaceCustomFieldsParam) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetWorkspaceCustomFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceCustomFields'
+type MockClient_GetWorkspaceCustomFields_Call struct {
+	*mock.Call
+}
+
+// GetWorkspaceCustomFields is a helper method to define mock.On call
+//   - _a0 api.GetWorkspaceCustomFieldsParam
+func (_e *MockClient_Expecter) GetWorkspaceCustomFields(_a0 interface{}) *MockClient_GetWorkspaceCustomFields_Call {
+	return &MockClient_GetWorkspaceCustomFields_Call{Call: _e.mock.On("GetWorkspaceCustomFields", _a0)}
+}
+
+func (_c *MockClient_GetWorkspaceCustomFields_Call) Run(run func(_a0 api.GetWorkspaceCustomFieldsParam)) *MockClient_GetWorkspaceCustomFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.GetWorkspaceCustomFieldsParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetWorkspaceCustomFields_Call) Return(_a0 []dto.WorkspaceCustomField, _a1 error) *MockClient_GetWorkspaceCustomFields_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetWorkspaces provides a mock function with given fields: _a0
 func (_m *MockClient) GetWorkspaces(_a0 api.GetWorkspaces) ([]dto.Workspace, error) {
 	ret := _m.Called(_a0)
@@ -1225,6 +1533,44 @@ func (_c *MockClient_LogRange_Call) Return(_a0 []dto.TimeEntry, _a1 error) *Mock
 	return _c
 }
 
+// LogRangeByPage provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) LogRangeByPage(_a0 api.LogRangeParam, _a1 func([]dto.TimeEntry) error) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(api.LogRangeParam, func([]dto.TimeEntry) error) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_LogRangeByPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogRangeByPage'
+type MockClient_LogRangeByPage_Call struct {
+	*mock.Call
+}
+
+// LogRangeByPage is a helper method to define mock.On call
+//   - _a0 api.LogRangeParam
+//   - _a1 func([]dto.TimeEntry) error
+func (_e *MockClient_Expecter) LogRangeByPage(_a0 interface{}, _a1 interface{}) *MockClient_LogRangeByPage_Call {
+	return &MockClient_LogRangeByPage_Call{Call: _e.mock.On("LogRangeByPage", _a0, _a1)}
+}
+
+func (_c *MockClient_LogRangeByPage_Call) Run(run func(_a0 api.LogRangeParam, _a1 func([]dto.TimeEntry) error)) *MockClient_LogRangeByPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.LogRangeParam), args[1].(func([]dto.TimeEntry) error))
+	})
+	return _c
+}
+
+func (_c *MockClient_LogRangeByPage_Call) Return(_a0 error) *MockClient_LogRangeByPage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // Out provides a mock function with given fields: _a0
 func (_m *MockClient) Out(_a0 api.OutParam) error {
 	ret := _m.Called(_a0)
@@ -1262,6 +1608,45 @@ func (_c *MockClient_Out_Call) Return(_a0 error) *MockClient_Out_Call {
 	return _c
 }
 
+// SetConcurrency provides a mock function with given fields: pages
+func (_m *MockClient) SetConcurrency(pages int) api.Client {
+	ret := _m.Called(pages)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(int) api.Client); ok {
+		r0 = rf(pages)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetConcurrency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConcurrency'
+type MockClient_SetConcurrency_Call struct {
+	*mock.Call
+}
+
+// SetConcurrency is a helper method to define mock.On call
+//   - pages int
+func (_e *MockClient_Expecter) SetConcurrency(pages interface{}) *MockClient_SetConcurrency_Call {
+	return &MockClient_SetConcurrency_Call{Call: _e.mock.On("SetConcurrency", pages)}
+}
+
+func (_c *MockClient_SetConcurrency_Call) Run(run func(pages int)) *MockClient_SetConcurrency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetConcurrency_Call) Return(_a0 api.Client) *MockClient_SetConcurrency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // SetDebugLogger provides a mock function with given fields: logger
 func (_m *MockClient) SetDebugLogger(logger api.Logger) api.Client {
 	ret := _m.Called(logger)
@@ -1340,6 +1725,206 @@ func (_c *MockClient_SetInfoLogger_Call) Return(_a0 api.Client) *MockClient_SetI
 	return _c
 }
 
+// SetRateLimit provides a mock function with given fields: perSecond
+func (_m *MockClient) SetRateLimit(perSecond int) api.Client {
+	ret := _m.Called(perSecond)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(int) api.Client); ok {
+		r0 = rf(perSecond)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetRateLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRateLimit'
+type MockClient_SetRateLimit_Call struct {
+	*mock.Call
+}
+
+// SetRateLimit is a helper method to define mock.On call
+//   - perSecond int
+func (_e *MockClient_Expecter) SetRateLimit(perSecond interface{}) *MockClient_SetRateLimit_Call {
+	return &MockClient_SetRateLimit_Call{Call: _e.mock.On("SetRateLimit", perSecond)}
+}
+
+func (_c *MockClient_SetRateLimit_Call) Run(run func(perSecond int)) *MockClient_SetRateLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetRateLimit_Call) Return(_a0 api.Client) *MockClient_SetRateLimit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SetRequestTimeout provides a mock function with given fields: _a0
+func (_m *MockClient) SetRequestTimeout(_a0 time.Duration) api.Client {
+	ret := _m.Called(_a0)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(time.Duration) api.Client); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetRequestTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRequestTimeout'
+type MockClient_SetRequestTimeout_Call struct {
+	*mock.Call
+}
+
+// SetRequestTimeout is a helper method to define mock.On call
+//   - _a0 time.Duration
+func (_e *MockClient_Expecter) SetRequestTimeout(_a0 interface{}) *MockClient_SetRequestTimeout_Call {
+	return &MockClient_SetRequestTimeout_Call{Call: _e.mock.On("SetRequestTimeout", _a0)}
+}
+
+func (_c *MockClient_SetRequestTimeout_Call) Run(run func(_a0 time.Duration)) *MockClient_SetRequestTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetRequestTimeout_Call) Return(_a0 api.Client) *MockClient_SetRequestTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SetRetryPolicy provides a mock function with given fields: _a0
+func (_m *MockClient) SetRetryPolicy(_a0 api.RetryPolicy) api.Client {
+	ret := _m.Called(_a0)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(api.RetryPolicy) api.Client); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetRetryPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRetryPolicy'
+type MockClient_SetRetryPolicy_Call struct {
+	*mock.Call
+}
+
+// SetRetryPolicy is a helper method to define mock.On call
+//   - _a0 api.RetryPolicy
+func (_e *MockClient_Expecter) SetRetryPolicy(_a0 interface{}) *MockClient_SetRetryPolicy_Call {
+	return &MockClient_SetRetryPolicy_Call{Call: _e.mock.On("SetRetryPolicy", _a0)}
+}
+
+func (_c *MockClient_SetRetryPolicy_Call) Run(run func(_a0 api.RetryPolicy)) *MockClient_SetRetryPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.RetryPolicy))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetRetryPolicy_Call) Return(_a0 api.Client) *MockClient_SetRetryPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SetStructuredLogger provides a mock function with given fields: _a0
+func (_m *MockClient) SetStructuredLogger(_a0 api.StructuredLogger) api.Client {
+	ret := _m.Called(_a0)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(api.StructuredLogger) api.Client); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetStructuredLogger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStructuredLogger'
+type MockClient_SetStructuredLogger_Call struct {
+	*mock.Call
+}
+
+// SetStructuredLogger is a helper method to define mock.On call
+//   - _a0 api.StructuredLogger
+func (_e *MockClient_Expecter) SetStructuredLogger(_a0 interface{}) *MockClient_SetStructuredLogger_Call {
+	return &MockClient_SetStructuredLogger_Call{Call: _e.mock.On("SetStructuredLogger", _a0)}
+}
+
+func (_c *MockClient_SetStructuredLogger_Call) Run(run func(_a0 api.StructuredLogger)) *MockClient_SetStructuredLogger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.StructuredLogger))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetStructuredLogger_Call) Return(_a0 api.Client) *MockClient_SetStructuredLogger_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// UpdateClient provides a mock function with given fields: _a0
+func (_m *MockClient) UpdateClient(_a0 api.UpdateClientParam) (dto.Client, error) {
+	ret := _m.Called(_a0)
+
+	var r0 dto.Client
+	if rf, ok := ret.Get(0).(func(api.UpdateClientParam) dto.Client); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(dto.Client)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(api.UpdateClientParam) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_UpdateClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClient'
+type MockClient_UpdateClient_Call struct {
+	*mock.Call
+}
+
+// UpdateClient is a helper method to define mock.On call
+//   - _a0 api.UpdateClientParam
+func (_e *MockClient_Expecter) UpdateClient(_a0 interface{}) *MockClient_UpdateClient_Call {
+	return &MockClient_UpdateClient_Call{Call: _e.mock.On("UpdateClient", _a0)}
+}
+
+func (_c *MockClient_UpdateClient_Call) Run(run func(_a0 api.UpdateClientParam)) *MockClient_UpdateClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.UpdateClientParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_UpdateClient_Call) Return(_a0 dto.Client, _a1 error) *MockClient_UpdateClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // UpdateProject provides a mock function with given fields: _a0
 func (_m *MockClient) UpdateProject(_a0 api.UpdateProjectParam) (dto.Project, error) {
 	ret := _m.Called(_a0)
@@ -1604,6 +2189,50 @@ func (_c *MockClient_UpdateProjectUserCostRate_Call) Return(_a0 dto.Project, _a1
 	return _c
 }
 
+// UpdateTag provides a mock function with given fields: _a0
+func (_m *MockClient) UpdateTag(_a0 api.UpdateTagParam) (dto.Tag, error) {
+	ret := _m.Called(_a0)
+
+	var r0 dto.Tag
+	if rf, ok := ret.Get(0).(func(api.UpdateTagParam) dto.Tag); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(dto.Tag)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(api.UpdateTagParam) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type MockClient_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - _a0 api.UpdateTagParam
+func (_e *MockClient_Expecter) UpdateTag(_a0 interface{}) *MockClient_UpdateTag_Call {
+	return &MockClient_UpdateTag_Call{Call: _e.mock.On("UpdateTag", _a0)}
+}
+
+func (_c *MockClient_UpdateTag_Call) Run(run func(_a0 api.UpdateTagParam)) *MockClient_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.UpdateTagParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_UpdateTag_Call) Return(_a0 dto.Tag, _a1 error) *MockClient_UpdateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // UpdateTask provides a mock function with given fields: _a0
 func (_m *MockClient) UpdateTask(_a0 api.UpdateTaskParam) (dto.Task, error) {
 	ret := _m.Called(_a0)
@@ -1692,6 +2321,45 @@ func (_c *MockClient_UpdateTimeEntry_Call) Return(_a0 dto.TimeEntryImpl, _a1 err
 	return _c
 }
 
+// WithContext provides a mock function with given fields: _a0
+func (_m *MockClient) WithContext(_a0 context.Context) api.Client {
+	ret := _m.Called(_a0)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(context.Context) api.Client); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type MockClient_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *MockClient_Expecter) WithContext(_a0 interface{}) *MockClient_WithContext_Call {
+	return &MockClient_WithContext_Call{Call: _e.mock.On("WithContext", _a0)}
+}
+
+func (_c *MockClient_WithContext_Call) Run(run func(_a0 context.Context)) *MockClient_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_WithContext_Call) Return(_a0 api.Client) *MockClient_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // WorkspaceUsers provides a mock function with given fields: _a0
 func (_m *MockClient) WorkspaceUsers(_a0 api.WorkspaceUsersParam) ([]dto.User, error) {
 	ret := _m.Called(_a0)
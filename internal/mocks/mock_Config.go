@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockConfig is an autogenerated mock type for the Config type
 type MockConfig struct {
@@ -282,6 +286,44 @@ func (_c *MockConfig_GetWorkWeekdays_Call) Return(_a0 []string) *MockConfig_GetW
 	return _c
 }
 
+// GetWorkweekHours provides a mock function with given fields:
+func (_m *MockConfig) GetWorkweekHours() map[time.Weekday]time.Duration {
+	ret := _m.Called()
+
+	var r0 map[time.Weekday]time.Duration
+	if rf, ok := ret.Get(0).(func() map[time.Weekday]time.Duration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[time.Weekday]time.Duration)
+		}
+	}
+
+	return r0
+}
+
+// MockConfig_GetWorkweekHours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkweekHours'
+type MockConfig_GetWorkweekHours_Call struct {
+	*mock.Call
+}
+
+// GetWorkweekHours is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) GetWorkweekHours() *MockConfig_GetWorkweekHours_Call {
+	return &MockConfig_GetWorkweekHours_Call{Call: _e.mock.On("GetWorkweekHours")}
+}
+
+func (_c *MockConfig_GetWorkweekHours_Call) Run(run func()) *MockConfig_GetWorkweekHours_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_GetWorkweekHours_Call) Return(_a0 map[time.Weekday]time.Duration) *MockConfig_GetWorkweekHours_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // InteractivePageSize provides a mock function with given fields:
 func (_m *MockConfig) InteractivePageSize() int {
 	ret := _m.Called()
@@ -3,14 +3,13 @@
 package mocks
 
 import (
-	api "github.com/lucassabreu/clockify-cli/api"
-	cmdutil "github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	context "context"
 
+	api "github.com/lucassabreu/clockify-cli/api"
 	dto "github.com/lucassabreu/clockify-cli/api/dto"
-
-	mock "github.com/stretchr/testify/mock"
-
+	cmdutil "github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	ui "github.com/lucassabreu/clockify-cli/pkg/ui"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // MockFactory is an autogenerated mock type for the Factory type
@@ -71,6 +70,42 @@ func (_c *MockFactory_Client_Call) Return(_a0 api.Client, _a1 error) *MockFactor
 	return _c
 }
 
+// Close provides a mock function with given fields:
+func (_m *MockFactory) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFactory_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockFactory_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockFactory_Expecter) Close() *MockFactory_Close_Call {
+	return &MockFactory_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockFactory_Close_Call) Run(run func()) *MockFactory_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFactory_Close_Call) Return(_a0 error) *MockFactory_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // Config provides a mock function with given fields:
 func (_m *MockFactory) Config() cmdutil.Config {
 	ret := _m.Called()
@@ -109,6 +144,44 @@ func (_c *MockFactory_Config_Call) Return(_a0 cmdutil.Config) *MockFactory_Confi
 	return _c
 }
 
+// Context provides a mock function with given fields:
+func (_m *MockFactory) Context() context.Context {
+	ret := _m.Called()
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func() context.Context); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
+// MockFactory_Context_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Context'
+type MockFactory_Context_Call struct {
+	*mock.Call
+}
+
+// Context is a helper method to define mock.On call
+func (_e *MockFactory_Expecter) Context() *MockFactory_Context_Call {
+	return &MockFactory_Context_Call{Call: _e.mock.On("Context")}
+}
+
+func (_c *MockFactory_Context_Call) Run(run func()) *MockFactory_Context_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFactory_Context_Call) Return(_a0 context.Context) *MockFactory_Context_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // GetUserID provides a mock function with given fields:
 func (_m *MockFactory) GetUserID() (string, error) {
 	ret := _m.Called()
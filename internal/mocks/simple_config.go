@@ -1,11 +1,16 @@
 package mocks
 
-import "github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+import (
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+)
 
 // SimpleConfig is used to set configs for tests were changing the config or
 // accessing them with Get and All is not important
 type SimpleConfig struct {
 	WorkweekDays                []string
+	WorkweekHours               map[time.Weekday]time.Duration
 	Interactive                 bool
 	InteractivePageSizeNumber   int
 	AllowNameForID              bool
@@ -19,6 +24,7 @@ type SimpleConfig struct {
 	ShowTotalDuration           bool
 	LogLevelValue               string
 	AllowArchivedTags           bool
+	Offline                     bool
 }
 
 // InteractivePageSize sets how many items are shown when prompting
@@ -43,6 +49,8 @@ func (d *SimpleConfig) GetBool(n string) bool {
 		return d.ShowTotalDuration
 	case cmdutil.CONF_ALLOW_ARCHIVED_TAGS:
 		return d.AllowArchivedTags
+	case cmdutil.CONF_OFFLINE:
+		return d.Offline
 	default:
 		return false
 	}
@@ -116,6 +124,10 @@ func (d *SimpleConfig) GetWorkWeekdays() []string {
 	return d.WorkweekDays
 }
 
+func (d *SimpleConfig) GetWorkweekHours() map[time.Weekday]time.Duration {
+	return d.WorkweekHours
+}
+
 func (*SimpleConfig) Get(_ string) interface{} {
 	panic("should not call")
 }
@@ -0,0 +1,331 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// NewClient wraps the api.Client, so projects, tasks, tags, clients, users
+// and user groups are read from the store when possible, changes made through it will
+// invalidate the data of its kind
+func NewClient(c api.Client, s *Store) api.Client {
+	return &client{Client: c, store: s}
+}
+
+type client struct {
+	api.Client
+	store  *Store
+	bypass bool
+}
+
+type bypassKey struct{}
+
+// Bypass returns a context that makes the clients using it (see
+// api.Client.WithContext) always fetch from Clockify, the store is still
+// updated with the responses
+func Bypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassKey{}, true)
+}
+
+// cached loads v from the store, or uses fetch to fill it and store it
+func (c *client) cached(
+	workspace string, k Kind, params, v interface{}, fetch func() error,
+) error {
+	if !c.bypass && c.store.Get(workspace, k, params, v) {
+		return nil
+	}
+
+	if err := fetch(); err != nil {
+		return err
+	}
+
+	// failing to write the cache should not fail the command
+	_ = c.store.Put(workspace, k, params, v)
+	return nil
+}
+
+func (c *client) invalidate(workspace string, ks ...Kind) {
+	_ = c.store.Invalidate(workspace, ks...)
+}
+
+func (c *client) SetDebugLogger(l api.Logger) api.Client {
+	c.Client = c.Client.SetDebugLogger(l)
+	return c
+}
+
+func (c *client) SetInfoLogger(l api.Logger) api.Client {
+	c.Client = c.Client.SetInfoLogger(l)
+	return c
+}
+
+func (c *client) SetStructuredLogger(l api.StructuredLogger) api.Client {
+	c.Client = c.Client.SetStructuredLogger(l)
+	return c
+}
+
+func (c *client) SetRetryPolicy(p api.RetryPolicy) api.Client {
+	c.Client = c.Client.SetRetryPolicy(p)
+	return c
+}
+
+func (c *client) SetRateLimit(perSecond int) api.Client {
+	c.Client = c.Client.SetRateLimit(perSecond)
+	return c
+}
+
+func (c *client) SetRequestTimeout(d time.Duration) api.Client {
+	c.Client = c.Client.SetRequestTimeout(d)
+	return c
+}
+
+func (c *client) SetConcurrency(pages int) api.Client {
+	c.Client = c.Client.SetConcurrency(pages)
+	return c
+}
+
+func (c *client) WithContext(ctx context.Context) api.Client {
+	return &client{
+		Client: c.Client.WithContext(ctx),
+		store:  c.store,
+		bypass: ctx.Value(bypassKey{}) != nil,
+	}
+}
+
+func (c *client) WorkspaceUsers(p api.WorkspaceUsersParam) (
+	us []dto.User, err error) {
+	err = c.cached(p.Workspace, KindUsers, p, &us, func() (err error) {
+		us, err = c.Client.WorkspaceUsers(p)
+		return
+	})
+	return
+}
+
+func (c *client) GetUser(p api.GetUser) (u dto.User, err error) {
+	err = c.cached(p.Workspace, KindUsers, p, &u, func() (err error) {
+		u, err = c.Client.GetUser(p)
+		return
+	})
+	return
+}
+
+func (c *client) GetUserGroups(p api.GetUserGroupsParam) (
+	gs []dto.UserGroup, err error) {
+	err = c.cached(p.Workspace, KindUserGroups, p, &gs, func() (err error) {
+		gs, err = c.Client.GetUserGroups(p)
+		return
+	})
+	return
+}
+
+func (c *client) GetWorkspaceCustomFields(
+	p api.GetWorkspaceCustomFieldsParam) (
+	cfs []dto.WorkspaceCustomField, err error) {
+	err = c.cached(p.Workspace, KindCustomFields, p, &cfs,
+		func() (err error) {
+			cfs, err = c.Client.GetWorkspaceCustomFields(p)
+			return
+		})
+	return
+}
+
+func (c *client) GetClients(p api.GetClientsParam) (
+	cs []dto.Client, err error) {
+	err = c.cached(p.Workspace, KindClients, p, &cs, func() (err error) {
+		cs, err = c.Client.GetClients(p)
+		return
+	})
+	return
+}
+
+func (c *client) GetClient(p api.GetClientParam) (cl dto.Client, err error) {
+	err = c.cached(p.Workspace, KindClients, p, &cl, func() (err error) {
+		cl, err = c.Client.GetClient(p)
+		return
+	})
+	return
+}
+
+func (c *client) AddClient(p api.AddClientParam) (dto.Client, error) {
+	defer c.invalidate(p.Workspace, KindClients)
+	return c.Client.AddClient(p)
+}
+
+func (c *client) UpdateClient(p api.UpdateClientParam) (dto.Client, error) {
+	defer c.invalidate(p.Workspace, KindClients, KindProjects)
+	return c.Client.UpdateClient(p)
+}
+
+func (c *client) DeleteClient(p api.DeleteClientParam) (dto.Client, error) {
+	defer c.invalidate(p.Workspace, KindClients, KindProjects)
+	return c.Client.DeleteClient(p)
+}
+
+func (c *client) GetTags(p api.GetTagsParam) (ts []dto.Tag, err error) {
+	err = c.cached(p.Workspace, KindTags, p, &ts, func() (err error) {
+		ts, err = c.Client.GetTags(p)
+		return
+	})
+	return
+}
+
+func (c *client) GetTag(p api.GetTagParam) (t *dto.Tag, err error) {
+	err = c.cached(p.Workspace, KindTags, p, &t, func() (err error) {
+		t, err = c.Client.GetTag(p)
+		return
+	})
+	return
+}
+
+func (c *client) AddTag(p api.AddTagParam) (dto.Tag, error) {
+	defer c.invalidate(p.Workspace, KindTags)
+	return c.Client.AddTag(p)
+}
+
+func (c *client) UpdateTag(p api.UpdateTagParam) (dto.Tag, error) {
+	defer c.invalidate(p.Workspace, KindTags)
+	return c.Client.UpdateTag(p)
+}
+
+func (c *client) DeleteTag(p api.DeleteTagParam) (dto.Tag, error) {
+	defer c.invalidate(p.Workspace, KindTags)
+	return c.Client.DeleteTag(p)
+}
+
+func (c *client) GetProjects(p api.GetProjectsParam) (
+	ps []dto.Project, err error) {
+	err = c.cached(p.Workspace, KindProjects, p, &ps, func() (err error) {
+		ps, err = c.Client.GetProjects(p)
+		return
+	})
+
+	if p.Hydrate {
+		for i := range ps {
+			ps[i].Hydrated = true
+		}
+	}
+
+	return
+}
+
+func (c *client) GetProjectsByPage(
+	p api.GetProjectsParam, fn func([]dto.Project) error) error {
+	var ps []dto.Project
+	if c.store.Get(p.Workspace, KindProjects, p, &ps) {
+		if p.Hydrate {
+			for i := range ps {
+				ps[i].Hydrated = true
+			}
+		}
+
+		return fn(ps)
+	}
+
+	ps = []dto.Project{}
+	if err := c.Client.GetProjectsByPage(p, func(l []dto.Project) error {
+		ps = append(ps, l...)
+		return fn(l)
+	}); err != nil {
+		return err
+	}
+
+	_ = c.store.Put(p.Workspace, KindProjects, p, ps)
+	return nil
+}
+
+func (c *client) GetProject(p api.GetProjectParam) (
+	pr *dto.Project, err error) {
+	err = c.cached(p.Workspace, KindProjects, p, &pr, func() (err error) {
+		pr, err = c.Client.GetProject(p)
+		return
+	})
+
+	if p.Hydrate && pr != nil {
+		pr.Hydrated = true
+	}
+
+	return
+}
+
+func (c *client) AddProject(p api.AddProjectParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, KindProjects)
+	return c.Client.AddProject(p)
+}
+
+func (c *client) UpdateProject(p api.UpdateProjectParam) (
+	dto.Project, error) {
+	defer c.invalidate(p.Workspace, KindProjects)
+	return c.Client.UpdateProject(p)
+}
+
+func (c *client) UpdateProjectUserBillableRate(
+	p api.UpdateProjectUserRateParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, KindProjects)
+	return c.Client.UpdateProjectUserBillableRate(p)
+}
+
+func (c *client) UpdateProjectUserCostRate(
+	p api.UpdateProjectUserRateParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, KindProjects)
+	return c.Client.UpdateProjectUserCostRate(p)
+}
+
+func (c *client) UpdateProjectEstimate(p api.UpdateProjectEstimateParam) (
+	dto.Project, error) {
+	defer c.invalidate(p.Workspace, KindProjects)
+	return c.Client.UpdateProjectEstimate(p)
+}
+
+func (c *client) UpdateProjectMemberships(
+	p api.UpdateProjectMembershipsParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, KindProjects)
+	return c.Client.UpdateProjectMemberships(p)
+}
+
+func (c *client) UpdateProjectTemplate(p api.UpdateProjectTemplateParam) (
+	dto.Project, error) {
+	defer c.invalidate(p.Workspace, KindProjects)
+	return c.Client.UpdateProjectTemplate(p)
+}
+
+func (c *client) DeleteProject(p api.DeleteProjectParam) (
+	dto.Project, error) {
+	defer c.invalidate(p.Workspace, KindProjects, KindTasks)
+	return c.Client.DeleteProject(p)
+}
+
+func (c *client) GetTasks(p api.GetTasksParam) (ts []dto.Task, err error) {
+	err = c.cached(p.Workspace, KindTasks, p, &ts, func() (err error) {
+		ts, err = c.Client.GetTasks(p)
+		return
+	})
+	return
+}
+
+func (c *client) GetTask(p api.GetTaskParam) (t dto.Task, err error) {
+	err = c.cached(p.Workspace, KindTasks, p, &t, func() (err error) {
+		t, err = c.Client.GetTask(p)
+		return
+	})
+	return
+}
+
+// tasks are also part of hydrated projects, so both kinds are invalidated
+// when a task changes
+
+func (c *client) AddTask(p api.AddTaskParam) (dto.Task, error) {
+	defer c.invalidate(p.Workspace, KindTasks, KindProjects)
+	return c.Client.AddTask(p)
+}
+
+func (c *client) UpdateTask(p api.UpdateTaskParam) (dto.Task, error) {
+	defer c.invalidate(p.Workspace, KindTasks, KindProjects)
+	return c.Client.UpdateTask(p)
+}
+
+func (c *client) DeleteTask(p api.DeleteTaskParam) (dto.Task, error) {
+	defer c.invalidate(p.Workspace, KindTasks, KindProjects)
+	return c.Client.DeleteTask(p)
+}
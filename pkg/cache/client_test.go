@@ -0,0 +1,303 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientUsesCache(t *testing.T) {
+	m := mocks.NewMockClient(t)
+	c := cache.NewClient(m, cache.NewStore(t.TempDir(), time.Hour))
+
+	p := api.GetProjectsParam{Workspace: "w", Hydrate: true}
+	m.On("GetProjects", p).
+		Return([]dto.Project{{ID: "p1", Name: "first"}}, nil).
+		Once()
+
+	for i := 0; i < 2; i++ {
+		ps, err := c.GetProjects(p)
+		if !assert.NoError(t, err) {
+			return
+		}
+		if assert.Len(t, ps, 1) {
+			assert.Equal(t, "first", ps[0].Name)
+			assert.True(t, ps[0].Hydrated)
+		}
+	}
+
+	other := api.GetProjectsParam{Workspace: "w", Name: "other"}
+	m.On("GetProjects", other).
+		Return([]dto.Project{}, nil).
+		Once()
+
+	ps, err := c.GetProjects(other)
+	if assert.NoError(t, err) {
+		assert.Len(t, ps, 0)
+	}
+}
+
+func TestClientDoesNotCacheErrors(t *testing.T) {
+	m := mocks.NewMockClient(t)
+	c := cache.NewClient(m, cache.NewStore(t.TempDir(), time.Hour))
+
+	p := api.GetTagsParam{Workspace: "w"}
+	m.On("GetTags", p).
+		Return(nil, errors.New("http error")).
+		Once()
+	m.On("GetTags", p).
+		Return([]dto.Tag{{ID: "t1"}}, nil).
+		Once()
+
+	_, err := c.GetTags(p)
+	assert.EqualError(t, err, "http error")
+
+	ts, err := c.GetTags(p)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []dto.Tag{{ID: "t1"}}, ts)
+	}
+}
+
+func TestClientExpires(t *testing.T) {
+	m := mocks.NewMockClient(t)
+	c := cache.NewClient(m, cache.NewStore(t.TempDir(), time.Nanosecond))
+
+	p := api.GetClientsParam{Workspace: "w"}
+	m.On("GetClients", p).
+		Return([]dto.Client{{ID: "c1"}}, nil).
+		Twice()
+
+	for i := 0; i < 2; i++ {
+		time.Sleep(time.Millisecond)
+		_, err := c.GetClients(p)
+		if !assert.NoError(t, err) {
+			return
+		}
+	}
+}
+
+func TestClientBypass(t *testing.T) {
+	m := mocks.NewMockClient(t)
+	c := cache.NewClient(m, cache.NewStore(t.TempDir(), time.Hour))
+
+	p := api.GetProjectParam{Workspace: "w", ProjectID: "p1"}
+	m.On("GetProject", p).
+		Return(&dto.Project{ID: "p1", Name: "old"}, nil).
+		Once()
+	m.On("GetProject", p).
+		Return(&dto.Project{ID: "p1", Name: "new"}, nil).
+		Once()
+
+	_, err := c.GetProject(p)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	m.On("WithContext", mock.Anything).Return(m).Once()
+	pr, err := c.WithContext(cache.Bypass(context.Background())).
+		GetProject(p)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "new", pr.Name)
+	}
+
+	pr, err = c.GetProject(p)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "new", pr.Name, "store should be updated")
+	}
+}
+
+func TestClientInvalidatesOnChanges(t *testing.T) {
+	m := mocks.NewMockClient(t)
+	s := cache.NewStore(t.TempDir(), time.Hour)
+	c := cache.NewClient(m, s)
+
+	pp := api.GetProjectsParam{Workspace: "w"}
+	tp := api.GetTasksParam{Workspace: "w", ProjectID: "p1"}
+	cp := api.GetClientsParam{Workspace: "w"}
+	m.On("GetProjects", pp).Return([]dto.Project{}, nil).Times(3)
+	m.On("GetTasks", tp).Return([]dto.Task{}, nil).Times(2)
+	m.On("GetClients", cp).Return([]dto.Client{}, nil).Times(2)
+
+	load := func() {
+		_, _ = c.GetProjects(pp)
+		_, _ = c.GetTasks(tp)
+		_, _ = c.GetClients(cp)
+	}
+
+	load()
+	load()
+
+	ap := api.AddProjectParam{Workspace: "w", Name: "new"}
+	m.On("AddProject", ap).Return(dto.Project{ID: "p2"}, nil).Once()
+	_, err := c.AddProject(ap)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	load()
+
+	at := api.AddTaskParam{Workspace: "w", ProjectID: "p1", Name: "task"}
+	m.On("AddTask", at).Return(dto.Task{}, errors.New("http error")).Once()
+	_, err = c.AddTask(at)
+	assert.Error(t, err)
+
+	load()
+
+	ac := api.AddClientParam{Workspace: "w", Name: "new"}
+	m.On("AddClient", ac).Return(dto.Client{}, nil).Once()
+	_, err = c.AddClient(ac)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	load()
+
+	ss, err := s.Status()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, ss, 3)
+}
+
+func TestClientInvalidatesTags(t *testing.T) {
+	m := mocks.NewMockClient(t)
+	c := cache.NewClient(m, cache.NewStore(t.TempDir(), time.Hour))
+
+	p := api.GetTagsParam{Workspace: "w"}
+	m.On("GetTags", p).Return([]dto.Tag{}, nil).Times(4)
+
+	b := true
+	up := api.UpdateTagParam{Workspace: "w", TagID: "t1", Archived: &b}
+	m.On("AddTag", api.AddTagParam{Workspace: "w", Name: "new"}).
+		Return(dto.Tag{ID: "t1"}, nil).Once()
+	m.On("UpdateTag", up).Return(dto.Tag{ID: "t1"}, nil).Once()
+	m.On("DeleteTag", api.DeleteTagParam{Workspace: "w", TagID: "t1"}).
+		Return(dto.Tag{ID: "t1"}, nil).Once()
+
+	load := func() {
+		_, _ = c.GetTags(p)
+		_, _ = c.GetTags(p)
+	}
+
+	load()
+
+	_, err := c.AddTag(api.AddTagParam{Workspace: "w", Name: "new"})
+	assert.NoError(t, err)
+	load()
+
+	_, err = c.UpdateTag(up)
+	assert.NoError(t, err)
+	load()
+
+	_, err = c.DeleteTag(api.DeleteTagParam{Workspace: "w", TagID: "t1"})
+	assert.NoError(t, err)
+	load()
+}
+
+func TestClientInvalidatesClientsAndProjects(t *testing.T) {
+	m := mocks.NewMockClient(t)
+	c := cache.NewClient(m, cache.NewStore(t.TempDir(), time.Hour))
+
+	gc := api.GetClientParam{Workspace: "w", ClientID: "c1"}
+	gp := api.GetProjectsParam{Workspace: "w", Clients: []string{"c1"}}
+	m.On("GetClient", gc).Return(dto.Client{ID: "c1"}, nil).Times(3)
+	m.On("GetProjects", gp).Return([]dto.Project{}, nil).Times(3)
+
+	up := api.UpdateClientParam{Workspace: "w", ClientID: "c1", Name: "new"}
+	m.On("UpdateClient", up).Return(dto.Client{ID: "c1"}, nil).Once()
+	m.On("DeleteClient", api.DeleteClientParam{Workspace: "w", ClientID: "c1"}).
+		Return(dto.Client{ID: "c1"}, nil).Once()
+
+	load := func() {
+		_, _ = c.GetClient(gc)
+		_, _ = c.GetClient(gc)
+		_, _ = c.GetProjects(gp)
+		_, _ = c.GetProjects(gp)
+	}
+
+	load()
+
+	_, err := c.UpdateClient(up)
+	assert.NoError(t, err)
+	load()
+
+	_, err = c.DeleteClient(
+		api.DeleteClientParam{Workspace: "w", ClientID: "c1"})
+	assert.NoError(t, err)
+	load()
+}
+
+func TestStoreClearAndStatus(t *testing.T) {
+	s := cache.NewStore(t.TempDir(), time.Hour)
+
+	ss, err := s.Status()
+	if assert.NoError(t, err) {
+		assert.Len(t, ss, 0)
+	}
+
+	for _, w := range []string{"w2", "w1"} {
+		if !assert.NoError(t, s.Put(w, cache.KindTags, "a", []string{"1"})) ||
+			!assert.NoError(t, s.Put(w, cache.KindTags, "b", []string{"2"})) ||
+			!assert.NoError(t, s.Put(w, cache.KindUsers, "a", "u")) {
+			return
+		}
+	}
+
+	ss, err = s.Status()
+	if !assert.NoError(t, err) || !assert.Len(t, ss, 4) {
+		return
+	}
+	assert.Equal(t, "w1", ss[0].Workspace)
+	assert.Equal(t, cache.KindTags, ss[0].Kind)
+	assert.Equal(t, 2, ss[0].Entries)
+	assert.Equal(t, 0, ss[0].Expired)
+	assert.Equal(t, cache.KindUsers, ss[1].Kind)
+
+	var v []string
+	assert.True(t, s.Get("w1", cache.KindTags, "b", &v))
+	assert.Equal(t, []string{"2"}, v)
+
+	if !assert.NoError(t, s.Invalidate("w1", cache.KindTags)) {
+		return
+	}
+	assert.False(t, s.Get("w1", cache.KindTags, "b", &v))
+	assert.True(t, s.Get("w2", cache.KindTags, "b", &v))
+
+	if !assert.NoError(t, s.Clear()) {
+		return
+	}
+
+	ss, err = s.Status()
+	if assert.NoError(t, err) {
+		assert.Len(t, ss, 0)
+	}
+}
+
+func TestStoreForAccount(t *testing.T) {
+	dir := t.TempDir()
+	s := cache.NewStore(dir, time.Hour)
+	a := s.ForAccount("token", "https://api.clockify.me/api")
+
+	if !assert.NoError(t, a.Put("w", cache.KindTags, "a", []string{"1"})) {
+		return
+	}
+
+	var v []string
+	assert.True(t, s.ForAccount("token", "https://api.clockify.me/api").
+		Get("w", cache.KindTags, "a", &v))
+	assert.Equal(t, []string{"1"}, v)
+
+	assert.False(t, s.ForAccount("other", "https://api.clockify.me/api").
+		Get("w", cache.KindTags, "a", &v))
+	assert.False(t, s.ForAccount("token", "https://euc1.clockify.me/api").
+		Get("w", cache.KindTags, "a", &v))
+	assert.False(t, s.Get("w", cache.KindTags, "a", &v))
+}
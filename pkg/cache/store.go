@@ -0,0 +1,258 @@
+// Package cache stores reference data fetched from Clockify (projects, tasks,
+// tags, clients and users) on disk, so commands and shell completions don't
+// need to fetch it again until it expires.
+package cache
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Kind is the type of data stored on the cache
+type Kind string
+
+const (
+	KindProjects     = Kind("projects")
+	KindTasks        = Kind("tasks")
+	KindTags         = Kind("tags")
+	KindClients      = Kind("clients")
+	KindUsers        = Kind("users")
+	KindUserGroups   = Kind("user-groups")
+	KindCustomFields = Kind("custom-fields")
+)
+
+// DefaultDir returns where the cache is stored when no other directory is
+// set, inside the user's cache directory
+func DefaultDir() (string, error) {
+	d, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(d, "clockify-cli"), nil
+}
+
+// Store persists responses on a directory, separated by account, workspace
+// and kind of data, they expire after the TTL
+type Store struct {
+	dir     string
+	account string
+	ttl     time.Duration
+}
+
+// NewStore creates a Store using the directory
+func NewStore(dir string, ttl time.Duration) *Store {
+	return &Store{dir: dir, ttl: ttl}
+}
+
+// ForAccount returns a copy of the Store keeping the data apart from other
+// accounts, identified by the token and the API base URL
+func (s *Store) ForAccount(token, baseURL string) *Store {
+	h := sha1.Sum([]byte(baseURL + "\n" + token))
+
+	ns := *s
+	ns.account = hex.EncodeToString(h[:])
+	return &ns
+}
+
+// WithTTL returns a copy of the Store using another TTL
+func (s *Store) WithTTL(ttl time.Duration) *Store {
+	ns := *s
+	ns.ttl = ttl
+	return &ns
+}
+
+// Dir is where the cache is stored
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// TTL is how long a response will be used
+func (s *Store) TTL() time.Duration {
+	return s.ttl
+}
+
+type entry struct {
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func (s *Store) path(workspace string, k Kind, params interface{}) string {
+	b, _ := json.Marshal(params)
+	h := sha1.Sum(b)
+
+	return filepath.Join(
+		s.dir, s.account, workspace, string(k), hex.EncodeToString(h[:])+".json")
+}
+
+// Get loads into v the data stored for the params, returning false if there
+// is none or if it expired
+func (s *Store) Get(
+	workspace string, k Kind, params interface{}, v interface{}) bool {
+	b, err := os.ReadFile(s.path(workspace, k, params))
+	if err != nil {
+		return false
+	}
+
+	var e entry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return false
+	}
+
+	if time.Since(e.CreatedAt) > s.ttl {
+		return false
+	}
+
+	return json.Unmarshal(e.Data, v) == nil
+}
+
+// Put stores v as the data for the params
+func (s *Store) Put(
+	workspace string, k Kind, params interface{}, v interface{}) error {
+	d, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(entry{CreatedAt: time.Now(), Data: d})
+	if err != nil {
+		return err
+	}
+
+	p := s.path(workspace, k, params)
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(p), "*.tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), p)
+}
+
+// Invalidate removes all data of the kinds stored for the workspace
+func (s *Store) Invalidate(workspace string, ks ...Kind) error {
+	for _, k := range ks {
+		if err := os.RemoveAll(
+			filepath.Join(s.dir, s.account, workspace, string(k))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Clear removes all the data stored, of every account
+func (s *Store) Clear() error {
+	return os.RemoveAll(s.dir)
+}
+
+// Status summarizes what is stored for a kind of data on a workspace
+type Status struct {
+	Workspace string
+	Kind      Kind
+	Entries   int
+	Expired   int
+	Size      int64
+	UpdatedAt time.Time
+}
+
+// Status lists what is stored on the cache for the account, by workspace and
+// kind
+func (s *Store) Status() ([]Status, error) {
+	ss := make([]Status, 0)
+
+	ws, err := os.ReadDir(filepath.Join(s.dir, s.account))
+	if os.IsNotExist(err) {
+		return ss, nil
+	}
+	if err != nil {
+		return ss, err
+	}
+
+	for _, w := range ws {
+		if !w.IsDir() {
+			continue
+		}
+
+		ks, err := os.ReadDir(filepath.Join(s.dir, s.account, w.Name()))
+		if err != nil {
+			return ss, err
+		}
+
+		for _, k := range ks {
+			if !k.IsDir() {
+				continue
+			}
+
+			st, err := s.status(w.Name(), Kind(k.Name()))
+			if err != nil {
+				return ss, err
+			}
+
+			if st.Entries > 0 {
+				ss = append(ss, st)
+			}
+		}
+	}
+
+	sort.SliceStable(ss, func(i, j int) bool {
+		if ss[i].Workspace != ss[j].Workspace {
+			return ss[i].Workspace < ss[j].Workspace
+		}
+
+		return ss[i].Kind < ss[j].Kind
+	})
+
+	return ss, nil
+}
+
+func (s *Store) status(workspace string, k Kind) (Status, error) {
+	st := Status{Workspace: workspace, Kind: k}
+
+	fs, err := os.ReadDir(filepath.Join(s.dir, s.account, workspace, string(k)))
+	if err != nil {
+		return st, err
+	}
+
+	for _, f := range fs {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+
+		i, err := f.Info()
+		if err != nil {
+			return st, err
+		}
+
+		st.Entries++
+		st.Size += i.Size()
+		if time.Since(i.ModTime()) > s.ttl {
+			st.Expired++
+		}
+
+		if i.ModTime().After(st.UpdatedAt) {
+			st.UpdatedAt = i.ModTime()
+		}
+	}
+
+	return st, nil
+}
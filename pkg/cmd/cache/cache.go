@@ -0,0 +1,28 @@
+package cache
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache/clear"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache/status"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdCache represents the cache command
+func NewCmdCache(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "cache",
+		Short: "Manages the local cache of projects, tasks, tags, clients " +
+			"and users",
+		Long: "Projects, tasks, tags, clients and users are kept on a " +
+			"local cache, so commands and completions don't need to fetch " +
+			"them every time.\n" +
+			"The cache expires after the config cache-ttl and is cleaned " +
+			"when they are changed using the CLI, use --no-cache to skip " +
+			"it.",
+	}
+
+	cmd.AddCommand(status.NewCmdStatus(f))
+	cmd.AddCommand(clear.NewCmdClear(f))
+
+	return cmd
+}
@@ -0,0 +1,35 @@
+package clear
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdClear represents the cache clear command
+func NewCmdClear(f cmdutil.Factory) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear",
+		Args:  cobra.ExactArgs(0),
+		Short: "Removes all data stored on the local cache",
+		Example: heredoc.Doc(`
+			$ clockify-cli cache clear
+			cache cleared
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			s, err := cmdutil.NewCacheStore(f.Config())
+			if err != nil {
+				return err
+			}
+
+			if err := s.Clear(); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "cache cleared")
+			return nil
+		},
+	}
+}
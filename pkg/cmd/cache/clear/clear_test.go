@@ -0,0 +1,53 @@
+package clear_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache/clear"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdClear(t *testing.T) {
+	dir := t.TempDir()
+	s := cache.NewStore(dir, time.Hour).ForAccount("t1", "http://a/api")
+	_ = s.Put("w1", cache.KindTags, "a", "1")
+	_ = s.Put("w2", cache.KindUsers, "a", "2")
+	o := cache.NewStore(dir, time.Hour).ForAccount("t2", "http://a/api")
+	_ = o.Put("w1", cache.KindTags, "a", "1")
+
+	f := mocks.NewMockFactory(t)
+	c := mocks.NewMockConfig(t)
+	f.On("Config").Return(c)
+	c.On("GetString", cmdutil.CONF_CACHE_TTL).Return("")
+	c.On("GetString", cmdutil.CONF_CACHE_DIR).Return(dir)
+	c.On("GetString", cmdutil.CONF_TOKEN).Return("t1")
+	c.On("GetString", cmdutil.CONF_API_URL).Return("http://a/api")
+
+	cmd := clear.NewCmdClear(f)
+	cmd.SetArgs([]string{})
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetErr(b)
+
+	_, err := cmd.ExecuteC()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "cache cleared\n", b.String())
+
+	ss, err := s.Status()
+	if assert.NoError(t, err) {
+		assert.Len(t, ss, 0)
+	}
+
+	ss, err = o.Status()
+	if assert.NoError(t, err) {
+		assert.Len(t, ss, 0)
+	}
+}
@@ -0,0 +1,51 @@
+package status
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/cache"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdStatus represents the cache status command
+func NewCmdStatus(f cmdutil.Factory) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Args:  cobra.ExactArgs(0),
+		Short: "Shows what is stored on the local cache",
+		Example: heredoc.Doc(`
+			$ clockify-cli cache status
+			Directory: /home/john/.cache/clockify-cli
+			TTL: 1h0m0s
+			+--------------------------+----------+---------+---------+-------+---------------------+
+			|        WORKSPACE         |   TYPE   | ENTRIES | EXPIRED | SIZE  |     UPDATED AT      |
+			+--------------------------+----------+---------+---------+-------+---------------------+
+			| 62a3b5c9e4b0d1a2f3c4d5e6 | projects |       2 |       0 | 12 KB | 2022-12-01 10:35:42 |
+			| 62a3b5c9e4b0d1a2f3c4d5e6 | tags     |       1 |       1 | 1 KB  | 2022-11-30 17:02:10 |
+			+--------------------------+----------+---------+---------+-------+---------------------+
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			s, err := cmdutil.NewCacheStore(f.Config())
+			if err != nil {
+				return err
+			}
+
+			ss, err := s.Status()
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintln(out, "Directory: "+s.Dir())
+			if f.Config().GetBool(cmdutil.CONF_NO_CACHE) || s.TTL() == 0 {
+				fmt.Fprintln(out, "TTL: disabled")
+			} else {
+				fmt.Fprintln(out, "TTL: "+s.TTL().String())
+			}
+
+			return output.StatusPrint(ss, out)
+		},
+	}
+}
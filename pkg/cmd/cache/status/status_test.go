@@ -0,0 +1,101 @@
+package status_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache/status"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdStatus(t *testing.T) {
+	tts := []struct {
+		name    string
+		ttl     string
+		noCache bool
+		empty   bool
+		out     []string
+		err     string
+	}{
+		{
+			name: "invalid ttl",
+			ttl:  "1 hour",
+			err:  "config cache-ttl is not a valid duration: 1 hour",
+		},
+		{
+			name:  "empty cache",
+			empty: true,
+			out:   []string{"TTL: 1h0m0s", "WORKSPACE"},
+		},
+		{
+			name: "entries by workspace",
+			ttl:  "30m",
+			out: []string{
+				"TTL: 30m0s",
+				"| w1        | projects |       1 |       0 | 1 KB |",
+				"| w1        | tags     |       2 |       0 | 1 KB |",
+			},
+		},
+		{
+			name:    "disabled",
+			noCache: true,
+			out:     []string{"TTL: disabled"},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if !tt.empty {
+				s := cache.NewStore(dir, time.Hour).
+					ForAccount("t1", api.GlobalRegion.APIURL)
+				_ = s.Put("w1", cache.KindTags, "a", "1")
+				_ = s.Put("w1", cache.KindTags, "b", "2")
+				_ = s.Put("w1", cache.KindProjects, "a", "3")
+
+				// other account
+				_ = cache.NewStore(dir, time.Hour).
+					ForAccount("t2", api.GlobalRegion.APIURL).
+					Put("w2", cache.KindTags, "a", "1")
+			}
+
+			f := mocks.NewMockFactory(t)
+			c := mocks.NewMockConfig(t)
+			f.On("Config").Return(c)
+			c.On("GetString", cmdutil.CONF_CACHE_TTL).Return(tt.ttl)
+			c.On("GetString", cmdutil.CONF_CACHE_DIR).Return(dir).Maybe()
+			c.On("GetString", cmdutil.CONF_TOKEN).Return("t1").Maybe()
+			c.On("GetString", cmdutil.CONF_API_URL).Return("").Maybe()
+			c.On("GetBool", cmdutil.CONF_NO_CACHE).
+				Return(tt.noCache).Maybe()
+
+			cmd := status.NewCmdStatus(f)
+			cmd.SetArgs([]string{})
+			b := bytes.NewBufferString("")
+			cmd.SetOut(b)
+			cmd.SetErr(b)
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Contains(t, b.String(), "Directory: "+dir)
+			for _, l := range tt.out {
+				assert.Contains(t, b.String(), l)
+			}
+			assert.NotContains(t, b.String(), "w2")
+		})
+	}
+}
@@ -2,6 +2,9 @@ package client
 
 import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/add"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/client/delete"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/edit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/get"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/list"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -17,6 +20,9 @@ func NewCmdClient(f cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(list.NewCmdList(f, nil))
 	cmd.AddCommand(add.NewCmdAdd(f, nil))
+	cmd.AddCommand(get.NewCmdGet(f, nil))
+	cmd.AddCommand(edit.NewCmdEdit(f, nil))
+	cmd.AddCommand(del.NewCmdDelete(f, nil))
 
 	return cmd
 }
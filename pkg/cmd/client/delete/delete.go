@@ -0,0 +1,180 @@
+package del
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdDelete represents the delete command
+func NewCmdDelete(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Client) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	yes := false
+	cmd := &cobra.Command{
+		Use:     "delete <client>...",
+		Aliases: []string{"remove", "rm", "del"},
+		Args:    cmdutil.RequiredNamedArgs("client"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewClientAutoComplete(f)),
+		Short: "Deletes clients from a Clockify workspace",
+		Long: heredoc.Doc(`
+			Deletes clients from a Clockify workspace
+
+			Clients must be archived before being deleted, so active clients will be archived first.
+			Before deleting each client the number of projects attached to it will be shown and a confirmation will be asked, use --yes to skip it.
+
+			This action can't be reverted.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s special
+			? Client "Special" (6202634a28782767054eec26) has 2 projects, delete it? Yes
+			+--------------------------+---------+----------+
+			|            ID            |  NAME   | ARCHIVED |
+			+--------------------------+---------+----------+
+			| 6202634a28782767054eec26 | Special | YES      |
+			+--------------------------+---------+----------+
+
+			# deleting multiple clients without confirmation
+			$ %[1]s special 62964b36bb48532a70730dbe --yes -q
+			6202634a28782767054eec26
+			62964b36bb48532a70730dbe
+		`, "clockify-cli client delete"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+			if f.Config().IsAllowNameForID() {
+				if ids, err = search.GetClientsByName(
+					c, w, ids); err != nil {
+					return err
+				}
+			}
+
+			clients := make([]dto.Client, len(ids))
+			var g errgroup.Group
+			for i := range ids {
+				j := i
+				g.Go(func() (err error) {
+					clients[j], err = c.GetClient(api.GetClientParam{
+						Workspace: w,
+						ClientID:  ids[j],
+					})
+					return err
+				})
+			}
+
+			if err := g.Wait(); err != nil {
+				return err
+			}
+
+			if !yes {
+				if clients, err = confirmDeletion(
+					f, c, w, clients); err != nil {
+					return err
+				}
+			}
+
+			deleted := make([]dto.Client, len(clients))
+			var dg errgroup.Group
+			for i := range clients {
+				j := i
+				dg.Go(func() error {
+					if !clients[j].Archived {
+						b := true
+						if _, err := c.UpdateClient(api.UpdateClientParam{
+							Workspace: w,
+							ClientID:  clients[j].ID,
+							Name:      clients[j].Name,
+							Archived:  &b,
+						}); err != nil {
+							return err
+						}
+					}
+
+					cl, err := c.DeleteClient(api.DeleteClientParam{
+						Workspace: w,
+						ClientID:  clients[j].ID,
+					})
+					deleted[j] = cl
+					return err
+				})
+			}
+
+			if err := dg.Wait(); err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, deleted)
+			}
+
+			return util.Report(deleted, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false,
+		"don't ask for confirmation before deleting")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
+
+// confirmDeletion will show how many projects each client has and ask the
+// user to confirm the deletion, returning only the confirmed ones
+func confirmDeletion(
+	f cmdutil.Factory, c api.Client, w string, cs []dto.Client,
+) ([]dto.Client, error) {
+	confirmed := make([]dto.Client, 0, len(cs))
+	for i := range cs {
+		ps, err := c.GetProjects(api.GetProjectsParam{
+			Workspace:       w,
+			Clients:         []string{cs[i].ID},
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return confirmed, err
+		}
+
+		ok, err := f.UI().Confirm(fmt.Sprintf(
+			"Client \"%s\" (%s) has %d projects, delete it?",
+			cs[i].Name, cs[i].ID, len(ps),
+		), false)
+		if err != nil {
+			return confirmed, err
+		}
+
+		if ok {
+			confirmed = append(confirmed, cs[i])
+		}
+	}
+
+	return confirmed, nil
+}
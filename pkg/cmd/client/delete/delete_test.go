@@ -0,0 +1,161 @@
+package del_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/consoletest"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/client/delete"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFactory(t *testing.T, allowNameForID bool) (
+	*mocks.MockFactory, *mocks.MockClient) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+	cf := mocks.NewMockConfig(t)
+	cf.EXPECT().IsAllowNameForID().Return(allowNameForID)
+	f.EXPECT().Config().Return(cf)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	return f, c
+}
+
+func TestCmdDelete(t *testing.T) {
+	t.Run("client is required", func(t *testing.T) {
+		cmd := del.NewCmdDelete(mocks.NewMockFactory(t), nil)
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		if assert.Error(t, err) {
+			assert.Regexp(t, "requires arg client", err.Error())
+		}
+	})
+
+	t.Run("http error", func(t *testing.T) {
+		f, c := newFactory(t, false)
+		c.EXPECT().GetClient(api.GetClientParam{Workspace: "w", ClientID: "c1"}).
+			Return(dto.Client{ID: "c1", Archived: true}, nil)
+		c.EXPECT().DeleteClient(
+			api.DeleteClientParam{Workspace: "w", ClientID: "c1"}).
+			Return(dto.Client{}, errors.New("http error"))
+
+		cmd := del.NewCmdDelete(f, nil)
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{"c1", "--yes"})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "http error")
+	})
+
+	t.Run("archive and delete by name without confirmation", func(t *testing.T) {
+		f, c := newFactory(t, true)
+		c.EXPECT().GetClients(api.GetClientsParam{
+			Workspace:       "w",
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Client{
+			{ID: "c1", Name: "Special"},
+			{ID: "c2", Name: "Other", Archived: true},
+		}, nil)
+
+		c.EXPECT().GetClient(api.GetClientParam{Workspace: "w", ClientID: "c1"}).
+			Return(dto.Client{ID: "c1", Name: "Special"}, nil)
+		c.EXPECT().GetClient(api.GetClientParam{Workspace: "w", ClientID: "c2"}).
+			Return(dto.Client{ID: "c2", Name: "Other", Archived: true}, nil)
+
+		b := true
+		c.EXPECT().UpdateClient(api.UpdateClientParam{
+			Workspace: "w",
+			ClientID:  "c1",
+			Name:      "Special",
+			Archived:  &b,
+		}).Return(dto.Client{ID: "c1", Archived: true}, nil).Once()
+
+		for _, id := range []string{"c1", "c2"} {
+			c.EXPECT().DeleteClient(
+				api.DeleteClientParam{Workspace: "w", ClientID: id}).
+				Return(dto.Client{ID: id, Archived: true}, nil)
+		}
+
+		called := false
+		cmd := del.NewCmdDelete(f, func(
+			_ io.Writer, of *util.OutputFlags, cs []dto.Client) error {
+			called = true
+			assert.True(t, of.Quiet)
+			assert.Equal(t, []dto.Client{
+				{ID: "c2", Archived: true},
+				{ID: "c1", Archived: true},
+			}, cs)
+			return nil
+		})
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{"other", "special", "-y", "-q"})
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+		assert.True(t, called, "was not called")
+	})
+}
+
+func TestCmdDeleteShouldAskForConfirmation(t *testing.T) {
+	consoletest.RunTestConsole(t,
+		func(out consoletest.FileWriter, in consoletest.FileReader) error {
+			f, c := newFactory(t, false)
+			f.EXPECT().UI().Return(ui.NewUI(in, out, out))
+
+			for _, cl := range []dto.Client{
+				{ID: "c1", Name: "Special", Archived: true},
+				{ID: "c2", Name: "Other", Archived: true},
+			} {
+				c.EXPECT().GetClient(api.GetClientParam{
+					Workspace: "w", ClientID: cl.ID}).Return(cl, nil)
+			}
+
+			c.EXPECT().GetProjects(api.GetProjectsParam{
+				Workspace:       "w",
+				Clients:         []string{"c1"},
+				PaginationParam: api.AllPages(),
+			}).Return([]dto.Project{{ID: "p1"}, {ID: "p2"}}, nil)
+			c.EXPECT().GetProjects(api.GetProjectsParam{
+				Workspace:       "w",
+				Clients:         []string{"c2"},
+				PaginationParam: api.AllPages(),
+			}).Return([]dto.Project{}, nil)
+
+			c.EXPECT().DeleteClient(
+				api.DeleteClientParam{Workspace: "w", ClientID: "c2"}).
+				Return(dto.Client{ID: "c2"}, nil)
+
+			called := false
+			t.Cleanup(func() { assert.True(t, called, "was not called") })
+			cmd := del.NewCmdDelete(f, func(
+				_ io.Writer, _ *util.OutputFlags, cs []dto.Client) error {
+				called = true
+				assert.Equal(t, []dto.Client{{ID: "c2"}}, cs)
+				return nil
+			})
+			cmd.SilenceUsage = true
+			cmd.SetArgs([]string{"c1", "c2"})
+
+			_, err := cmd.ExecuteC()
+			return err
+		},
+		func(c consoletest.ExpectConsole) {
+			c.ExpectString(`Client "Special" (c1) has 2 projects, delete it?`)
+			c.SendLine("n")
+			c.ExpectString(`Client "Other" (c2) has 0 projects, delete it?`)
+			c.SendLine("y")
+			c.ExpectEOF()
+		},
+	)
+}
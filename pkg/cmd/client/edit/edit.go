@@ -0,0 +1,132 @@
+package edit
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdEdit updates clients
+func NewCmdEdit(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Client) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "edit <client>...",
+		Aliases: []string{"update"},
+		Args:    cmdutil.RequiredNamedArgs("client"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewClientAutoComplete(f)),
+		Short: "Renames, archives or activates clients, or changes their notes",
+		Example: heredoc.Docf(`
+			# rename a client
+			$ %[1]s 6202634a28782767054eec26 --name "Very Special"
+			+--------------------------+--------------+----------+
+			|            ID            |     NAME     | ARCHIVED |
+			+--------------------------+--------------+----------+
+			| 6202634a28782767054eec26 | Very Special | NO       |
+			+--------------------------+--------------+----------+
+
+			# archive multiple clients
+			$ %[1]s special other --archived --note "old contracts" \
+				--format "{{.Name}} | {{.Archived}} | {{.Note}}"
+			Special | true | old contracts
+			Other | true | old contracts
+
+			# activate a client
+			$ %[1]s special --active -q
+			6202634a28782767054eec26
+		`, "clockify-cli client edit"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if err := cmdutil.XorFlagSet(
+				cmd.Flags(), "archived", "active"); err != nil {
+				return err
+			}
+
+			if len(args) > 1 && cmd.Flags().Changed("name") {
+				return errors.New(
+					"`--name` can't be changed for multiple clients")
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+			if f.Config().IsAllowNameForID() {
+				if ids, err = search.GetClientsByName(
+					c, w, ids); err != nil {
+					return err
+				}
+			}
+
+			p := api.UpdateClientParam{Workspace: w}
+			p.Name, _ = cmd.Flags().GetString("name")
+			if cmd.Flags().Changed("note") {
+				n, _ := cmd.Flags().GetString("note")
+				p.Note = &n
+			}
+
+			if cmd.Flags().Changed("archived") ||
+				cmd.Flags().Changed("active") {
+				b, _ := cmd.Flags().GetBool("archived")
+				p.Archived = &b
+			}
+
+			var g errgroup.Group
+			clients := make([]dto.Client, len(ids))
+			for i := range ids {
+				j := i
+				g.Go(func() error {
+					cp := p
+					cp.ClientID = ids[j]
+					cl, err := c.UpdateClient(cp)
+					clients[j] = cl
+					return err
+				})
+			}
+
+			if err := g.Wait(); err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, clients)
+			}
+
+			return util.Report(clients, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringP("name", "n", "", "new name of the client")
+	cmd.Flags().StringP("note", "N", "", "note about the clients")
+	cmd.Flags().BoolP("archived", "A", false, "set the clients as archived")
+	cmd.Flags().BoolP("active", "a", false, "set the clients as active")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,159 @@
+package edit_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/edit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFactory(t *testing.T, allowNameForID bool) (
+	*mocks.MockFactory, *mocks.MockClient) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+	cf := mocks.NewMockConfig(t)
+	cf.EXPECT().IsAllowNameForID().Return(allowNameForID)
+	f.EXPECT().Config().Return(cf)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	return f, c
+}
+
+func TestCmdEdit(t *testing.T) {
+	bTrue := true
+	bFalse := false
+	note := "old contracts"
+	empty := ""
+
+	tts := []struct {
+		name    string
+		args    []string
+		err     string
+		factory func(*testing.T) cmdutil.Factory
+		expect  []dto.Client
+	}{
+		{
+			name: "client is required",
+			err:  "requires arg client",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "archived and active",
+			args: []string{"c1", "--archived", "--active"},
+			err:  "flags can't be used together.*active.*archived",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "name for multiple clients",
+			args: []string{"c1", "c2", "--name", "new"},
+			err:  "`--name` can't be changed for multiple clients",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "client not found by name",
+			args: []string{"other"},
+			err:  "No client with id or name containing 'other' was found",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f, c := newFactory(t, true)
+				c.EXPECT().GetClients(api.GetClientsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Client{{ID: "c1", Name: "Special"}}, nil)
+				return f
+			},
+		},
+		{
+			name: "http error",
+			args: []string{"c1", "--archived"},
+			err:  "http error",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f, c := newFactory(t, false)
+				c.EXPECT().UpdateClient(api.UpdateClientParam{
+					Workspace: "w",
+					ClientID:  "c1",
+					Archived:  &bTrue,
+				}).Return(dto.Client{}, errors.New("http error"))
+				return f
+			},
+		},
+		{
+			name: "rename and clean note by name",
+			args: []string{"spec", "--name", "Very Special", "--note", ""},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f, c := newFactory(t, true)
+				c.EXPECT().GetClients(api.GetClientsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Client{{ID: "c1", Name: "Special"}}, nil)
+				c.EXPECT().UpdateClient(api.UpdateClientParam{
+					Workspace: "w",
+					ClientID:  "c1",
+					Name:      "Very Special",
+					Note:      &empty,
+				}).Return(dto.Client{ID: "c1", Name: "Very Special"}, nil)
+				return f
+			},
+			expect: []dto.Client{{ID: "c1", Name: "Very Special"}},
+		},
+		{
+			name: "activate multiple with note",
+			args: []string{"c1", "c2", "c1", "--active", "-N", note},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f, c := newFactory(t, false)
+				for _, id := range []string{"c1", "c2"} {
+					c.EXPECT().UpdateClient(api.UpdateClientParam{
+						Workspace: "w",
+						ClientID:  id,
+						Note:      &note,
+						Archived:  &bFalse,
+					}).Return(dto.Client{ID: id, Note: note}, nil).Once()
+				}
+				return f
+			},
+			expect: []dto.Client{{ID: "c1", Note: note}, {ID: "c2", Note: note}},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			cmd := edit.NewCmdEdit(tt.factory(t), func(
+				_ io.Writer, _ *util.OutputFlags, cs []dto.Client) error {
+				called = true
+				assert.Equal(t, tt.expect, cs)
+				return nil
+			})
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				assert.True(t, called, "report was not called")
+				return
+			}
+
+			if assert.Error(t, err) {
+				assert.Regexp(t, tt.err, err.Error())
+			}
+			assert.False(t, called, "report should not be called")
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package get
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/client"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdGet shows a client and the projects attached to it
+func NewCmdGet(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, output.ClientSummary) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "get <client>",
+		Aliases: []string{"show"},
+		Args:    cmdutil.RequiredNamedArgs("client"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewClientAutoComplete(f)),
+		Short: "Shows a client and the projects attached to it",
+		Example: heredoc.Docf(`
+			$ %[1]s special
+			+--------------------------+---------+----------+------+-----------------------------------------+
+			|            ID            |  NAME   | ARCHIVED | NOTE |                PROJECTS                 |
+			+--------------------------+---------+----------+------+-----------------------------------------+
+			| 6202634a28782767054eec26 | Special | NO       |      | Clockify Cli (621948458cb9606d934ebb1c) |
+			|                          |         |          |      | Other (62f19c254a912b05acc6d6cf)        |
+			+--------------------------+---------+----------+------+-----------------------------------------+
+
+			$ %[1]s special --format '{{ .Name }} has {{ len .Projects }} projects'
+			Special has 2 projects
+
+			$ %[1]s special --csv
+			id,name,archived,note,project.id,project.name,project.archived
+			6202634a28782767054eec26,Special,false,,621948458cb9606d934ebb1c,Clockify Cli,false
+			6202634a28782767054eec26,Special,false,,62f19c254a912b05acc6d6cf,Other,false
+		`, "clockify-cli client get"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := strings.TrimSpace(args[0])
+			if id == "" {
+				return errors.New("client id should not be empty")
+			}
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if f.Config().IsAllowNameForID() {
+				if id, err = search.GetClientByName(c, w, id); err != nil {
+					return err
+				}
+			}
+
+			cl, err := c.GetClient(api.GetClientParam{
+				Workspace: w,
+				ClientID:  id,
+			})
+			if err != nil {
+				return err
+			}
+
+			ps, err := c.GetProjects(api.GetProjectsParam{
+				Workspace:       w,
+				Clients:         []string{cl.ID},
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			s := output.NewClientSummary(cl, ps)
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, s)
+			}
+
+			return util.ReportSummary(s, cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,122 @@
+package get_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/get"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFactory(t *testing.T, allowNameForID bool) (
+	*mocks.MockFactory, *mocks.MockClient) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+	cf := mocks.NewMockConfig(t)
+	cf.EXPECT().IsAllowNameForID().Return(allowNameForID)
+	f.EXPECT().Config().Return(cf)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	return f, c
+}
+
+func TestCmdGet(t *testing.T) {
+	t.Run("client is required", func(t *testing.T) {
+		cmd := get.NewCmdGet(mocks.NewMockFactory(t), nil)
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		if assert.Error(t, err) {
+			assert.Regexp(t, "requires arg client", err.Error())
+		}
+	})
+
+	t.Run("http error", func(t *testing.T) {
+		f, c := newFactory(t, false)
+		c.EXPECT().GetClient(api.GetClientParam{Workspace: "w", ClientID: "c1"}).
+			Return(dto.Client{}, errors.New("http error"))
+
+		cmd := get.NewCmdGet(f, nil)
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{"c1"})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "http error")
+	})
+
+	t.Run("by name with projects", func(t *testing.T) {
+		f, c := newFactory(t, true)
+		c.EXPECT().GetClients(api.GetClientsParam{
+			Workspace:       "w",
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Client{{ID: "c1", Name: "Special"}}, nil)
+		c.EXPECT().GetClient(api.GetClientParam{Workspace: "w", ClientID: "c1"}).
+			Return(dto.Client{ID: "c1", Name: "Special", Note: "n"}, nil)
+		c.EXPECT().GetProjects(api.GetProjectsParam{
+			Workspace:       "w",
+			Clients:         []string{"c1"},
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Project{
+			{ID: "p1", Name: "CLI", ClientID: "c1"},
+			{ID: "p2", Name: "Old", ClientID: "c1", Archived: true},
+		}, nil)
+
+		called := false
+		cmd := get.NewCmdGet(f, func(
+			_ io.Writer, _ *util.OutputFlags, s output.ClientSummary) error {
+			called = true
+			assert.Equal(t, output.ClientSummary{
+				Client: dto.Client{ID: "c1", Name: "Special", Note: "n"},
+				Projects: []output.ProjectSummary{
+					{ID: "p1", Name: "CLI"},
+					{ID: "p2", Name: "Old", Archived: true},
+				},
+			}, s)
+			return nil
+		})
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{"spec"})
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+		assert.True(t, called, "was not called")
+	})
+
+	t.Run("csv without projects", func(t *testing.T) {
+		f, c := newFactory(t, false)
+		c.EXPECT().GetClient(api.GetClientParam{Workspace: "w", ClientID: "c1"}).
+			Return(dto.Client{ID: "c1", Name: "Special"}, nil)
+		c.EXPECT().GetProjects(api.GetProjectsParam{
+			Workspace:       "w",
+			Clients:         []string{"c1"},
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Project{}, nil)
+
+		b := bytes.NewBufferString("")
+		cmd := get.NewCmdGet(f, nil)
+		cmd.SilenceUsage = true
+		cmd.SetOut(b)
+		cmd.SetArgs([]string{"c1", "--csv"})
+
+		_, err := cmd.ExecuteC()
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t,
+			"id,name,archived,note,project.id,project.name,project.archived\n"+
+				"c1,Special,false,,,,\n",
+			b.String())
+	})
+}
@@ -50,3 +50,19 @@ func Report(cs []dto.Client, out io.Writer, of OutputFlags) error {
 		return output.ClientPrint(cs, out)
 	}
 }
+
+// ReportSummary prints out the client and its projects
+func ReportSummary(s output.ClientSummary, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.ClientSummaryJSONPrint(s, out)
+	case of.CSV:
+		return output.ClientSummaryCSVPrint(s, out)
+	case of.Format != "":
+		return output.ClientSummaryPrintWithTemplate(of.Format)(s, out)
+	case of.Quiet:
+		return output.ClientPrintQuietly([]dto.Client{s.Client}, out)
+	default:
+		return output.ClientSummaryPrint(s, out)
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/config/get"
 	initialize "github.com/lucassabreu/clockify-cli/pkg/cmd/config/init"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/config/list"
@@ -33,7 +34,49 @@ var validParameters = cmdcompl.ValidArgsMap{
 		"reports with the sum of the time entries duration",
 	cmdutil.CONF_LOG_LEVEL: "how much logs should be shown values: " +
 		"none , error , info and debug",
+	cmdutil.CONF_LOG_FORMAT: "how logs are written, values: text and json " +
+		"(defaults to text)",
+	cmdutil.CONF_LOG_FILE: "file where logs are appended, instead of " +
+		"the stderr",
 	cmdutil.CONF_ALLOW_ARCHIVED_TAGS: "should allow and suggest archived tags",
+	cmdutil.CONF_HOLIDAYS_FILE: "calendar file with the holidays and days " +
+		"off, which are not expected to be worked",
+	cmdutil.CONF_MAX_RETRIES: "how many times a request is retried when " +
+		"rate limited or the server is unavailable (defaults to 3, " +
+		"0 disables it)",
+	cmdutil.CONF_RATE_LIMIT: "max number of requests per second sent to " +
+		"clockify (defaults to 0, no limit)",
+	cmdutil.CONF_CONCURRENCY: "how many pages are fetched at the same " +
+		"time when listing all entities (defaults to 4, 0 or 1 fetches " +
+		"one at a time)",
+	cmdutil.CONF_REQUEST_TIMEOUT: "how long a request to clockify can take, " +
+		"like 30s or 1m (defaults to 30s, 0 disables it)",
+	cmdutil.CONF_TIMEOUT: "how long a command can take to talk with " +
+		"clockify, like 5m (defaults to no limit)",
+	cmdutil.CONF_API_URL: "base URL of the clockify API, used for regional " +
+		"or stand-in servers (defaults to " + api.GlobalRegion.APIURL + ")",
+	cmdutil.CONF_REPORTS_API_URL: "base URL of the clockify reports API " +
+		"(defaults to " + api.GlobalRegion.ReportsURL + ")",
+	cmdutil.CONF_NO_CACHE: "always fetch projects, tasks, tags, clients " +
+		"and users from clockify instead of the local cache",
+	cmdutil.CONF_CACHE_TTL: "how long projects, tasks, tags, clients and " +
+		"users are kept on the local cache, like 30m (defaults to 1h, " +
+		"0 disables it)",
+	cmdutil.CONF_CACHE_DIR: "directory where the local cache is stored " +
+		"(defaults to clockify-cli inside the user's cache directory)",
+	cmdutil.CONF_OFFLINE: "record changes on time entries to be synced " +
+		"later (using sync), instead of sending them to clockify",
+	cmdutil.CONF_JOURNAL_FILE: "file where changes made offline are " +
+		"recorded (defaults to clockify-cli/journal.json inside the " +
+		"user's config directory)",
+}
+
+func init() {
+	for _, d := range cmdutil.GetWeekdays() {
+		validParameters.Set(cmdutil.CONF_WORKWEEK_HOURS+"."+d,
+			"how many hours you are expected to work on "+d+
+				" (defaults to 8 if the day is on workweek-days)")
+	}
 }
 
 // NewCmdConfig represents the config command
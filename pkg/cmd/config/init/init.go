@@ -1,6 +1,7 @@
 package init
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -21,6 +22,10 @@ func NewCmdInit(f cmdutil.Factory) *cobra.Command {
 			i := f.UI()
 			config := f.Config()
 
+			if err := updateRegion(i, config); err != nil {
+				return err
+			}
+
 			var err error
 			token := ""
 			if token, err = i.AskForText("User Generated Token:",
@@ -170,6 +175,63 @@ func NewCmdInit(f cmdutil.Factory) *cobra.Command {
 	return cmd
 }
 
+// customRegion is the option used to inform the API URLs manually, like
+// when using a local stand-in server
+const customRegion = "Custom"
+
+func updateRegion(i ui.UI, config cmdutil.Config) error {
+	apiURL := config.GetString(cmdutil.CONF_API_URL)
+	d := customRegion
+	if apiURL == "" {
+		d = api.GlobalRegion.Name
+	}
+
+	options := make([]string, 0, len(api.Regions)+1)
+	for _, r := range api.Regions {
+		options = append(options, r.Name)
+		if r.APIURL == apiURL {
+			d = r.Name
+		}
+	}
+	options = append(options, customRegion)
+
+	name, err := i.AskFromOptions(
+		"Which region is your Clockify account on?", options, d)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range api.Regions {
+		if r.Name == name {
+			config.SetString(cmdutil.CONF_API_URL, r.APIURL)
+			config.SetString(cmdutil.CONF_REPORTS_API_URL, r.ReportsURL)
+			return nil
+		}
+	}
+
+	validate := func(s string) error {
+		if !cmdutil.IsValidURL(s) {
+			return errors.New("must be a http or https URL")
+		}
+		return nil
+	}
+
+	if apiURL, err = i.AskForValidText("API URL:", validate,
+		ui.WithDefault(apiURL)); err != nil {
+		return err
+	}
+	config.SetString(cmdutil.CONF_API_URL, apiURL)
+
+	reportsURL, err := i.AskForValidText("Reports API URL:", validate,
+		ui.WithDefault(config.GetString(cmdutil.CONF_REPORTS_API_URL)))
+	if err != nil {
+		return err
+	}
+	config.SetString(cmdutil.CONF_REPORTS_API_URL, reportsURL)
+
+	return nil
+}
+
 func updateInt(ui ui.UI, config cmdutil.Config, param, desc string) error {
 	value := config.GetInt(param)
 	value, err := ui.AskForInt(desc, value)
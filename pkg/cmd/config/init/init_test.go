@@ -48,6 +48,12 @@ func TestInitCmd(t *testing.T) {
 			client := mocks.NewMockClient(t)
 
 			f.EXPECT().Config().Return(config)
+			config.EXPECT().GetString(cmdutil.CONF_API_URL).Return("")
+			config.EXPECT().SetString(cmdutil.CONF_API_URL,
+				"https://euc1.clockify.me/api")
+			config.EXPECT().SetString(cmdutil.CONF_REPORTS_API_URL,
+				"https://euc1.clockify.me/report")
+
 			config.EXPECT().GetString(cmdutil.CONF_TOKEN).Return("")
 			config.EXPECT().SetString(cmdutil.CONF_TOKEN, "new token")
 
@@ -109,6 +115,13 @@ func TestInitCmd(t *testing.T) {
 			return err
 		},
 		func(c consoletest.ExpectConsole) {
+			c.ExpectString("Which region is your Clockify account on?")
+			c.ExpectString("Global")
+			c.ExpectString("EU (Germany)")
+			c.ExpectString("Custom")
+			c.SendLine("eu")
+			c.ExpectString("EU (Germany)")
+
 			c.ExpectString("Token:")
 			c.SendLine("new token")
 			c.ExpectString("new token")
@@ -197,6 +210,12 @@ func TestInitCmdCtrlC(t *testing.T) {
 			config := mocks.NewMockConfig(t)
 
 			f.EXPECT().Config().Return(config)
+			config.EXPECT().GetString(cmdutil.CONF_API_URL).Return("")
+			config.EXPECT().SetString(cmdutil.CONF_API_URL,
+				api.GlobalRegion.APIURL)
+			config.EXPECT().SetString(cmdutil.CONF_REPORTS_API_URL,
+				api.GlobalRegion.ReportsURL)
+
 			config.EXPECT().GetString(cmdutil.CONF_TOKEN).Return("")
 
 			f.EXPECT().UI().Return(ui.NewUI(in, out, out))
@@ -210,6 +229,55 @@ func TestInitCmdCtrlC(t *testing.T) {
 			return nil
 		},
 		func(c consoletest.ExpectConsole) {
+			c.ExpectString("Which region")
+			c.SendLine("")
+			c.ExpectString("Global")
+
+			c.ExpectString("Token: ")
+			c.Send(string(terminal.KeyInterrupt))
+
+			c.ExpectEOF()
+		})
+}
+
+func TestInitCmdCustomRegion(t *testing.T) {
+	consoletest.RunTestConsole(t,
+		func(out consoletest.FileWriter, in consoletest.FileReader) error {
+			f := mocks.NewMockFactory(t)
+			config := mocks.NewMockConfig(t)
+
+			f.EXPECT().Config().Return(config)
+			config.EXPECT().GetString(cmdutil.CONF_API_URL).
+				Return("http://localhost:8080/old")
+			config.EXPECT().GetString(cmdutil.CONF_REPORTS_API_URL).
+				Return("")
+			config.EXPECT().SetString(cmdutil.CONF_API_URL,
+				"http://localhost:8080/api")
+			config.EXPECT().SetString(cmdutil.CONF_REPORTS_API_URL,
+				"http://localhost:8080/report")
+
+			config.EXPECT().GetString(cmdutil.CONF_TOKEN).Return("")
+
+			f.EXPECT().UI().Return(ui.NewUI(in, out, out))
+
+			_, err := ini.NewCmdInit(f).ExecuteC()
+			assert.ErrorIs(t, err, terminal.InterruptErr)
+			return nil
+		},
+		func(c consoletest.ExpectConsole) {
+			c.ExpectString("Which region")
+			c.SendLine("")
+			c.ExpectString("Custom")
+
+			c.ExpectString("API URL:")
+			c.ExpectString("http://localhost:8080/old")
+			c.SendLine("http://localhost:8080/api")
+
+			c.ExpectString("Reports API URL:")
+			c.SendLine("localhost:8080")
+			c.ExpectString("must be a http or https URL")
+			c.SendLine("http://localhost:8080/report")
+
 			c.ExpectString("Token: ")
 			c.Send(string(terminal.KeyInterrupt))
 
@@ -1,11 +1,16 @@
 package set
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/logging"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 	"github.com/lucassabreu/clockify-cli/strhlp"
 	"github.com/spf13/cobra"
 )
@@ -26,7 +31,11 @@ func NewCmdSet(
 		Example: heredoc.Docf(`
 			$ %[1]s token "Yamdas569"
 			$ %[1]s workweek-days monday,tuesday,wednesday,thursday,friday
+			$ %[1]s workweek-hours.friday 6.5
 			$ %[1]s show-task true
+			$ %[1]s max-retries 5
+			$ %[1]s request-timeout 1m
+			$ %[1]s api-url https://euc1.clockify.me/api
 			$ %[1]s user.id 4564d5a6s4d54a5s4dasd5
 		`, "clockify-cli config set"),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -34,8 +43,8 @@ func NewCmdSet(
 			value := args[1]
 			config := f.Config()
 
-			switch param {
-			case cmdutil.CONF_WORKWEEK_DAYS:
+			switch {
+			case param == cmdutil.CONF_WORKWEEK_DAYS:
 				ws := strings.Split(strings.ToLower(value), ",")
 				ws = strhlp.Filter(
 					func(s string) bool {
@@ -44,6 +53,53 @@ func NewCmdSet(
 					ws,
 				)
 				config.SetStringSlice(param, ws)
+			case strings.HasPrefix(param, cmdutil.CONF_WORKWEEK_HOURS+"."):
+				day := strings.ToLower(
+					strings.TrimPrefix(param, cmdutil.CONF_WORKWEEK_HOURS+"."))
+				if strhlp.Search(day, cmdutil.GetWeekdays()) == -1 {
+					return fmt.Errorf("%s is not a day of the week", day)
+				}
+
+				if _, err := timehlp.ParseHours(value); err != nil {
+					return err
+				}
+
+				config.SetString(cmdutil.CONF_WORKWEEK_HOURS+"."+day, value)
+			case param == cmdutil.CONF_MAX_RETRIES,
+				param == cmdutil.CONF_RATE_LIMIT,
+				param == cmdutil.CONF_CONCURRENCY:
+				i, err := strconv.Atoi(value)
+				if err != nil || i < 0 {
+					return fmt.Errorf(
+						"%s must be zero or a positive number", param)
+				}
+
+				config.SetInt(param, i)
+			case param == cmdutil.CONF_REQUEST_TIMEOUT,
+				param == cmdutil.CONF_TIMEOUT,
+				param == cmdutil.CONF_CACHE_TTL:
+				if d, err := time.ParseDuration(value); err != nil || d < 0 {
+					return fmt.Errorf(
+						"%s must be a duration, like 30s or 5m", param)
+				}
+
+				config.SetString(param, value)
+			case param == cmdutil.CONF_LOG_FORMAT:
+				if value != logging.FormatText &&
+					value != logging.FormatJSON {
+					return fmt.Errorf("%s must be %s or %s",
+						param, logging.FormatText, logging.FormatJSON)
+				}
+
+				config.SetString(param, value)
+			case param == cmdutil.CONF_API_URL,
+				param == cmdutil.CONF_REPORTS_API_URL:
+				if value != "" && !cmdutil.IsValidURL(value) {
+					return fmt.Errorf(
+						"%s must be a http or https URL", param)
+				}
+
+				config.SetString(param, value)
 			default:
 				config.SetString(param, value)
 			}
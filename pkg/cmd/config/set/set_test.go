@@ -53,6 +53,28 @@ func TestSetCmdRun(t *testing.T) {
 				return c
 			},
 		},
+		tc{
+			name: "set api url",
+			args: []string{cmdutil.CONF_API_URL, "http://localhost:8080/api"},
+			config: func(t *testing.T) cmdutil.Config {
+				c := mocks.NewMockConfig(t)
+				c.On("SetString", cmdutil.CONF_API_URL,
+					"http://localhost:8080/api").Return(nil).Once()
+				c.On("Save").Once().Return(nil)
+				return c
+			},
+		},
+		tc{
+			name: "set log format",
+			args: []string{cmdutil.CONF_LOG_FORMAT, "json"},
+			config: func(t *testing.T) cmdutil.Config {
+				c := mocks.NewMockConfig(t)
+				c.On("SetString", cmdutil.CONF_LOG_FORMAT, "json").
+					Return(nil).Once()
+				c.On("Save").Once().Return(nil)
+				return c
+			},
+		},
 		tc{
 			name: "set weekdays",
 			args: []string{cmdutil.CONF_WORKWEEK_DAYS, "SUNDAY,SATURDAY"},
@@ -77,6 +99,37 @@ func TestSetCmdRun(t *testing.T) {
 				return c
 			},
 		},
+		tc{
+			name: "set workweek hours",
+			args: []string{"workweek-hours.Friday", "6h30m"},
+			config: func(t *testing.T) cmdutil.Config {
+				c := mocks.NewMockConfig(t)
+				c.On("SetString", "workweek-hours.friday", "6h30m").
+					Return(nil).Once()
+				c.On("Save").Once().Return(nil)
+				return c
+			},
+		},
+		tc{
+			name: "set max retries",
+			args: []string{"max-retries", "5"},
+			config: func(t *testing.T) cmdutil.Config {
+				c := mocks.NewMockConfig(t)
+				c.On("SetInt", "max-retries", 5).Return(nil).Once()
+				c.On("Save").Once().Return(nil)
+				return c
+			},
+		},
+		tc{
+			name: "set timeout",
+			args: []string{"timeout", "5m"},
+			config: func(t *testing.T) cmdutil.Config {
+				c := mocks.NewMockConfig(t)
+				c.On("SetString", "timeout", "5m").Return(nil).Once()
+				c.On("Save").Once().Return(nil)
+				return c
+			},
+		},
 	}
 
 	for _, tc := range ts {
@@ -99,3 +152,96 @@ func TestSetCmdRun(t *testing.T) {
 	}
 
 }
+
+func TestSetCmdWorkweekHoursInvalid(t *testing.T) {
+	for _, args := range [][]string{
+		{"workweek-hours.june", "8"},
+		{"workweek-hours.monday", "eight"},
+	} {
+		f := mocks.NewMockFactory(t)
+		f.On("Config").Return(mocks.NewMockConfig(t))
+		cmd := set.NewCmdSet(f, cmdcompl.ValidArgsMap{})
+		b := bytes.NewBufferString("")
+		cmd.SetArgs(args)
+		cmd.SetErr(b)
+		cmd.SetOut(b)
+		_, err := cmd.ExecuteC()
+
+		assert.Error(t, err)
+	}
+}
+
+func TestSetCmdRetriesAndRateLimitInvalid(t *testing.T) {
+	for _, args := range [][]string{
+		{"max-retries", "many"},
+		{"rate-limit", "--", "-1"},
+	} {
+		f := mocks.NewMockFactory(t)
+		f.On("Config").Return(mocks.NewMockConfig(t))
+		cmd := set.NewCmdSet(f, cmdcompl.ValidArgsMap{})
+		b := bytes.NewBufferString("")
+		cmd.SetArgs(args)
+		cmd.SetErr(b)
+		cmd.SetOut(b)
+		_, err := cmd.ExecuteC()
+
+		if assert.Error(t, err) {
+			assert.Regexp(t, "must be zero or a positive number", err.Error())
+		}
+	}
+}
+
+func TestSetCmdTimeoutInvalid(t *testing.T) {
+	for _, args := range [][]string{
+		{"timeout", "5"},
+		{"request-timeout", "--", "-1s"},
+	} {
+		f := mocks.NewMockFactory(t)
+		f.On("Config").Return(mocks.NewMockConfig(t))
+		cmd := set.NewCmdSet(f, cmdcompl.ValidArgsMap{})
+		b := bytes.NewBufferString("")
+		cmd.SetArgs(args)
+		cmd.SetErr(b)
+		cmd.SetOut(b)
+		_, err := cmd.ExecuteC()
+
+		if assert.Error(t, err) {
+			assert.Regexp(t, "must be a duration", err.Error())
+		}
+	}
+}
+
+func TestSetCmdAPIURLInvalid(t *testing.T) {
+	for _, args := range [][]string{
+		{"api-url", "euc1.clockify.me/api"},
+		{"reports-api-url", "ftp://localhost/report"},
+	} {
+		f := mocks.NewMockFactory(t)
+		f.On("Config").Return(mocks.NewMockConfig(t))
+		cmd := set.NewCmdSet(f, cmdcompl.ValidArgsMap{})
+		b := bytes.NewBufferString("")
+		cmd.SetArgs(args)
+		cmd.SetErr(b)
+		cmd.SetOut(b)
+		_, err := cmd.ExecuteC()
+
+		if assert.Error(t, err) {
+			assert.Regexp(t, "must be a http or https URL", err.Error())
+		}
+	}
+}
+
+func TestSetCmdLogFormatInvalid(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.On("Config").Return(mocks.NewMockConfig(t))
+	cmd := set.NewCmdSet(f, cmdcompl.ValidArgsMap{})
+	b := bytes.NewBufferString("")
+	cmd.SetArgs([]string{"log-format", "xml"})
+	cmd.SetErr(b)
+	cmd.SetOut(b)
+	_, err := cmd.ExecuteC()
+
+	if assert.Error(t, err) {
+		assert.Equal(t, "log-format must be text or json", err.Error())
+	}
+}
@@ -3,12 +3,16 @@ package add
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"fmt"
 	"io"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	mutil "github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/lucassabreu/clockify-cli/pkg/search"
 	"github.com/spf13/cobra"
@@ -22,10 +26,16 @@ func NewCmdAdd(
 	of := util.OutputFlags{}
 	p := api.AddProjectParam{}
 	randomColor := false
+	fromTemplate := ""
 	cmd := &cobra.Command{
 		Use:     "add",
 		Aliases: []string{"new", "create"},
 		Short:   "Adds a project to the Clockify workspace",
+		Long: heredoc.Doc(`
+			Adds a project to the Clockify workspace
+
+			When --from-template is set, the color, billable flag, client, members with their rates, estimates and active tasks (with their assignees and user groups) of the template will be copied into the new project, flags informed will override the values of the template.
+		`),
 		Example: heredoc.Docf(`
 			$ %[1]s --name "New One"
 			+--------------------------+---------+--------+
@@ -50,6 +60,8 @@ func NewCmdAdd(
 			$ %[1]s --name "Something" --client="Uber"
 			the following flags can't be used together: color and random-color
 
+			$ %[1]s --name "New Client" --from-template "Default Engagement" -q
+			62a8b6ab027fe4592ef15210
 		`, "clockify-cli project add"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := of.Check(); err != nil {
@@ -74,7 +86,22 @@ func NewCmdAdd(
 				return err
 			}
 
-			if p.ClientId != "" && f.Config().IsAllowNameForID() {
+			var t dto.Project
+			if fromTemplate != "" {
+				if t, err = util.GetProject(
+					f, c, p.Workspace, fromTemplate); err != nil {
+					return err
+				}
+
+				if !t.Template {
+					return fmt.Errorf("project %s is not a template", t.Name)
+				}
+
+				fillFromTemplate(cmd, &p, t, randomColor)
+			}
+
+			if p.ClientId != "" && p.ClientId != t.ClientID &&
+				f.Config().IsAllowNameForID() {
 				cs, err := search.GetClientsByName(
 					c, p.Workspace, []string{p.ClientId})
 				if err != nil {
@@ -96,6 +123,13 @@ func NewCmdAdd(
 				return err
 			}
 
+			if fromTemplate != "" {
+				if project, err = copyFromTemplate(
+					c, p.Workspace, project, t); err != nil {
+					return err
+				}
+			}
+
 			out := cmd.OutOrStdout()
 			if report != nil {
 				return report(out, &of, project)
@@ -120,8 +154,124 @@ func NewCmdAdd(
 		"make the new project public")
 	cmd.Flags().BoolVarP(&p.Billable, "billable", "b", false,
 		"make the new project as billable")
+	cmd.Flags().StringVar(&fromTemplate, "from-template", "",
+		"the id/name of a project template to copy into the new project")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "from-template",
+		cmdcomplutil.NewProjectAutoComplete(f))
 
 	util.AddReportFlags(cmd, &of)
 
 	return cmd
 }
+
+// fillFromTemplate uses the color, billable flag and client of the template
+// when they were not set by flags
+func fillFromTemplate(
+	cmd *cobra.Command, p *api.AddProjectParam, t dto.Project, randomColor bool,
+) {
+	if p.Color == "" && !randomColor {
+		p.Color = t.Color
+	}
+
+	if !cmd.Flags().Changed("billable") {
+		p.Billable = t.Billable
+	}
+
+	if p.ClientId == "" {
+		p.ClientId = t.ClientID
+	}
+}
+
+// copyFromTemplate copies the members and their rates, estimates and active
+// tasks with their assignees and groups of the template into the new project
+func copyFromTemplate(
+	c api.Client, w string, p, t dto.Project,
+) (dto.Project, error) {
+	var err error
+	if len(t.Memberships) > 0 {
+		if p, err = c.UpdateProjectMemberships(
+			api.UpdateProjectMembershipsParam{
+				Workspace:   w,
+				ProjectID:   p.ID,
+				Memberships: mutil.ToUpdateMemberships(t.Memberships),
+			}); err != nil {
+			return p, err
+		}
+	}
+
+	if e, ok := templateEstimate(t); ok {
+		e.Workspace = w
+		e.ProjectID = p.ID
+		if p, err = c.UpdateProjectEstimate(e); err != nil {
+			return p, err
+		}
+	}
+
+	tasks, err := c.GetTasks(api.GetTasksParam{
+		Workspace:       w,
+		ProjectID:       t.ID,
+		Active:          true,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return p, err
+	}
+
+	for i := range tasks {
+		task := api.AddTaskParam{
+			Workspace: w,
+			ProjectID: p.ID,
+			Name:      tasks[i].Name,
+			Billable:  &tasks[i].Billable,
+		}
+
+		if len(tasks[i].AssigneeIDs) > 0 {
+			task.AssigneeIDs = &tasks[i].AssigneeIDs
+		}
+
+		if len(tasks[i].UserGroupIDs) > 0 {
+			task.UserGroupIDs = &tasks[i].UserGroupIDs
+		}
+
+		if tasks[i].Estimate != nil {
+			task.Estimate = &tasks[i].Estimate.Duration
+		}
+
+		if _, err := c.AddTask(task); err != nil {
+			return p, err
+		}
+	}
+
+	return p, nil
+}
+
+// templateEstimate reads the estimate of the template as a update param
+func templateEstimate(t dto.Project) (api.UpdateProjectEstimateParam, bool) {
+	var b dto.BaseEstimate
+	e := api.UpdateProjectEstimateParam{}
+	switch {
+	case t.TimeEstimate.Active:
+		b = t.TimeEstimate.BaseEstimate
+		e.Method = api.EstimateMethodTime
+		e.Estimate = int64(t.TimeEstimate.Estimate.Duration)
+		e.IncludeNonBillable = &t.TimeEstimate.IncludeNonBillable
+	case t.BudgetEstimate.Active:
+		b = t.BudgetEstimate.BaseEstimate
+		e.Method = api.EstimateMethodBudget
+		e.Estimate = int64(t.BudgetEstimate.Estimate)
+	default:
+		return e, false
+	}
+
+	e.Type = api.EstimateTypeProject
+	if b.Type == dto.EstimateTypeAuto {
+		e.Type = api.EstimateTypeTask
+	}
+
+	if b.ResetOptions != nil &&
+		*b.ResetOptions == dto.EstimateResetOptionMonthly {
+		e.ResetOption = api.EstimateResetOptionMonthly
+	}
+
+	return e, true
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
@@ -214,6 +215,149 @@ func TestCmdAdd(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "template must be a template",
+			err:  "project Common is not a template",
+			args: []string{"-n=New", "--from-template=p1"},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				c := mocks.NewMockClient(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+				f.EXPECT().Client().Return(c, nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.EXPECT().Config().Return(cf)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&dto.Project{ID: "p1", Name: "Common"}, nil)
+
+				return f
+			},
+		},
+		{
+			name: "add from template",
+			args: []string{"-n=New", "--from-template=p1", "--color=#fff"},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				c := mocks.NewMockClient(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+				f.EXPECT().Client().Return(c, nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.EXPECT().Config().Return(cf)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+
+				monthly := dto.EstimateResetOptionMonthly
+				tmpl := dto.Project{
+					ID:       "p1",
+					Name:     "Template",
+					Color:    "#000000",
+					ClientID: "c1",
+					Billable: true,
+					Template: true,
+					Memberships: []dto.Membership{
+						{
+							UserID:     "u1",
+							HourlyRate: &dto.Rate{Amount: 100},
+							CostRate:   &dto.Rate{Amount: 60},
+						},
+						{UserID: "u2"},
+					},
+				}
+				tmpl.BudgetEstimate.Active = true
+				tmpl.BudgetEstimate.Type = dto.EstimateTypeManual
+				tmpl.BudgetEstimate.ResetOptions = &monthly
+				tmpl.BudgetEstimate.Estimate = 100000
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&tmpl, nil)
+
+				c.EXPECT().AddProject(api.AddProjectParam{
+					Workspace: "w",
+					Name:      "New",
+					ClientId:  "c1",
+					Color:     "#fff",
+					Billable:  true,
+				}).Return(dto.Project{ID: "p2"}, nil)
+
+				c.EXPECT().UpdateProjectMemberships(
+					api.UpdateProjectMembershipsParam{
+						Workspace: "w",
+						ProjectID: "p2",
+						Memberships: []api.UpdateMembership{
+							{
+								UserOrGroupID: "u1",
+								HourlyRate:    &dto.Rate{Amount: 100},
+								CostRate:      &dto.Rate{Amount: 60},
+							},
+							{UserOrGroupID: "u2"},
+						},
+					}).Return(dto.Project{ID: "p2"}, nil)
+
+				c.EXPECT().UpdateProjectEstimate(
+					api.UpdateProjectEstimateParam{
+						Workspace:   "w",
+						ProjectID:   "p2",
+						Method:      api.EstimateMethodBudget,
+						Type:        api.EstimateTypeProject,
+						ResetOption: api.EstimateResetOptionMonthly,
+						Estimate:    100000,
+					}).Return(dto.Project{ID: "p2", Name: "New"}, nil)
+
+				e := dto.Duration{Duration: time.Hour}
+				c.EXPECT().GetTasks(api.GetTasksParam{
+					Workspace:       "w",
+					ProjectID:       "p1",
+					Active:          true,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Task{
+					{Name: "Planning", Estimate: &e, Billable: true},
+					{
+						Name:         "Review",
+						AssigneeIDs:  []string{"u2"},
+						UserGroupIDs: []string{"g1"},
+					},
+				}, nil)
+
+				b := true
+				d := time.Hour
+				c.EXPECT().AddTask(api.AddTaskParam{
+					Workspace: "w",
+					ProjectID: "p2",
+					Name:      "Planning",
+					Estimate:  &d,
+					Billable:  &b,
+				}).Return(dto.Task{}, nil)
+
+				nb := false
+				c.EXPECT().AddTask(api.AddTaskParam{
+					Workspace:    "w",
+					ProjectID:    "p2",
+					Name:         "Review",
+					AssigneeIDs:  &[]string{"u2"},
+					UserGroupIDs: &[]string{"g1"},
+					Billable:     &nb,
+				}).Return(dto.Task{}, nil)
+
+				return f
+			},
+			report: func(t *testing.T) func(
+				io.Writer, *util.OutputFlags, dto.Project) error {
+				called := false
+				t.Cleanup(func() { assert.True(t, called) })
+				return func(
+					w io.Writer, of *util.OutputFlags, p dto.Project) error {
+					called = true
+					assert.Equal(t, dto.Project{ID: "p2", Name: "New"}, p)
+					return nil
+				}
+			},
+		},
 	}
 
 	for _, tt := range tts {
@@ -0,0 +1,227 @@
+package del
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdDelete represents the delete command
+func NewCmdDelete(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Project) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	yes := false
+	cmd := &cobra.Command{
+		Use:     "delete <project>...",
+		Aliases: []string{"remove", "rm", "del"},
+		Args:    cmdutil.RequiredNamedArgs("project"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewProjectAutoComplete(f)),
+		Short: "Deletes projects from a Clockify workspace",
+		Long: heredoc.Doc(`
+			Deletes projects from a Clockify workspace
+
+			Projects must be archived before being deleted, so active projects will be archived first.
+			Before deleting each project it will be shown if there are time entries using it and a confirmation will be asked, use --yes to skip it.
+
+			This action can't be reverted.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s cli
+			? Project "Clockify Cli" (621948458cb9606d934ebb1c) has time entries, delete it? Yes
+			+--------------------------+--------------+--------+
+			|            ID            |     NAME     | CLIENT |
+			+--------------------------+--------------+--------+
+			| 621948458cb9606d934ebb1c | Clockify Cli |        |
+			+--------------------------+--------------+--------+
+
+			# deleting multiple projects without confirmation
+			$ %[1]s first 62f19c254a912b05acc6d6cf --yes -q
+			62f19c254a912b05acc6d6ce
+			62f19c254a912b05acc6d6cf
+		`, "clockify-cli project delete"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+			if f.Config().IsAllowNameForID() {
+				if ids, err = search.GetProjectsByName(
+					c, w, ids); err != nil {
+					return err
+				}
+			}
+
+			projects := make([]dto.Project, len(ids))
+			var g errgroup.Group
+			for i := range ids {
+				j := i
+				g.Go(func() error {
+					p, err := c.GetProject(api.GetProjectParam{
+						Workspace: w,
+						ProjectID: ids[j],
+					})
+					if err != nil {
+						return err
+					}
+
+					if p == nil {
+						return api.EntityNotFound{
+							EntityName: "project",
+							ID:         ids[j],
+						}
+					}
+
+					projects[j] = *p
+					return nil
+				})
+			}
+
+			if err := g.Wait(); err != nil {
+				return err
+			}
+
+			if !yes {
+				if projects, err = confirmDeletion(
+					f, c, w, projects); err != nil {
+					return err
+				}
+			}
+
+			deleted := make([]dto.Project, len(projects))
+			var dg errgroup.Group
+			for i := range projects {
+				j := i
+				dg.Go(func() error {
+					if !projects[j].Archived {
+						b := true
+						if _, err := c.UpdateProject(api.UpdateProjectParam{
+							Workspace: w,
+							ProjectID: projects[j].ID,
+							Archived:  &b,
+						}); err != nil {
+							return err
+						}
+					}
+
+					p, err := c.DeleteProject(api.DeleteProjectParam{
+						Workspace: w,
+						ProjectID: projects[j].ID,
+					})
+					deleted[j] = p
+					return err
+				})
+			}
+
+			if err := dg.Wait(); err != nil {
+				return err
+			}
+
+			if report == nil {
+				return util.Report(deleted, cmd.OutOrStdout(), of)
+			}
+
+			return report(cmd.OutOrStdout(), &of, deleted)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false,
+		"don't ask for confirmation before deleting")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
+
+// confirmDeletion will show if each project has time entries and ask the user
+// to confirm the deletion, returning only the confirmed ones
+func confirmDeletion(
+	f cmdutil.Factory, c api.Client, w string, ps []dto.Project,
+) ([]dto.Project, error) {
+	users, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
+		Workspace:       w,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return ps, err
+	}
+
+	confirmed := make([]dto.Project, 0, len(ps))
+	for i := range ps {
+		has, err := hasTimeEntries(c, w, users, ps[i].ID)
+		if err != nil {
+			return confirmed, err
+		}
+
+		msg := "has no time entries"
+		if has {
+			msg = "has time entries"
+		}
+
+		ok, err := f.UI().Confirm(fmt.Sprintf(
+			"Project \"%s\" (%s) %s, delete it?",
+			ps[i].Name, ps[i].ID, msg,
+		), false)
+		if err != nil {
+			return confirmed, err
+		}
+
+		if ok {
+			confirmed = append(confirmed, ps[i])
+		}
+	}
+
+	return confirmed, nil
+}
+
+// hasTimeEntries looks for a time entry using the project on each user of the
+// workspace, stopping at the first one found
+func hasTimeEntries(
+	c api.Client, w string, users []dto.User, projectID string,
+) (bool, error) {
+	for i := range users {
+		tes, err := c.GetUserTimeEntries(api.GetUserTimeEntriesParam{
+			Workspace: w,
+			UserID:    users[i].ID,
+			ProjectID: projectID,
+			PaginationParam: api.PaginationParam{
+				PageSize: 1,
+				Page:     1,
+			},
+		})
+		if err != nil {
+			return false, err
+		}
+
+		if len(tes) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
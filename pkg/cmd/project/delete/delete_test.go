@@ -0,0 +1,291 @@
+package del_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/consoletest"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/project/delete"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+type report func(io.Writer, *util.OutputFlags, []dto.Project) error
+
+func TestCmdDelete(t *testing.T) {
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "project is required",
+			err:  "requires arg project",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "only one format",
+			args: []string{"--format={}", "-q", "-j", "cli"},
+			err:  "flags can't be used together.*format.*json.*quiet",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "workspace error",
+			err:  "error",
+			args: []string{"cli"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("", errors.New("error"))
+				return f, nil
+			},
+		},
+		{
+			name: "lookup project error",
+			err:  "No project with id or name",
+			args: []string{"cli"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(true)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProjects(api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{}, nil)
+
+				return f, nil
+			},
+		},
+		{
+			name: "project not found",
+			err:  "project with id p1 was not found",
+			args: []string{"p1", "--yes"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(nil, nil)
+
+				return f, nil
+			},
+		},
+		{
+			name: "fail to delete",
+			err:  "delete error",
+			args: []string{"p1", "--yes"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&dto.Project{ID: "p1", Archived: true}, nil)
+
+				c.EXPECT().DeleteProject(api.DeleteProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(dto.Project{}, errors.New("delete error"))
+
+				return f, nil
+			},
+		},
+		{
+			name: "archive and delete without confirmation",
+			args: []string{"cli", "second", "--yes"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(true)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProjects(api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{
+					{ID: "p1", Name: "Clockify CLI"},
+					{ID: "p2", Name: "Second"},
+				}, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&dto.Project{ID: "p1"}, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p2",
+				}).Return(&dto.Project{ID: "p2", Archived: true}, nil)
+
+				b := true
+				c.EXPECT().UpdateProject(api.UpdateProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+					Archived:  &b,
+				}).Return(dto.Project{ID: "p1", Archived: true}, nil)
+
+				c.EXPECT().DeleteProject(api.DeleteProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(dto.Project{ID: "p1"}, nil)
+
+				c.EXPECT().DeleteProject(api.DeleteProjectParam{
+					Workspace: "w",
+					ProjectID: "p2",
+				}).Return(dto.Project{ID: "p2"}, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags, ps []dto.Project) error {
+					called = true
+					assert.Equal(t, []dto.Project{{ID: "p1"}, {ID: "p2"}}, ps)
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(io.Writer, *util.OutputFlags, []dto.Project) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := del.NewCmdDelete(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
+
+func TestCmdDeleteShouldAskForConfirmation(t *testing.T) {
+	consoletest.RunTestConsole(t,
+		func(out consoletest.FileWriter, in consoletest.FileReader) error {
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().UI().Return(ui.NewUI(in, out, out))
+
+			cf := mocks.NewMockConfig(t)
+			cf.EXPECT().IsAllowNameForID().Return(false)
+			f.EXPECT().Config().Return(cf)
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+
+			c.EXPECT().GetProject(api.GetProjectParam{
+				Workspace: "w",
+				ProjectID: "p1",
+			}).Return(&dto.Project{
+				ID: "p1", Name: "First", Archived: true}, nil)
+
+			c.EXPECT().GetProject(api.GetProjectParam{
+				Workspace: "w",
+				ProjectID: "p2",
+			}).Return(&dto.Project{
+				ID: "p2", Name: "Second", Archived: true}, nil)
+
+			c.EXPECT().WorkspaceUsers(api.WorkspaceUsersParam{
+				Workspace:       "w",
+				PaginationParam: api.AllPages(),
+			}).Return([]dto.User{{ID: "u1"}, {ID: "u2"}}, nil)
+
+			page := api.PaginationParam{PageSize: 1, Page: 1}
+			c.EXPECT().GetUserTimeEntries(api.GetUserTimeEntriesParam{
+				Workspace:       "w",
+				UserID:          "u1",
+				ProjectID:       "p1",
+				PaginationParam: page,
+			}).Return(make([]dto.TimeEntryImpl, 1), nil).Once()
+
+			for _, u := range []string{"u1", "u2"} {
+				c.EXPECT().GetUserTimeEntries(api.GetUserTimeEntriesParam{
+					Workspace:       "w",
+					UserID:          u,
+					ProjectID:       "p2",
+					PaginationParam: page,
+				}).Return([]dto.TimeEntryImpl{}, nil).Once()
+			}
+
+			c.EXPECT().DeleteProject(api.DeleteProjectParam{
+				Workspace: "w",
+				ProjectID: "p2",
+			}).Return(dto.Project{ID: "p2"}, nil)
+
+			called := false
+			t.Cleanup(func() { assert.True(t, called, "was not called") })
+			cmd := del.NewCmdDelete(f, func(
+				_ io.Writer, _ *util.OutputFlags, ps []dto.Project) error {
+				called = true
+				assert.Equal(t, []dto.Project{{ID: "p2"}}, ps)
+				return nil
+			})
+			cmd.SilenceUsage = true
+			cmd.SetArgs([]string{"p1", "p2"})
+
+			_, err := cmd.ExecuteC()
+			return err
+		},
+		func(c consoletest.ExpectConsole) {
+			c.ExpectString(`"First" (p1) has time entries, delete it?`)
+			c.SendLine("n")
+			c.ExpectString(`"Second" (p2) has no time entries, delete it?`)
+			c.SendLine("y")
+			c.ExpectEOF()
+		},
+	)
+}
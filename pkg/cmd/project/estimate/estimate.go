@@ -0,0 +1,370 @@
+package estimate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/estimate"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// OutputFlags sets how to print out the estimate
+type OutputFlags struct {
+	Format string
+	CSV    bool
+	JSON   bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"csv":    of.CSV,
+	})
+}
+
+// Report prints out the estimate
+func Report(e estimate.Estimate, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return estimate.EstimateJSONPrint(e, out)
+	case of.CSV:
+		return estimate.EstimateCSVPrint(e, out)
+	case of.Format != "":
+		return estimate.EstimatePrintWithTemplate(of.Format)(e, out)
+	default:
+		return estimate.EstimatePrint(e, out)
+	}
+}
+
+// NewCmdEstimate represents the project estimate command
+func NewCmdEstimate(
+	f cmdutil.Factory,
+	report func(io.Writer, *OutputFlags, estimate.Estimate) error,
+) *cobra.Command {
+	of := OutputFlags{}
+	p := api.UpdateProjectEstimateParam{}
+	var method, value string
+	var auto, monthly, includeNonBillable bool
+	cmd := &cobra.Command{
+		Use:  "estimate <project>",
+		Args: cmdutil.RequiredNamedArgs("project"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewProjectAutoComplete(f)),
+		Short: "Shows or changes the estimate of a project",
+		Long: heredoc.Doc(`
+			Shows or changes the estimate of a project
+
+			Without flags the current estimate and how much of it was already tracked will be shown.
+
+			To change the estimate set the --method, a project can be estimated by time or budget, and the estimate can be set manually with --estimate or be the sum of its tasks estimates with --auto.
+			Time estimates are informed as durations (like "120h" or "90h30m"), and budget estimates as amounts (like "1500.50").
+
+			The progress is the percentage of the time estimate that was already tracked, and is only shown for time estimates.
+			When the estimate is reset every month only the time tracked on the current month is counted, without non-billable time unless --include-non-billable was set.
+			Without the monthly reset, the tracked time is the one informed by Clockify for the whole project, which counts non-billable time even when the estimate does not.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s cli
+			+-----------------------------------------+--------+------+-------+----------+----------+----------+
+			|                 PROJECT                 | METHOD | TYPE | RESET | ESTIMATE | TRACKED  | PROGRESS |
+			+-----------------------------------------+--------+------+-------+----------+----------+----------+
+			| Clockify Cli (621948458cb9606d934ebb1c) | none   |      |       |          | 42:30:00 |          |
+			+-----------------------------------------+--------+------+-------+----------+----------+----------+
+
+			# estimate the project in 120 hours, counting non-billable time
+			$ %[1]s cli --method time --estimate 120h --include-non-billable \
+				--format '{{ printf "%%.2f" .Progress }}'
+			35.42
+
+			# budget of 1500.00 reset every month
+			$ %[1]s cli --method budget --estimate 1500 --monthly --json
+			{"projectId":"621948458cb9606d934ebb1c","projectName":"Clockify Cli","method":"budget","type":"MANUAL","resetOption":"MONTHLY","includeNonBillable":false,"budgetEstimate":150000,"tracked":"PT42H30M0S","progress":0}
+
+			# use the estimates of the tasks
+			$ %[1]s cli --method time --auto
+
+			# remove the estimate
+			$ %[1]s cli --method none
+		`, "clockify-cli project estimate"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if err := cmdutil.XorFlag(map[string]bool{
+				"estimate": cmd.Flags().Changed("estimate"),
+				"auto":     auto,
+			}); err != nil {
+				return err
+			}
+
+			update := cmd.Flags().Changed("method")
+			if !update {
+				for _, n := range []string{
+					"estimate", "auto", "monthly", "include-non-billable"} {
+					if cmd.Flags().Changed(n) {
+						return cmdutil.FlagErrorWrap(errors.New(
+							"--method is required to change the estimate"))
+					}
+				}
+			}
+
+			if update {
+				p.Method = api.EstimateMethod(strings.ToLower(method))
+				if err := validateMethod(
+					cmd, p.Method, includeNonBillable); err != nil {
+					return err
+				}
+			}
+
+			if update && p.Method != api.EstimateMethodNone {
+				p.Type = api.EstimateTypeProject
+				if auto {
+					p.Type = api.EstimateTypeTask
+				}
+
+				if monthly {
+					p.ResetOption = api.EstimateResetOptionMonthly
+				}
+
+				if p.Method == api.EstimateMethodTime {
+					p.IncludeNonBillable = &includeNonBillable
+				}
+
+				if !auto {
+					var err error
+					if p.Estimate, err = parseEstimate(
+						p.Method, value); err != nil {
+						return err
+					}
+				}
+			}
+
+			var err error
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			p.ProjectID = strings.TrimSpace(args[0])
+			if f.Config().IsAllowNameForID() {
+				if p.ProjectID, err = search.GetProjectByName(
+					c, p.Workspace, p.ProjectID); err != nil {
+					return err
+				}
+			}
+
+			var project dto.Project
+			if update {
+				if project, err = c.UpdateProjectEstimate(p); err != nil {
+					return err
+				}
+			} else {
+				pc := c
+				if !f.Config().GetBool(cmdutil.CONF_OFFLINE) {
+					// the tracked time changes all the time, so the cache
+					// is not used
+					pc = c.WithContext(cache.Bypass(f.Context()))
+				}
+
+				pr, err := pc.GetProject(api.GetProjectParam{
+					Workspace: p.Workspace,
+					ProjectID: p.ProjectID,
+				})
+				if err != nil {
+					return err
+				}
+
+				if pr == nil {
+					return api.EntityNotFound{
+						EntityName: "project",
+						ID:         p.ProjectID,
+					}
+				}
+				project = *pr
+			}
+
+			if resetsMonthly(project) {
+				d, err := trackedThisMonth(c, p.Workspace, project,
+					cmdutil.Concurrency(f.Config()))
+				if err != nil {
+					return err
+				}
+				project.Duration = &d
+			}
+
+			e := estimate.NewEstimate(project)
+			if report == nil {
+				return Report(e, cmd.OutOrStdout(), of)
+			}
+
+			return report(cmd.OutOrStdout(), &of, e)
+		},
+	}
+
+	cmd.Flags().StringVarP(&method, "method", "m", "",
+		"how the project will be estimated (none, time or budget)")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "method",
+		cmdcompl.ValidArgsSlide{
+			string(api.EstimateMethodNone),
+			string(api.EstimateMethodTime),
+			string(api.EstimateMethodBudget),
+		})
+	cmd.Flags().StringVarP(&value, "estimate", "e", "",
+		"manual estimate of the project, a duration for time or a amount "+
+			"for budget")
+	cmd.Flags().BoolVar(&auto, "auto", false,
+		"use the sum of the tasks estimates as the estimate of the project")
+	cmd.Flags().BoolVar(&monthly, "monthly", false,
+		"reset the estimate every month")
+	cmd.Flags().BoolVar(&includeNonBillable, "include-non-billable", false,
+		"count non-billable time into the time estimate")
+
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on the estimate")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
+
+	return cmd
+}
+
+func validateMethod(
+	cmd *cobra.Command, m api.EstimateMethod, includeNonBillable bool,
+) error {
+	switch m {
+	case api.EstimateMethodNone:
+		for _, n := range []string{
+			"estimate", "auto", "monthly", "include-non-billable"} {
+			if cmd.Flags().Changed(n) {
+				return cmdutil.FlagErrorWrap(fmt.Errorf(
+					"--%s can't be used with method none", n))
+			}
+		}
+	case api.EstimateMethodTime, api.EstimateMethodBudget:
+		if m == api.EstimateMethodBudget && includeNonBillable {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"--include-non-billable can only be used with method time"))
+		}
+	default:
+		return cmdutil.FlagErrorWrap(fmt.Errorf(
+			"method %s is not valid, use none, time or budget", m))
+	}
+
+	return nil
+}
+
+// parseEstimate reads the estimate as a duration for the time method and as a
+// amount (converted to cents) for the budget method
+func parseEstimate(m api.EstimateMethod, v string) (int64, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, cmdutil.FlagErrorWrap(errors.New(
+			"--estimate or --auto should be set"))
+	}
+
+	if m == api.EstimateMethodTime {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, cmdutil.FlagErrorWrap(fmt.Errorf(
+				"estimate %s is not a valid duration", v))
+		}
+		return int64(d), nil
+	}
+
+	a, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, cmdutil.FlagErrorWrap(fmt.Errorf(
+			"estimate %s is not a valid amount", v))
+	}
+
+	return int64(math.Round(a * 100)), nil
+}
+
+// resetsMonthly tells if the project has a time estimate reset every month
+func resetsMonthly(p dto.Project) bool {
+	r := p.TimeEstimate.ResetOptions
+	return p.TimeEstimate.Active &&
+		r != nil && *r == dto.EstimateResetOptionMonthly
+}
+
+// trackedThisMonth sums the time tracked on the project by all users since the
+// start of the current month, non-billable time is only counted when the
+// estimate includes it
+func trackedThisMonth(
+	c api.Client, workspace string, p dto.Project, concurrency int,
+) (dto.Duration, error) {
+	us, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
+		Workspace:       workspace,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return dto.Duration{}, err
+	}
+
+	now := timehlp.Now()
+	first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	tracked := make([]time.Duration, len(us))
+	var g errgroup.Group
+	g.SetLimit(concurrency)
+	for i := range us {
+		j := i
+		g.Go(func() error {
+			tes, err := c.GetUserTimeEntries(api.GetUserTimeEntriesParam{
+				Workspace:       workspace,
+				UserID:          us[j].ID,
+				ProjectID:       p.ID,
+				Start:           &first,
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, te := range tes {
+				if !te.Billable && !p.TimeEstimate.IncludeNonBillable {
+					continue
+				}
+
+				end := now
+				if te.TimeInterval.End != nil {
+					end = *te.TimeInterval.End
+				}
+				tracked[j] += end.Sub(te.TimeInterval.Start)
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return dto.Duration{}, err
+	}
+
+	d := dto.Duration{}
+	for i := range tracked {
+		d.Duration += tracked[i]
+	}
+
+	return d, nil
+}
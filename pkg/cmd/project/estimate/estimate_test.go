@@ -0,0 +1,460 @@
+package estimate_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/estimate"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	oe "github.com/lucassabreu/clockify-cli/pkg/output/estimate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type report func(io.Writer, *estimate.OutputFlags, oe.Estimate) error
+
+func TestCmdEstimate(t *testing.T) {
+	bTrue := true
+	bFalse := false
+	tracked := dto.Duration{Duration: 30 * time.Hour}
+
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "project is required",
+			err:  "requires arg project",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "only one format",
+			args: []string{"--format={}", "-j", "p1"},
+			err:  "flags can't be used together.*format.*json",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "estimate or auto",
+			args: []string{"-m=time", "-e=1h", "--auto", "p1"},
+			err:  "flags can't be used together.*auto.*estimate",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "method is required to change",
+			args: []string{"--monthly", "p1"},
+			err:  "--method is required to change the estimate",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "invalid method",
+			args: []string{"-m=tasks", "p1"},
+			err:  "method tasks is not valid",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "method none without other flags",
+			args: []string{"-m=none", "--auto", "p1"},
+			err:  "--auto can't be used with method none",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "non-billable only for time",
+			args: []string{"-m=budget", "-e=10", "--include-non-billable",
+				"p1"},
+			err: "--include-non-billable can only be used with method time",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "estimate must be set",
+			args: []string{"-m=time", "p1"},
+			err:  "--estimate or --auto should be set",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "invalid duration",
+			args: []string{"-m=time", "-e=ten hours", "p1"},
+			err:  "estimate ten hours is not a valid duration",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "invalid amount",
+			args: []string{"-m=budget", "-e=1.000,00", "p1"},
+			err:  "estimate 1.000,00 is not a valid amount",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "workspace error",
+			args: []string{"p1"},
+			err:  "error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("", errors.New("error"))
+				return f, nil
+			},
+		},
+		{
+			name: "project not found",
+			args: []string{"p1"},
+			err:  "project with id p1 was not found",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				cf.EXPECT().GetBool(cmdutil.CONF_OFFLINE).Return(true)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(nil, nil)
+
+				return f, nil
+			},
+		},
+		{
+			name: "show progress",
+			args: []string{"cli"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(true)
+				cf.EXPECT().GetBool(cmdutil.CONF_OFFLINE).Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+				f.EXPECT().Context().Return(context.Background())
+				c.EXPECT().WithContext(mock.Anything).Return(c)
+
+				c.EXPECT().GetProjects(api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{{ID: "p1", Name: "Clockify CLI"}}, nil)
+
+				p := dto.Project{
+					ID:       "p1",
+					Name:     "Clockify CLI",
+					Duration: &tracked,
+				}
+				p.TimeEstimate.Active = true
+				p.TimeEstimate.Type = dto.EstimateTypeManual
+				p.TimeEstimate.Estimate = dto.Duration{
+					Duration: 120 * time.Hour}
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&p, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, _ *estimate.OutputFlags, e oe.Estimate) error {
+					called = true
+					assert.Equal(t, oe.Estimate{
+						ProjectID:    "p1",
+						ProjectName:  "Clockify CLI",
+						Method:       api.EstimateMethodTime,
+						Type:         dto.EstimateTypeManual,
+						TimeEstimate: &p.TimeEstimate.Estimate,
+						Tracked:      tracked,
+						Progress:     25,
+					}, e)
+					return nil
+				}
+			},
+		},
+		{
+			name: "show progress of the month",
+			args: []string{"p1"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				cf.EXPECT().GetBool(cmdutil.CONF_OFFLINE).Return(false)
+				cf.EXPECT().Get(cmdutil.CONF_CONCURRENCY).Return(nil)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+				f.EXPECT().Context().Return(context.Background())
+				c.EXPECT().WithContext(mock.Anything).Return(c)
+
+				monthly := dto.EstimateResetOptionMonthly
+				p := dto.Project{ID: "p1", Duration: &tracked}
+				p.TimeEstimate.Active = true
+				p.TimeEstimate.ResetOptions = &monthly
+				p.TimeEstimate.Estimate = dto.Duration{
+					Duration: 10 * time.Hour}
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&p, nil)
+
+				c.EXPECT().WorkspaceUsers(api.WorkspaceUsersParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.User{{ID: "u1"}, {ID: "u2"}}, nil)
+
+				start := time.Now().Add(-10 * time.Hour)
+				te := func(d time.Duration, billable bool) dto.TimeEntryImpl {
+					e := start.Add(d)
+					return dto.TimeEntryImpl{
+						Billable:     billable,
+						TimeInterval: dto.NewTimeInterval(start, &e),
+					}
+				}
+
+				for u, tes := range map[string][]dto.TimeEntryImpl{
+					"u1": {te(time.Hour, true), te(3*time.Hour, false)},
+					"u2": {te(2*time.Hour, true)},
+				} {
+					u := u
+					c.EXPECT().GetUserTimeEntries(mock.MatchedBy(
+						func(p api.GetUserTimeEntriesParam) bool {
+							return p.UserID == u && p.ProjectID == "p1" &&
+								p.Start != nil && p.Start.Day() == 1 &&
+								p.PaginationParam == api.AllPages()
+						})).Return(tes, nil)
+				}
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, _ *estimate.OutputFlags, e oe.Estimate) error {
+					called = true
+					assert.Equal(t, 3*time.Hour, e.Tracked.Duration)
+					assert.Equal(t, float64(30), e.Progress)
+					return nil
+				}
+			},
+		},
+		{
+			name: "set time estimate",
+			args: []string{"p1", "-m", "time", "-e", "120h", "--monthly",
+				"--include-non-billable"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().UpdateProjectEstimate(
+					api.UpdateProjectEstimateParam{
+						Workspace:          "w",
+						ProjectID:          "p1",
+						Method:             api.EstimateMethodTime,
+						Type:               api.EstimateTypeProject,
+						ResetOption:        api.EstimateResetOptionMonthly,
+						Estimate:           int64(120 * time.Hour),
+						IncludeNonBillable: &bTrue,
+					}).
+					Return(dto.Project{ID: "p1"}, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, _ *estimate.OutputFlags, e oe.Estimate) error {
+					called = true
+					return nil
+				}
+			},
+		},
+		{
+			name: "set auto time estimate",
+			args: []string{"p1", "-m", "time", "--auto"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().UpdateProjectEstimate(
+					api.UpdateProjectEstimateParam{
+						Workspace:          "w",
+						ProjectID:          "p1",
+						Method:             api.EstimateMethodTime,
+						Type:               api.EstimateTypeTask,
+						IncludeNonBillable: &bFalse,
+					}).
+					Return(dto.Project{ID: "p1"}, nil)
+
+				return f, func(
+					io.Writer, *estimate.OutputFlags, oe.Estimate) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "set budget estimate",
+			args: []string{"p1", "-m", "budget", "-e", "1500.50"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().UpdateProjectEstimate(
+					api.UpdateProjectEstimateParam{
+						Workspace: "w",
+						ProjectID: "p1",
+						Method:    api.EstimateMethodBudget,
+						Type:      api.EstimateTypeProject,
+						Estimate:  150050,
+					}).
+					Return(dto.Project{ID: "p1"}, nil)
+
+				return f, func(
+					io.Writer, *estimate.OutputFlags, oe.Estimate) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "remove estimate",
+			args: []string{"p1", "-m", "none"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().UpdateProjectEstimate(
+					api.UpdateProjectEstimateParam{
+						Workspace: "w",
+						ProjectID: "p1",
+						Method:    api.EstimateMethodNone,
+					}).
+					Return(dto.Project{ID: "p1"}, nil)
+
+				return f, func(
+					io.Writer, *estimate.OutputFlags, oe.Estimate) error {
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(io.Writer, *estimate.OutputFlags, oe.Estimate) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := estimate.NewCmdEstimate(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
+
+func TestCmdEstimateFormat(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+	cf := mocks.NewMockConfig(t)
+	cf.EXPECT().IsAllowNameForID().Return(false)
+	cf.EXPECT().GetBool(cmdutil.CONF_OFFLINE).Return(false)
+	f.EXPECT().Config().Return(cf)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+	f.EXPECT().Context().Return(context.Background())
+	c.EXPECT().WithContext(mock.Anything).Return(c)
+
+	p := dto.Project{
+		ID:       "p1",
+		Duration: &dto.Duration{Duration: 45 * time.Hour},
+	}
+	p.TimeEstimate.Active = true
+	p.TimeEstimate.Estimate = dto.Duration{Duration: 60 * time.Hour}
+
+	c.EXPECT().GetProject(api.GetProjectParam{
+		Workspace: "w",
+		ProjectID: "p1",
+	}).Return(&p, nil)
+
+	b := bytes.NewBufferString("")
+	cmd := estimate.NewCmdEstimate(f, nil)
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"p1", "--format", `{{ printf "%.2f" .Progress }}`})
+
+	_, err := cmd.ExecuteC()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "75.00\n", b.String())
+	}
+}
@@ -94,6 +94,17 @@ func NewCmdList(
 				p.Archived = &archived
 			}
 
+			if report == nil {
+				if w := util.NewPageWriter(
+					cmd.OutOrStdout(), of); w != nil {
+					if err := c.GetProjectsByPage(p, w.Write); err != nil {
+						return err
+					}
+
+					return w.Close()
+				}
+			}
+
 			projects, err := c.GetProjects(p)
 			if err != nil {
 				return err
@@ -1,16 +1,20 @@
 package list_test
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"testing"
 
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/fakeserver"
 	"github.com/lucassabreu/clockify-cli/internal/mocks"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/list"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/project"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -335,3 +339,64 @@ func TestCmdListReport(t *testing.T) {
 		})
 	}
 }
+
+func TestCmdListByPage(t *testing.T) {
+	s := fakeserver.New(t)
+	w := s.AddWorkspace(dto.Workspace{Name: "Work"})
+	s.AddUser(w.ID, dto.User{Name: "John Due", Email: "john@due.com"})
+	for i := 1; i <= 120; i++ {
+		s.AddProject(dto.Project{
+			WorkspaceID: w.ID,
+			Name:        fmt.Sprintf("project %03d", i),
+		})
+	}
+
+	c := fakeserver.NewConfig(t, map[string]interface{}{
+		cmdutil.CONF_WORKSPACE: w.ID,
+	})
+
+	cl, err := s.Factory(c).Client()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ps, err := cl.GetProjects(api.GetProjectsParam{
+		Workspace:       w.ID,
+		PaginationParam: api.AllPages(),
+	})
+	if !assert.NoError(t, err) || !assert.Len(t, ps, 120) {
+		return
+	}
+
+	tts := []struct {
+		flag  string
+		print func([]dto.Project, io.Writer) error
+	}{
+		{flag: "--csv", print: project.ProjectsCSVPrint},
+		{flag: "--json", print: project.ProjectsJSONPrint},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.flag, func(t *testing.T) {
+			b := bytes.NewBufferString("")
+			if !assert.NoError(t, tt.print(ps, b)) {
+				return
+			}
+
+			out, err := s.Run(c, "project", "list", tt.flag)
+			if assert.NoError(t, err) {
+				assert.Equal(t, b.String(), out)
+			}
+
+			out, err = s.Run(c, "project", "list", tt.flag, "-n", "none")
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			b.Reset()
+			if assert.NoError(t, tt.print([]dto.Project{}, b)) {
+				assert.Equal(t, b.String(), out)
+			}
+		})
+	}
+}
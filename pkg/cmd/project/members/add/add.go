@@ -0,0 +1,118 @@
+package add
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/util"
+	putil "github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/member"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAdd represents the project members add command
+func NewCmdAdd(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []member.Member) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "add <project> <user>...",
+		Aliases: []string{"new"},
+		Args:    cmdutil.RequiredNamedArgs("project", "user"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewProjectAutoComplete(f),
+			cmdcomplutil.NewUserAutoComplete(f),
+		),
+		Short: "Give users access to a project",
+		Long: heredoc.Doc(`
+			Give users access to a project
+
+			Users can be informed by their ID or email, and by name if "allow-name-for-id" is enabled.
+			Users that already are members of the project are kept as they are.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s cli joana@example.com -q
+			5c6bf21db079873a55facc08
+			62a8b52d67f40258719037f2
+
+			$ %[1]s cli joana@example.com "John Due"
+			+--------------------------+----------+-------------------+--------+-------------+-----------+
+			|            ID            |   NAME   |       EMAIL       | STATUS | HOURLY RATE | COST RATE |
+			+--------------------------+----------+-------------------+--------+-------------+-----------+
+			| 5c6bf21db079873a55facc08 | John Due | joe@due.com       | ACTIVE | 120.00 USD  |           |
+			| 62a8b52d67f40258719037f2 | Joana    | joana@example.com | ACTIVE |             |           |
+			+--------------------------+----------+-------------------+--------+-------------+-----------+
+		`, "clockify-cli project members add"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			p, err := putil.GetProject(f, c, w, args[0])
+			if err != nil {
+				return err
+			}
+
+			users, err := putil.GetUserIDs(f, c, w, args[1:])
+			if err != nil {
+				return err
+			}
+
+			ms := util.ToUpdateMemberships(p.Memberships)
+			for _, u := range strhlp.Unique(users) {
+				found := false
+				for i := range ms {
+					if ms[i].UserOrGroupID == u {
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					ms = append(ms, api.UpdateMembership{UserOrGroupID: u})
+				}
+			}
+
+			pr, err := c.UpdateProjectMemberships(
+				api.UpdateProjectMembershipsParam{
+					Workspace:   w,
+					ProjectID:   p.ID,
+					Memberships: ms,
+				})
+			if err != nil {
+				return err
+			}
+
+			members, err := util.GetMembers(c, w, pr.Memberships)
+			if err != nil {
+				return err
+			}
+
+			if report == nil {
+				return util.Report(members, cmd.OutOrStdout(), of)
+			}
+
+			return report(cmd.OutOrStdout(), &of, members)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
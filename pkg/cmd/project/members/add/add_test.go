@@ -0,0 +1,228 @@
+package add_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/member"
+	"github.com/stretchr/testify/assert"
+)
+
+type report func(io.Writer, *util.OutputFlags, []member.Member) error
+
+func TestCmdAdd(t *testing.T) {
+	users := []dto.User{
+		{ID: "u1", Name: "John Due", Email: "john@due.com"},
+		{ID: "u2", Name: "Joana D'ark", Email: "joana@example.com"},
+		{ID: "u3", Name: "Someone Else", Email: "someone@example.com"},
+	}
+
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "project and user are required",
+			args: []string{"cli"},
+			err:  "requires args project and user",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "only one format",
+			args: []string{"--format={}", "-q", "-j", "cli", "u1"},
+			err:  "flags can't be used together.*format.*json.*quiet",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "project not found",
+			args: []string{"p1", "u1"},
+			err:  "project with id p1 was not found",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(nil, nil)
+
+				return f, nil
+			},
+		},
+		{
+			name: "user not found",
+			args: []string{"p1", "nobody@example.com"},
+			err:  "No user with id or name containing 'nobody@example.com'",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&dto.Project{ID: "p1"}, nil)
+
+				c.EXPECT().WorkspaceUsers(api.WorkspaceUsersParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(users, nil)
+
+				return f, nil
+			},
+		},
+		{
+			name: "http error",
+			args: []string{"p1", "u2"},
+			err:  "http error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&dto.Project{ID: "p1"}, nil)
+
+				c.EXPECT().UpdateProjectMemberships(
+					api.UpdateProjectMembershipsParam{
+						Workspace: "w",
+						ProjectID: "p1",
+						Memberships: []api.UpdateMembership{
+							{UserOrGroupID: "u2"},
+						},
+					}).Return(dto.Project{}, errors.New("http error"))
+
+				return f, nil
+			},
+		},
+		{
+			name: "add users by name and email",
+			args: []string{"cli", "joana@EXAMPLE.com", "john", "someone"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(true)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProjects(api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{{ID: "p1", Name: "Clockify CLI"}}, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&dto.Project{
+					ID: "p1",
+					Memberships: []dto.Membership{
+						{UserID: "u1", HourlyRate: &dto.Rate{Amount: 100}},
+						{UserID: "g1"},
+					},
+				}, nil)
+
+				c.EXPECT().WorkspaceUsers(api.WorkspaceUsersParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(users, nil)
+
+				ms := []dto.Membership{
+					{UserID: "u1", HourlyRate: &dto.Rate{Amount: 100}},
+					{UserID: "g1"},
+					{UserID: "u2"},
+					{UserID: "u3"},
+				}
+				c.EXPECT().UpdateProjectMemberships(
+					api.UpdateProjectMembershipsParam{
+						Workspace: "w",
+						ProjectID: "p1",
+						Memberships: []api.UpdateMembership{
+							{UserOrGroupID: "u1", HourlyRate: &dto.Rate{Amount: 100}},
+							{UserOrGroupID: "g1"},
+							{UserOrGroupID: "u2"},
+							{UserOrGroupID: "u3"},
+						},
+					}).Return(dto.Project{ID: "p1", Memberships: ms}, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags, m []member.Member) error {
+					called = true
+					assert.Equal(t, []member.Member{
+						{Membership: ms[0], User: users[0]},
+						{Membership: ms[1], User: dto.User{ID: "g1"}},
+						{Membership: ms[2], User: users[1]},
+						{Membership: ms[3], User: users[2]},
+					}, m)
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(io.Writer, *util.OutputFlags, []member.Member) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := add.NewCmdAdd(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package list
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/util"
+	putil "github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/member"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList represents the project members list command
+func NewCmdList(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []member.Member) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "list <project>",
+		Aliases: []string{"ls"},
+		Args:    cmdutil.RequiredNamedArgs("project"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewProjectAutoComplete(f)),
+		Short: "List the members of a project",
+		Example: heredoc.Docf(`
+			$ %[1]s cli
+			+--------------------------+----------+-------------------+--------+-------------+-----------+
+			|            ID            |   NAME   |       EMAIL       | STATUS | HOURLY RATE | COST RATE |
+			+--------------------------+----------+-------------------+--------+-------------+-----------+
+			| 5c6bf21db079873a55facc08 | John Due | joe@due.com       | ACTIVE | 120.00 USD  |           |
+			| 62a8b52d67f40258719037f2 | Joana    | joana@example.com | ACTIVE |             | 60.00 USD |
+			+--------------------------+----------+-------------------+--------+-------------+-----------+
+
+			$ %[1]s cli --csv
+			user.id,user.name,user.email,status,hourlyRate.amount,hourlyRate.currency,costRate.amount,costRate.currency
+			5c6bf21db079873a55facc08,John Due,joe@due.com,ACTIVE,12000,USD,,
+			62a8b52d67f40258719037f2,Joana,joana@example.com,ACTIVE,,,6000,USD
+		`, "clockify-cli project members list"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			p, err := putil.GetProject(f, c, w, args[0])
+			if err != nil {
+				return err
+			}
+
+			ms, err := util.GetMembers(c, w, p.Memberships)
+			if err != nil {
+				return err
+			}
+
+			if report == nil {
+				return util.Report(ms, cmd.OutOrStdout(), of)
+			}
+
+			return report(cmd.OutOrStdout(), &of, ms)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,24 @@
+package members
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/remove"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdMembers represents the project members command
+func NewCmdMembers(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "members",
+		Aliases: []string{"member", "memberships"},
+		Short:   "Work with the members of a Clockify project",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(add.NewCmdAdd(f, nil))
+	cmd.AddCommand(remove.NewCmdRemove(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,107 @@
+package remove
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/util"
+	putil "github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/member"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRemove represents the project members remove command
+func NewCmdRemove(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []member.Member) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "remove <project> <user>...",
+		Aliases: []string{"rm", "del", "delete"},
+		Args:    cmdutil.RequiredNamedArgs("project", "user"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewProjectAutoComplete(f),
+			cmdcomplutil.NewUserAutoComplete(f),
+		),
+		Short: "Remove users access to a project",
+		Long: heredoc.Doc(`
+			Remove users access to a project
+
+			Users can be informed by their ID or email, and by name if "allow-name-for-id" is enabled.
+			Users that are not members of the project are ignored.
+			The remaining members of the project will be printed.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s cli joana@example.com
+			+--------------------------+----------+-------------+--------+-------------+-----------+
+			|            ID            |   NAME   |    EMAIL    | STATUS | HOURLY RATE | COST RATE |
+			+--------------------------+----------+-------------+--------+-------------+-----------+
+			| 5c6bf21db079873a55facc08 | John Due | joe@due.com | ACTIVE | 120.00 USD  |           |
+			+--------------------------+----------+-------------+--------+-------------+-----------+
+		`, "clockify-cli project members remove"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			p, err := putil.GetProject(f, c, w, args[0])
+			if err != nil {
+				return err
+			}
+
+			users, err := putil.GetUserIDs(f, c, w, args[1:])
+			if err != nil {
+				return err
+			}
+
+			current := util.ToUpdateMemberships(p.Memberships)
+			ms := make([]api.UpdateMembership, 0, len(current))
+			for i := range current {
+				if !strhlp.InSlice(current[i].UserOrGroupID, users) {
+					ms = append(ms, current[i])
+				}
+			}
+
+			pr, err := c.UpdateProjectMemberships(
+				api.UpdateProjectMembershipsParam{
+					Workspace:   w,
+					ProjectID:   p.ID,
+					Memberships: ms,
+				})
+			if err != nil {
+				return err
+			}
+
+			members, err := util.GetMembers(c, w, pr.Memberships)
+			if err != nil {
+				return err
+			}
+
+			if report == nil {
+				return util.Report(members, cmd.OutOrStdout(), of)
+			}
+
+			return report(cmd.OutOrStdout(), &of, members)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,79 @@
+package remove_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/remove"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members/util"
+	"github.com/lucassabreu/clockify-cli/pkg/output/member"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdRemove(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+	cf := mocks.NewMockConfig(t)
+	cf.EXPECT().IsAllowNameForID().Return(false)
+	f.EXPECT().Config().Return(cf)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().GetProject(api.GetProjectParam{
+		Workspace: "w",
+		ProjectID: "p1",
+	}).Return(&dto.Project{
+		ID: "p1",
+		Memberships: []dto.Membership{
+			{UserID: "u1", HourlyRate: &dto.Rate{Amount: 100}},
+			{UserID: "u2"},
+			{UserID: "u3"},
+		},
+	}, nil)
+
+	users := []dto.User{
+		{ID: "u1", Name: "John Due", Email: "john@due.com"},
+		{ID: "u2", Name: "Joana D'ark", Email: "joana@example.com"},
+		{ID: "u3", Name: "Someone Else", Email: "someone@example.com"},
+	}
+	c.EXPECT().WorkspaceUsers(api.WorkspaceUsersParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return(users, nil)
+
+	ms := []dto.Membership{
+		{UserID: "u1", HourlyRate: &dto.Rate{Amount: 100}},
+		{UserID: "u3"},
+	}
+	c.EXPECT().UpdateProjectMemberships(api.UpdateProjectMembershipsParam{
+		Workspace: "w",
+		ProjectID: "p1",
+		Memberships: []api.UpdateMembership{
+			{UserOrGroupID: "u1", HourlyRate: &dto.Rate{Amount: 100}},
+			{UserOrGroupID: "u3"},
+		},
+	}).Return(dto.Project{ID: "p1", Memberships: ms}, nil)
+
+	called := false
+	t.Cleanup(func() { assert.True(t, called, "was not called") })
+	cmd := remove.NewCmdRemove(f, func(
+		_ io.Writer, of *util.OutputFlags, m []member.Member) error {
+		called = true
+		assert.True(t, of.Quiet)
+		assert.Equal(t, []member.Member{
+			{Membership: ms[0], User: users[0]},
+			{Membership: ms[1], User: users[2]},
+		}, m)
+		return nil
+	})
+	cmd.SilenceUsage = true
+	cmd.SetArgs([]string{"p1", "joana@example.com", "-q"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+package util
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/member"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out a list of members
+type OutputFlags struct {
+	Format string
+	CSV    bool
+	JSON   bool
+	Quiet  bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"csv":    of.CSV,
+		"quiet":  of.Quiet,
+	})
+}
+
+// AddReportFlags adds the default output flags for members
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each Member")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false,
+		"only display user ids")
+}
+
+// Report prints out the members
+func Report(ms []member.Member, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return member.MembersJSONPrint(ms, out)
+	case of.CSV:
+		return member.MembersCSVPrint(ms, out)
+	case of.Format != "":
+		return member.MembersPrintWithTemplate(of.Format)(ms, out)
+	case of.Quiet:
+		return member.MembersPrintQuietly(ms, out)
+	default:
+		return member.MembersPrint(ms, out)
+	}
+}
+
+// ToUpdateMemberships converts the current memberships of a project into the
+// format used to change them, keeping their hourly and cost rates. Members
+// without rates of their own keep inheriting them
+func ToUpdateMemberships(ms []dto.Membership) []api.UpdateMembership {
+	us := make([]api.UpdateMembership, len(ms))
+	for i := range ms {
+		us[i].UserOrGroupID = ms[i].UserID
+		us[i].HourlyRate = copyRate(ms[i].HourlyRate)
+		us[i].CostRate = copyRate(ms[i].CostRate)
+	}
+
+	return us
+}
+
+func copyRate(r *dto.Rate) *dto.Rate {
+	if r == nil {
+		return nil
+	}
+
+	c := *r
+	return &c
+}
+
+// GetMembers fills the memberships with the details of its users
+func GetMembers(
+	c api.Client, w string, ms []dto.Membership,
+) ([]member.Member, error) {
+	us, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
+		Workspace:       w,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return []member.Member{}, err
+	}
+
+	users := make(map[string]dto.User, len(us))
+	for i := range us {
+		users[us[i].ID] = us[i]
+	}
+
+	members := make([]member.Member, len(ms))
+	for i := range ms {
+		members[i].Membership = ms[i]
+		u, ok := users[ms[i].UserID]
+		if !ok {
+			u = dto.User{ID: ms[i].UserID}
+		}
+		members[i].User = u
+	}
+
+	return members, nil
+}
@@ -2,9 +2,14 @@ package project
 
 import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/add"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/project/delete"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/edit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/estimate"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/get"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/members"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/rate"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/template"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +26,11 @@ func NewCmdProject(f cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(get.NewCmdGet(f, nil))
 	cmd.AddCommand(add.NewCmdAdd(f, nil))
 	cmd.AddCommand(edit.NewCmdEdit(f, nil))
+	cmd.AddCommand(del.NewCmdDelete(f, nil))
+	cmd.AddCommand(members.NewCmdMembers(f))
+	cmd.AddCommand(estimate.NewCmdEstimate(f, nil))
+	cmd.AddCommand(rate.NewCmdRate(f))
+	cmd.AddCommand(template.NewCmdTemplate(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,140 @@
+package list
+
+import (
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/rate/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/rate"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdList represents the project rate list command
+func NewCmdList(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []rate.ProjectUserRate) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "list [<project>...]",
+		Aliases: []string{"ls"},
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewProjectAutoComplete(f)),
+		Short: "List the rates of the members of projects",
+		Long: heredoc.Doc(`
+			List the rates of the members of projects
+
+			The workspace, project and member rates are shown side by side, Clockify will use the most specific one set to calculate the amounts of a time entry.
+			If no project is informed, all active projects of the workspace will be listed.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s
+			+--------------+----------+----------------+--------------+--------------+-------------+-------------+
+			|   PROJECT    |   USER   | WORKSPACE RATE | PROJECT RATE | PROJECT COST | MEMBER RATE | MEMBER COST |
+			+--------------+----------+----------------+--------------+--------------+-------------+-------------+
+			| Clockify Cli | John Due | 100.00 USD     | 110.00 USD   |              | 120.00 USD  | 60.00 USD   |
+			| Clockify Cli | Joana    | 100.00 USD     | 110.00 USD   |              |             |             |
+			| Second       | John Due | 100.00 USD     |              |              |             |             |
+			+--------------+----------+----------------+--------------+--------------+-------------+-------------+
+
+			$ %[1]s cli --csv
+			project.id,project.name,user.id,user.name,user.email,workspaceHourlyRate.amount,workspaceHourlyRate.currency,projectHourlyRate.amount,projectHourlyRate.currency,projectCostRate.amount,projectCostRate.currency,hourlyRate.amount,hourlyRate.currency,costRate.amount,costRate.currency
+			621948458cb9606d934ebb1c,Clockify Cli,5c6bf21db079873a55facc08,John Due,joe@due.com,10000,USD,11000,USD,,,12000,USD,6000,USD
+			621948458cb9606d934ebb1c,Clockify Cli,62a8b52d67f40258719037f2,Joana,joana@example.com,10000,USD,11000,USD,,,,,,
+		`, "clockify-cli project rate list"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			ps, err := getProjects(f, c, w, args)
+			if err != nil {
+				return err
+			}
+
+			rs, err := util.GetRates(f, c, ps)
+			if err != nil {
+				return err
+			}
+
+			if report == nil {
+				return util.Report(rs, cmd.OutOrStdout(), of)
+			}
+
+			return report(cmd.OutOrStdout(), &of, rs)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
+
+// getProjects returns the projects informed, or all active projects if none
+// was
+func getProjects(
+	f cmdutil.Factory, c api.Client, w string, args []string,
+) ([]dto.Project, error) {
+	if len(args) == 0 {
+		archived := false
+		return c.GetProjects(api.GetProjectsParam{
+			Workspace:       w,
+			Archived:        &archived,
+			PaginationParam: api.AllPages(),
+		})
+	}
+
+	var err error
+	ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+	if f.Config().IsAllowNameForID() {
+		if ids, err = search.GetProjectsByName(c, w, ids); err != nil {
+			return []dto.Project{}, err
+		}
+	}
+
+	ps := make([]dto.Project, len(ids))
+	var g errgroup.Group
+	for i := range ids {
+		j := i
+		g.Go(func() error {
+			p, err := c.GetProject(api.GetProjectParam{
+				Workspace: w,
+				ProjectID: ids[j],
+			})
+			if err != nil {
+				return err
+			}
+
+			if p == nil {
+				return api.EntityNotFound{
+					EntityName: "project",
+					ID:         ids[j],
+				}
+			}
+
+			ps[j] = *p
+			return nil
+		})
+	}
+
+	return ps, g.Wait()
+}
@@ -0,0 +1,82 @@
+package list_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/rate/list"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdListAllProjects(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetWorkspace().Return(dto.Workspace{
+		ID:         "w",
+		HourlyRate: dto.Rate{Amount: 10000, Currency: "USD"},
+		Memberships: []dto.Membership{
+			{UserID: "u2", HourlyRate: &dto.Rate{Amount: 9000}},
+		},
+	}, nil)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	archived := false
+	c.EXPECT().GetProjects(api.GetProjectsParam{
+		Workspace:       "w",
+		Archived:        &archived,
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Project{
+		{
+			ID:         "p1",
+			Name:       "First",
+			HourlyRate: dto.Rate{Amount: 11000, Currency: "USD"},
+			Memberships: []dto.Membership{
+				{UserID: "u1", HourlyRate: &dto.Rate{Amount: 12000},
+					CostRate: &dto.Rate{Amount: 6000}},
+				{UserID: "u2"},
+				{UserID: "g1", Type: "USERGROUP"},
+			},
+		},
+		{
+			ID:          "p2",
+			Name:        "Second",
+			CostRate:    &dto.Rate{Amount: 5000},
+			Memberships: []dto.Membership{{UserID: "u2"}},
+		},
+	}, nil)
+
+	c.EXPECT().WorkspaceUsers(api.WorkspaceUsersParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.User{
+		{ID: "u1", Name: "John", Email: "john@example.com"},
+		{ID: "u2", Name: "Joana", Email: "joana@example.com"},
+	}, nil)
+
+	b := bytes.NewBufferString("")
+	cmd := list.NewCmdList(f, nil)
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"--format",
+		"{{ .ProjectName }};{{ .UserName }};" +
+			"{{ with .WorkspaceHourlyRate }}{{ .Amount }}{{ end }};" +
+			"{{ with .ProjectHourlyRate }}{{ .Amount }}{{ end }};" +
+			"{{ with .ProjectCostRate }}{{ .Amount }}{{ end }};" +
+			"{{ with .HourlyRate }}{{ .Amount }}{{ end }};" +
+			"{{ with .CostRate }}{{ .Amount }}{{ end }}",
+	})
+
+	_, err := cmd.ExecuteC()
+	if assert.NoError(t, err) {
+		assert.Equal(t, heredoc.Doc(`
+			First;John;10000;11000;;12000;6000
+			First;Joana;9000;11000;;;
+			Second;Joana;9000;;5000;;
+		`), b.String())
+	}
+}
@@ -0,0 +1,22 @@
+package rate
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/rate/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/rate/set"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRate represents the project rate command
+func NewCmdRate(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate",
+		Aliases: []string{"rates"},
+		Short:   "Work with the billable and cost rates of projects members",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(set.NewCmdSet(f, nil))
+
+	return cmd
+}
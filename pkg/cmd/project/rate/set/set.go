@@ -0,0 +1,186 @@
+package set
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/rate/util"
+	putil "github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/rate"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSet represents the project rate set command
+func NewCmdSet(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []rate.ProjectUserRate) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var users []string
+	var billable, cost, since string
+	cmd := &cobra.Command{
+		Use:  "set <project>",
+		Args: cmdutil.RequiredNamedArgs("project"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewProjectAutoComplete(f)),
+		Short: "Set the billable and cost rates of members of a project",
+		Long: heredoc.Doc(`
+			Set the billable and cost rates of members of a project
+
+			Users can be informed by their ID or email, and by name if "allow-name-for-id" is enabled, they must already be members of the project.
+			Rates are informed as amounts in the workspace currency (like "120.00").
+			When --since is set, the time entries started after it will be updated to use the new rates.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s cli --user joe@due.com --billable 120 --cost 60.00
+			+--------------+----------+----------------+--------------+--------------+-------------+-------------+
+			|   PROJECT    |   USER   | WORKSPACE RATE | PROJECT RATE | PROJECT COST | MEMBER RATE | MEMBER COST |
+			+--------------+----------+----------------+--------------+--------------+-------------+-------------+
+			| Clockify Cli | John Due | 100.00 USD     | 110.00 USD   |              | 120.00 USD  | 60.00 USD   |
+			+--------------+----------+----------------+--------------+--------------+-------------+-------------+
+
+			# change the rate of the time entries since the start of the year
+			$ %[1]s cli --user joe@due.com --user joana@example.com --billable 150 \
+				--since 2022-01-01 --format '{{ .UserEmail }}'
+			joe@due.com
+			joana@example.com
+		`, "clockify-cli project rate set"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if billable == "" && cost == "" {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"--billable or --cost should be set"))
+			}
+
+			var err error
+			var b, co uint
+			if billable != "" {
+				if b, err = parseAmount("billable", billable); err != nil {
+					return err
+				}
+			}
+
+			if cost != "" {
+				if co, err = parseAmount("cost", cost); err != nil {
+					return err
+				}
+			}
+
+			var s *time.Time
+			if since != "" {
+				t, err := timehlp.ConvertToTime(since)
+				if err != nil {
+					return cmdutil.FlagErrorWrap(fmt.Errorf(
+						"since date is not valid: %w", err))
+				}
+				s = &t
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			p, err := putil.GetProject(f, c, w, args[0])
+			if err != nil {
+				return err
+			}
+
+			if users, err = putil.GetUserIDs(f, c, w, users); err != nil {
+				return err
+			}
+			users = strhlp.Unique(users)
+
+			for _, u := range users {
+				param := api.UpdateProjectUserRateParam{
+					Workspace: w,
+					ProjectID: p.ID,
+					UserID:    u,
+					Since:     s,
+				}
+
+				if billable != "" {
+					param.Amount = b
+					if p, err = c.UpdateProjectUserBillableRate(
+						param); err != nil {
+						return err
+					}
+				}
+
+				if cost != "" {
+					param.Amount = co
+					if p, err = c.UpdateProjectUserCostRate(
+						param); err != nil {
+						return err
+					}
+				}
+			}
+
+			rs, err := util.GetRates(f, c, []dto.Project{p})
+			if err != nil {
+				return err
+			}
+
+			updated := make([]rate.ProjectUserRate, 0, len(users))
+			for i := range rs {
+				if strhlp.InSlice(rs[i].UserID, users) {
+					updated = append(updated, rs[i])
+				}
+			}
+
+			if report == nil {
+				return util.Report(updated, cmd.OutOrStdout(), of)
+			}
+
+			return report(cmd.OutOrStdout(), &of, updated)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&users, "user", []string{},
+		"user to set the rates (can be used multiple times)")
+	_ = cmd.MarkFlagRequired("user")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "user",
+		cmdcomplutil.NewUserAutoComplete(f))
+	cmd.Flags().StringVarP(&billable, "billable", "b", "",
+		"billable (hourly) rate of the user on the project")
+	cmd.Flags().StringVarP(&cost, "cost", "c", "",
+		"cost rate of the user on the project")
+	cmd.Flags().StringVar(&since, "since", "",
+		"update the time entries started after this date to the new rates")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
+
+// parseAmount reads a amount as cents
+func parseAmount(name, v string) (uint, error) {
+	a, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+	if err != nil || a < 0 {
+		return 0, cmdutil.FlagErrorWrap(fmt.Errorf(
+			"%s rate %s is not a valid amount", name, v))
+	}
+
+	return uint(math.Round(a * 100)), nil
+}
@@ -0,0 +1,267 @@
+package set_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/rate/set"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/rate/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/rate"
+	"github.com/stretchr/testify/assert"
+)
+
+type report func(io.Writer, *util.OutputFlags, []rate.ProjectUserRate) error
+
+func TestCmdSet(t *testing.T) {
+	since, _ := time.ParseInLocation(
+		"2006-01-02 15:04:05", "2022-01-01 00:00:00", time.Local)
+
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "project is required",
+			err:  "requires arg project",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "user is required",
+			args: []string{"p1", "--billable=10"},
+			err:  `required flag\(s\) "user" not set`,
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "a rate is required",
+			args: []string{"p1", "--user=u1"},
+			err:  "--billable or --cost should be set",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "invalid billable",
+			args: []string{"p1", "--user=u1", "-b=ten"},
+			err:  "billable rate ten is not a valid amount",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "negative cost",
+			args: []string{"p1", "--user=u1", "-c=-10"},
+			err:  "cost rate -10 is not a valid amount",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "invalid since",
+			args: []string{"p1", "--user=u1", "-c=10", "--since=yesterday"},
+			err:  "since date is not valid",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "workspace error",
+			args: []string{"p1", "--user=u1", "-c=10"},
+			err:  "error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("", errors.New("error"))
+				return f, nil
+			},
+		},
+		{
+			name: "fail to set rate",
+			args: []string{"p1", "--user=u1", "-b=10"},
+			err:  "user is not a member",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&dto.Project{ID: "p1"}, nil)
+
+				c.EXPECT().UpdateProjectUserBillableRate(
+					api.UpdateProjectUserRateParam{
+						Workspace: "w",
+						ProjectID: "p1",
+						UserID:    "u1",
+						Amount:    1000,
+					}).
+					Return(dto.Project{}, errors.New("user is not a member"))
+
+				return f, nil
+			},
+		},
+		{
+			name: "set billable and cost rates",
+			args: []string{"cli", "--user=joe@due.com", "--user=u2", "-b=120",
+				"-c", "60.50", "--since", "2022-01-01 00:00:00"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+				f.EXPECT().GetWorkspace().Return(dto.Workspace{
+					ID:         "w",
+					HourlyRate: dto.Rate{Amount: 10000, Currency: "USD"},
+				}, nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(true)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProjects(api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{{ID: "p1", Name: "Clockify CLI"}}, nil)
+
+				c.EXPECT().GetProject(api.GetProjectParam{
+					Workspace: "w",
+					ProjectID: "p1",
+				}).Return(&dto.Project{ID: "p1"}, nil)
+
+				users := []dto.User{
+					{ID: "u1", Name: "John Due", Email: "joe@due.com"},
+					{ID: "u2", Name: "Joana", Email: "joana@example.com"},
+					{ID: "u3", Name: "Other"},
+				}
+				c.EXPECT().WorkspaceUsers(api.WorkspaceUsersParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(users, nil)
+
+				for _, u := range []string{"u1", "u2"} {
+					c.EXPECT().UpdateProjectUserBillableRate(
+						api.UpdateProjectUserRateParam{
+							Workspace: "w",
+							ProjectID: "p1",
+							UserID:    u,
+							Amount:    12000,
+							Since:     &since,
+						}).
+						Return(dto.Project{ID: "p1"}, nil)
+				}
+
+				billable := &dto.Rate{Amount: 12000, Currency: "USD"}
+				cost := &dto.Rate{Amount: 6050, Currency: "USD"}
+				c.EXPECT().UpdateProjectUserCostRate(
+					api.UpdateProjectUserRateParam{
+						Workspace: "w",
+						ProjectID: "p1",
+						UserID:    "u1",
+						Amount:    6050,
+						Since:     &since,
+					}).
+					Return(dto.Project{ID: "p1"}, nil)
+
+				c.EXPECT().UpdateProjectUserCostRate(
+					api.UpdateProjectUserRateParam{
+						Workspace: "w",
+						ProjectID: "p1",
+						UserID:    "u2",
+						Amount:    6050,
+						Since:     &since,
+					}).
+					Return(dto.Project{
+						ID:   "p1",
+						Name: "Clockify CLI",
+						Memberships: []dto.Membership{
+							{UserID: "u1", HourlyRate: billable,
+								CostRate: cost},
+							{UserID: "u2", HourlyRate: billable,
+								CostRate: cost},
+							{UserID: "u3"},
+						},
+					}, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags,
+					rs []rate.ProjectUserRate,
+				) error {
+					called = true
+					wr := &dto.Rate{Amount: 10000, Currency: "USD"}
+					assert.Equal(t, []rate.ProjectUserRate{
+						{
+							ProjectID:           "p1",
+							ProjectName:         "Clockify CLI",
+							UserID:              "u1",
+							UserName:            "John Due",
+							UserEmail:           "joe@due.com",
+							WorkspaceHourlyRate: wr,
+							HourlyRate:          billable,
+							CostRate:            cost,
+						},
+						{
+							ProjectID:           "p1",
+							ProjectName:         "Clockify CLI",
+							UserID:              "u2",
+							UserName:            "Joana",
+							UserEmail:           "joana@example.com",
+							WorkspaceHourlyRate: wr,
+							HourlyRate:          billable,
+							CostRate:            cost,
+						},
+					}, rs)
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(
+					io.Writer, *util.OutputFlags, []rate.ProjectUserRate,
+				) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := set.NewCmdSet(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
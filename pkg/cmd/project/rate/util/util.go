@@ -0,0 +1,78 @@
+package util
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/rate"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out a list of rates
+type OutputFlags struct {
+	Format string
+	CSV    bool
+	JSON   bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"csv":    of.CSV,
+	})
+}
+
+// AddReportFlags adds the default output flags for rates
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each rate")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
+}
+
+// Report prints out the rates
+func Report(rs []rate.ProjectUserRate, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return rate.ProjectUserRatesJSONPrint(rs, out)
+	case of.CSV:
+		return rate.ProjectUserRatesCSVPrint(rs, out)
+	case of.Format != "":
+		return rate.ProjectUserRatesPrintWithTemplate(of.Format)(rs, out)
+	default:
+		return rate.ProjectUserRatesPrint(rs, out)
+	}
+}
+
+// GetRates reads the rates of every member of the projects
+func GetRates(
+	f cmdutil.Factory, c api.Client, ps []dto.Project,
+) ([]rate.ProjectUserRate, error) {
+	w, err := f.GetWorkspace()
+	if err != nil {
+		return []rate.ProjectUserRate{}, err
+	}
+
+	us, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
+		Workspace:       w.ID,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return []rate.ProjectUserRate{}, err
+	}
+
+	users := make(map[string]dto.User, len(us))
+	for i := range us {
+		users[us[i].ID] = us[i]
+	}
+
+	rs := make([]rate.ProjectUserRate, 0, len(ps))
+	for i := range ps {
+		rs = append(rs, rate.NewProjectUserRates(w, ps[i], users)...)
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,121 @@
+package template
+
+import (
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdTemplate represents the project template command
+func NewCmdTemplate(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Project) error,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Marks or unmarks projects as templates",
+		Long: heredoc.Doc(`
+			Marks or unmarks projects as templates
+
+			Templates can be used to create new projects with the same settings and tasks, see "project add --from-template".
+		`),
+	}
+
+	of := util.OutputFlags{}
+	addCmd := func(c *cobra.Command) *cobra.Command {
+		c.Args = cmdutil.RequiredNamedArgs("project")
+		c.ValidArgsFunction = cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewProjectAutoComplete(f))
+		util.AddReportFlags(c, &of)
+		return c
+	}
+
+	cmd.AddCommand(addCmd(&cobra.Command{
+		Use:   "set <project>...",
+		Short: "Marks projects as templates",
+		Example: heredoc.Docf(`
+			$ %[1]s cli -q
+			621948458cb9606d934ebb1c
+		`, "clockify-cli project template set"),
+		RunE: changeTemplate(f, &of, report, true),
+	}))
+
+	cmd.AddCommand(addCmd(&cobra.Command{
+		Use:   "unset <project>...",
+		Short: "Unmarks projects as templates",
+		Example: heredoc.Docf(`
+			$ %[1]s cli other -q
+			621948458cb9606d934ebb1c
+			62a8b52d67f40258719037f2
+		`, "clockify-cli project template unset"),
+		RunE: changeTemplate(f, &of, report, false),
+	}))
+
+	return cmd
+}
+
+func changeTemplate(
+	f cmdutil.Factory,
+	of *util.OutputFlags,
+	report func(io.Writer, *util.OutputFlags, []dto.Project) error,
+	template bool,
+) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := of.Check(); err != nil {
+			return err
+		}
+
+		w, err := f.GetWorkspaceID()
+		if err != nil {
+			return err
+		}
+
+		c, err := f.Client()
+		if err != nil {
+			return err
+		}
+
+		ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+		if f.Config().IsAllowNameForID() {
+			if ids, err = search.GetProjectsByName(c, w, ids); err != nil {
+				return err
+			}
+		}
+
+		ps := make([]dto.Project, len(ids))
+		var g errgroup.Group
+		for i := range ids {
+			j := i
+			g.Go(func() error {
+				p, err := c.UpdateProjectTemplate(api.UpdateProjectTemplateParam{
+					Workspace: w,
+					ProjectID: ids[j],
+					Template:  template,
+				})
+				ps[j] = p
+				return err
+			})
+		}
+
+		if err := g.Wait(); err != nil {
+			return err
+		}
+
+		if report == nil {
+			return util.Report(ps, cmd.OutOrStdout(), *of)
+		}
+
+		return report(cmd.OutOrStdout(), of, ps)
+	}
+}
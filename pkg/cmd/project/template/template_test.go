@@ -0,0 +1,155 @@
+package template_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/template"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type report func(io.Writer, *util.OutputFlags, []dto.Project) error
+
+func TestCmdTemplate(t *testing.T) {
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "project is required",
+			args: []string{"set"},
+			err:  "requires arg project",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "only one format",
+			args: []string{"unset", "--format={}", "-q", "p1"},
+			err:  "flags can't be used together.*format.*quiet",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "fail to update",
+			args: []string{"set", "p1"},
+			err:  "http error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(false)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().UpdateProjectTemplate(
+					api.UpdateProjectTemplateParam{
+						Workspace: "w",
+						ProjectID: "p1",
+						Template:  true,
+					}).
+					Return(dto.Project{}, errors.New("http error"))
+
+				return f, nil
+			},
+		},
+	}
+
+	for _, b := range []bool{true, false} {
+		isTemplate := b
+		name := "unset"
+		if isTemplate {
+			name = "set"
+		}
+
+		tts = append(tts, struct {
+			name   string
+			args   []string
+			err    string
+			params func(*testing.T) (cmdutil.Factory, report)
+		}{
+			name: name + " as template",
+			args: []string{name, "cli", "p2"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.EXPECT().IsAllowNameForID().Return(true)
+				f.EXPECT().Config().Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+
+				c.EXPECT().GetProjects(api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{
+					{ID: "p1", Name: "Clockify CLI"},
+					{ID: "p2", Name: "Second"},
+				}, nil)
+
+				for _, id := range []string{"p1", "p2"} {
+					c.EXPECT().UpdateProjectTemplate(
+						api.UpdateProjectTemplateParam{
+							Workspace: "w",
+							ProjectID: id,
+							Template:  isTemplate,
+						}).
+						Return(dto.Project{ID: id, Template: isTemplate}, nil)
+				}
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, _ *util.OutputFlags, ps []dto.Project) error {
+					called = true
+					assert.Equal(t, []dto.Project{
+						{ID: "p1", Template: isTemplate},
+						{ID: "p2", Template: isTemplate},
+					}, ps)
+					return nil
+				}
+			},
+		})
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(io.Writer, *util.OutputFlags, []dto.Project) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := template.NewCmdTemplate(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
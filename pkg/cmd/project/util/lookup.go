@@ -0,0 +1,58 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+)
+
+// GetProject looks up the project by its id or name (if allowed)
+func GetProject(
+	f cmdutil.Factory, c api.Client, w, project string,
+) (dto.Project, error) {
+	var err error
+	project = strings.TrimSpace(project)
+	if f.Config().IsAllowNameForID() {
+		if project, err = search.GetProjectByName(
+			c, w, project); err != nil {
+			return dto.Project{}, err
+		}
+	}
+
+	p, err := c.GetProject(api.GetProjectParam{
+		Workspace: w,
+		ProjectID: project,
+	})
+	if err != nil {
+		return dto.Project{}, err
+	}
+
+	if p == nil {
+		return dto.Project{}, api.EntityNotFound{
+			EntityName: "project",
+			ID:         project,
+		}
+	}
+
+	return *p, nil
+}
+
+// GetUserIDs looks up the users by their ids, emails or names (if allowed)
+func GetUserIDs(
+	f cmdutil.Factory, c api.Client, w string, users []string,
+) ([]string, error) {
+	lookup := f.Config().IsAllowNameForID()
+	for i := range users {
+		users[i] = strings.TrimSpace(users[i])
+		lookup = lookup || strings.Contains(users[i], "@")
+	}
+
+	if !lookup {
+		return users, nil
+	}
+
+	return search.GetUsersByName(c, w, users)
+}
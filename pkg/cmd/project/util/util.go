@@ -52,6 +52,25 @@ func Report(list []dto.Project, out io.Writer, f OutputFlags) error {
 	}
 }
 
+// PageWriter prints pages of projects as they are loaded
+type PageWriter interface {
+	Write([]dto.Project) error
+	Close() error
+}
+
+// NewPageWriter returns a PageWriter for the formats that can be printed
+// before all pages are loaded, or nil if the flags require all projects
+func NewPageWriter(out io.Writer, f OutputFlags) PageWriter {
+	switch {
+	case f.JSON:
+		return project.NewProjectsJSONWriter(out)
+	case f.CSV:
+		return project.NewProjectsCSVWriter(out)
+	default:
+		return nil
+	}
+}
+
 // ReportOne will print a project as set by the flags
 func ReportOne(p dto.Project, out io.Writer, f OutputFlags) error {
 	switch {
@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/client"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/completion"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/config"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/sync"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/task"
 	timeentry "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry"
@@ -15,14 +18,41 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/logging"
 	"github.com/spf13/cobra"
 )
 
 // NewCmdRoot creates the base command when called without any subcommands
 func NewCmdRoot(f cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:           "clockify-cli",
-		Short:         "Allow to integrate with Clockify through terminal",
+		Use:   "clockify-cli",
+		Short: "Allow to integrate with Clockify through terminal",
+		Long: heredoc.Docf(`
+			Allow to integrate with Clockify through terminal
+
+			When a command fails it exits with one of the following codes:
+			  %-3dunexpected error or invalid flags
+			  %-3doperation was cancelled
+			  %-3dtoken was not accepted by Clockify (401)
+			  %-3duser is not allowed to do it (403)
+			  %-3dsomething was not found (404)
+			  %-3drequest was not valid (400)
+			  %-3drequest conflicts with the current state (409)
+			  %-3dtoo many requests were sent (429)
+			  %-3dClockify failed to answer (5xx)
+			  %-3dClockify could not be reached
+		`,
+			cmdutil.ExitError,
+			cmdutil.ExitCancel,
+			cmdutil.ExitUnauthorized,
+			cmdutil.ExitForbidden,
+			cmdutil.ExitNotFound,
+			cmdutil.ExitBadRequest,
+			cmdutil.ExitConflict,
+			cmdutil.ExitRateLimited,
+			cmdutil.ExitServerError,
+			cmdutil.ExitNetworkError,
+		),
 		SilenceErrors: true,
 		SilenceUsage:  true,
 	}
@@ -58,6 +88,22 @@ func NewCmdRoot(f cmdutil.Factory) *cobra.Command {
 			cmdutil.LOG_LEVEL_INFO,
 		})
 
+	cmd.PersistentFlags().String("log-format", logging.FormatText,
+		"how logs are written")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "log-format",
+		cmdcompl.ValidArgsSlide{logging.FormatText, logging.FormatJSON})
+
+	cmd.PersistentFlags().String("log-file", "",
+		"append logs to the file instead of writing them to stderr")
+
+	cmd.PersistentFlags().Bool("no-cache", false,
+		"fetch projects, tasks, tags, clients and users from clockify "+
+			"instead of the local cache")
+
+	cmd.PersistentFlags().Bool("offline", false,
+		"record changes on time entries to be synced later, instead of "+
+			"sending them to clockify")
+
 	_ = cmd.MarkFlagRequired("token")
 
 	cmd.AddCommand(version.NewCmdVersion(f))
@@ -77,6 +123,9 @@ func NewCmdRoot(f cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(timeentry.NewCmdTimeEntry(f)...)
 
+	cmd.AddCommand(cache.NewCmdCache(f))
+	cmd.AddCommand(sync.NewCmdSync(f))
+
 	cmd.AddCommand(completion.NewCmdCompletion())
 
 	return cmd
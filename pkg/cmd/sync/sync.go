@@ -0,0 +1,109 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/offline"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSync represents the sync command
+func NewCmdSync(f cmdutil.Factory) *cobra.Command {
+	o := offline.SyncOptions{}
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Args:  cobra.ExactArgs(0),
+		Short: "Sends the changes on time entries made offline to Clockify",
+		Long: heredoc.Doc(`
+			Sends the changes on time entries made offline (using --offline or the config offline) to Clockify, in the same order they were made.
+
+			Time entries created offline have provisional IDs (like offline-1), which are replaced by the ones created on Clockify.
+
+			If a time entry was changed or deleted on Clockify after it was changed offline the change is reported as a conflict and is not sent, use --force to send it anyway.
+			Changes with conflicts or refused by Clockify are kept to be sent later, unless --discard is used.
+
+			After sending the changes, the time entries of the user started on the last 30 days, the workspace and its custom fields are kept on the journal, so they can be shown and changed while offline.
+			While online, other commands only keep the user, the workspace and the time entries they fetch or change, so run sync before going offline to have all of them.
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli in --offline -p cli -d "Working without internet"
+			$ clockify-cli out --offline
+			$ clockify-cli sync
+			+--------+---------------------------------------+--------+--------+
+			| CHANGE |              TIME ENTRY               | STATUS | REASON |
+			+--------+---------------------------------------+--------+--------+
+			| create | offline-1 -> 62af70d849445270d7c09fbd | synced |        |
+			| out    | offline-1 -> 62af70d849445270d7c09fbd | synced |        |
+			+--------+---------------------------------------+--------+--------+
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdutil.XorFlag(map[string]bool{
+				"force":   o.Force,
+				"discard": o.Discard,
+			}); err != nil {
+				return err
+			}
+
+			if f.Config().GetBool(cmdutil.CONF_OFFLINE) {
+				return errors.New("sync can't be used while offline")
+			}
+
+			p, err := cmdutil.JournalPath(f.Config())
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			rs, err := offline.Sync(c, p, o)
+			if err == nil {
+				err = snapshot(f, c, p)
+			}
+
+			if len(rs) == 0 {
+				if err == nil {
+					fmt.Fprintln(cmd.OutOrStdout(), "nothing to sync")
+				}
+
+				return err
+			}
+
+			if pErr := output.SyncPrint(rs, cmd.OutOrStdout()); pErr != nil {
+				return pErr
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&o.Force, "force", false,
+		"send changes even if the time entry was changed on clockify")
+	cmd.Flags().BoolVar(&o.Discard, "discard", false,
+		"remove changes that could not be sent instead of keeping them")
+
+	return cmd
+}
+
+// snapshot keeps the current state of the user's time entries on the
+// journal, to be used while offline
+func snapshot(f cmdutil.Factory, c api.Client, path string) error {
+	w, err := f.GetWorkspaceID()
+	if err != nil {
+		return err
+	}
+
+	u, err := f.GetUserID()
+	if err != nil {
+		return err
+	}
+
+	return offline.Snapshot(c, path, w, u)
+}
@@ -0,0 +1,104 @@
+package sync_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/sync"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdSync(t *testing.T) {
+	tts := []struct {
+		name     string
+		args     []string
+		offline  bool
+		snapshot bool
+		out      string
+		err      string
+	}{
+		{
+			name: "force and discard",
+			args: []string{"--force", "--discard"},
+			err: "the following flags can't be used together: " +
+				"`discard` and `force`",
+		},
+		{
+			name:    "offline",
+			offline: true,
+			err:     "sync can't be used while offline",
+		},
+		{
+			name:     "empty journal",
+			out:      "nothing to sync\n",
+			snapshot: true,
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			p := filepath.Join(t.TempDir(), "journal.json")
+			f := mocks.NewMockFactory(t)
+			c := mocks.NewMockConfig(t)
+			f.On("Config").Return(c).Maybe()
+			c.On("GetBool", cmdutil.CONF_OFFLINE).Return(tt.offline).Maybe()
+			c.On("GetString", cmdutil.CONF_JOURNAL_FILE).Return(p).Maybe()
+
+			cl := mocks.NewMockClient(t)
+			f.On("Client").Return(cl, nil).Maybe()
+			if tt.snapshot {
+				f.On("GetWorkspaceID").Return("w", nil)
+				f.On("GetUserID").Return("u", nil)
+				cl.On("GetMe").Return(dto.User{ID: "u"}, nil)
+				cl.On("GetWorkspace", api.GetWorkspace{ID: "w"}).
+					Return(dto.Workspace{ID: "w"}, nil)
+				cl.On("GetWorkspaceCustomFields",
+					api.GetWorkspaceCustomFieldsParam{Workspace: "w"}).
+					Return([]dto.WorkspaceCustomField{}, nil)
+				cl.On("GetUsersHydratedTimeEntries",
+					mock.AnythingOfType("api.GetUserTimeEntriesParam")).
+					Return([]dto.TimeEntry{{
+						ID:           "te",
+						WorkspaceID:  "w",
+						TimeInterval: dto.NewTimeInterval(time.Now(), nil),
+					}}, nil)
+			}
+
+			cmd := sync.NewCmdSync(f)
+			cmd.SetArgs(tt.args)
+			b := bytes.NewBufferString("")
+			cmd.SetOut(b)
+			cmd.SetErr(b)
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.out, b.String())
+			if !tt.snapshot {
+				return
+			}
+
+			j, err := offline.Open(p)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "u", j.UserID)
+				assert.Contains(t, j.TimeEntries["w"], "te")
+			}
+		})
+	}
+}
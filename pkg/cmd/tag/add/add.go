@@ -0,0 +1,87 @@
+package add
+
+import (
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAdd represents the add command
+func NewCmdAdd(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Tag) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var names []string
+	cmd := &cobra.Command{
+		Use:     "add",
+		Aliases: []string{"new", "create"},
+		Short:   "Adds new tags to the Clockify workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s --name Meeting
+			+--------------------------+---------+
+			|            ID            |  NAME   |
+			+--------------------------+---------+
+			| 62194867edaba27d0a45b464 | Meeting |
+			+--------------------------+---------+
+
+			$ %[1]s --name "Code Review" --name "Pair Programming" --quiet
+			6219485e8cb9606d934ebb5f
+			621948708cb9606d934ebba7
+
+			$ %[1]s --name Meeting # same name as existing one
+			add tag: Tag with name 'Meeting' already exists (code: 501)
+		`, "clockify-cli tag add"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			names = strhlp.Unique(strhlp.Map(strings.TrimSpace, names))
+			tags := make([]dto.Tag, 0, len(names))
+			for _, n := range names {
+				t, err := c.AddTag(api.AddTagParam{
+					Workspace: w,
+					Name:      n,
+				})
+				if err != nil {
+					return err
+				}
+
+				tags = append(tags, t)
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, tags)
+			}
+
+			return util.Report(tags, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&names, "name", "n", []string{},
+		"the name of the new tag, can be used multiple times to add more "+
+			"than one tag")
+	_ = cmd.MarkFlagRequired("name")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,182 @@
+package add_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdAdd(t *testing.T) {
+	tts := []struct {
+		name    string
+		args    []string
+		factory func(*testing.T) cmdutil.Factory
+		err     string
+	}{
+		{
+			name: "only one format",
+			args: []string{"--format={}", "-q", "-j", "-n=OK"},
+			err:  "flags can't be used together.*format.*json.*quiet",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "name required",
+			err:  `"name" not set`,
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "workspace error",
+			err:  "workspace error",
+			args: []string{"-n=a"},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").
+					Return("", errors.New("workspace error"))
+				return f
+			},
+		},
+		{
+			name: "client error",
+			err:  "client error",
+			args: []string{"-n=a"},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").
+					Return("w", nil)
+				f.On("Client").Return(nil, errors.New("client error"))
+				return f
+			},
+		},
+		{
+			name: "http error",
+			err:  "http error",
+			args: []string{"-n=first", "-n=error", "-n=never"},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				c := mocks.NewMockClient(t)
+				f.On("GetWorkspaceID").
+					Return("w", nil)
+				f.On("Client").Return(c, nil)
+				c.On("AddTag", api.AddTagParam{
+					Workspace: "w",
+					Name:      "first",
+				}).
+					Return(dto.Tag{ID: "t1"}, nil)
+				c.On("AddTag", api.AddTagParam{
+					Workspace: "w",
+					Name:      "error",
+				}).
+					Return(dto.Tag{}, errors.New("http error"))
+				return f
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := add.NewCmdAdd(tt.factory(t),
+				func(io.Writer, *util.OutputFlags, []dto.Tag) error {
+					t.Error("should not get here")
+					return nil
+				})
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
+
+func TestCmdAddReport(t *testing.T) {
+	tags := []dto.Tag{
+		{ID: "t1", Name: "Meeting"},
+		{ID: "t2", Name: "Code Review"},
+	}
+	tts := []struct {
+		name   string
+		args   []string
+		assert func(*testing.T, *util.OutputFlags)
+	}{
+		{
+			name: "report quiet",
+			args: []string{"-q"},
+			assert: func(t *testing.T, of *util.OutputFlags) {
+				assert.True(t, of.Quiet)
+			},
+		},
+		{
+			name: "report json",
+			args: []string{"--json"},
+			assert: func(t *testing.T, of *util.OutputFlags) {
+				assert.True(t, of.JSON)
+			},
+		},
+		{
+			name: "report csv",
+			args: []string{"--csv"},
+			assert: func(t *testing.T, of *util.OutputFlags) {
+				assert.True(t, of.CSV)
+			},
+		},
+		{
+			name: "report format",
+			args: []string{"--format={{.ID}}"},
+			assert: func(t *testing.T, of *util.OutputFlags) {
+				assert.Equal(t, "{{.ID}}", of.Format)
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			c := mocks.NewMockClient(t)
+			f.On("Client").Return(c, nil)
+			f.On("GetWorkspaceID").
+				Return("w", nil)
+
+			for _, tag := range tags {
+				c.On("AddTag", api.AddTagParam{
+					Workspace: "w",
+					Name:      tag.Name,
+				}).
+					Return(tag, nil).Once()
+			}
+
+			called := false
+			t.Cleanup(func() { assert.True(t, called, "was not called") })
+			cmd := add.NewCmdAdd(f, func(
+				_ io.Writer, of *util.OutputFlags, ts []dto.Tag) error {
+				called = true
+				assert.Equal(t, tags, ts)
+				tt.assert(t, of)
+				return nil
+			})
+			cmd.SilenceUsage = true
+			cmd.SetArgs(append(tt.args,
+				"-n", "Meeting", "-n", " Code Review", "-n", "Meeting"))
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+		})
+	}
+}
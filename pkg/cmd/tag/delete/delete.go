@@ -0,0 +1,142 @@
+package del
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdDelete represents the delete command
+func NewCmdDelete(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Tag) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	yes := false
+	cmd := &cobra.Command{
+		Use:     "delete <tag>...",
+		Aliases: []string{"remove", "rm", "del"},
+		Args:    cmdutil.RequiredNamedArgs("tag"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewTagAutoComplete(f)),
+		Short: "Deletes tags from a Clockify workspace",
+		Long: heredoc.Doc(`
+			Deletes tags from a Clockify workspace
+
+			A confirmation will be asked before deleting each tag, use --yes to skip it.
+
+			This action can't be reverted.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s meeting
+			? Delete tag "Meeting" (6219485e8cb9606d934ebb5f)? Yes
+			+--------------------------+---------+
+			|            ID            |  NAME   |
+			+--------------------------+---------+
+			| 6219485e8cb9606d934ebb5f | Meeting |
+			+--------------------------+---------+
+
+			# deleting multiple tags without confirmation
+			$ %[1]s meeting 62194867edaba27d0a45b464 --yes -q
+			6219485e8cb9606d934ebb5f
+			62194867edaba27d0a45b464
+		`, "clockify-cli tag delete"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+			if f.Config().IsAllowNameForID() {
+				if ids, err = search.GetTagsByName(c, w, ids); err != nil {
+					return err
+				}
+			}
+
+			if !yes {
+				if ids, err = confirmDeletion(f, c, w, ids); err != nil {
+					return err
+				}
+			}
+
+			var g errgroup.Group
+			deleted := make([]dto.Tag, len(ids))
+			for i := range ids {
+				j := i
+				g.Go(func() error {
+					t, err := c.DeleteTag(api.DeleteTagParam{
+						Workspace: w,
+						TagID:     ids[j],
+					})
+					deleted[j] = t
+					return err
+				})
+			}
+
+			if err := g.Wait(); err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, deleted)
+			}
+
+			return util.Report(deleted, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false,
+		"don't ask for confirmation before deleting")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
+
+// confirmDeletion asks the user to confirm the deletion of each tag,
+// returning only the confirmed ones
+func confirmDeletion(
+	f cmdutil.Factory, c api.Client, w string, ids []string,
+) ([]string, error) {
+	confirmed := make([]string, 0, len(ids))
+	for _, id := range ids {
+		t, err := c.GetTag(api.GetTagParam{Workspace: w, TagID: id})
+		if err != nil {
+			return confirmed, err
+		}
+
+		ok, err := f.UI().Confirm(fmt.Sprintf(
+			"Delete tag \"%s\" (%s)?", t.Name, t.ID), false)
+		if err != nil {
+			return confirmed, err
+		}
+
+		if ok {
+			confirmed = append(confirmed, id)
+		}
+	}
+
+	return confirmed, nil
+}
@@ -0,0 +1,126 @@
+package del_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/consoletest"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/tag/delete"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFactory(t *testing.T, allowNameForID bool) (
+	*mocks.MockFactory, *mocks.MockClient) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+	cf := mocks.NewMockConfig(t)
+	cf.EXPECT().IsAllowNameForID().Return(allowNameForID)
+	f.EXPECT().Config().Return(cf)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	return f, c
+}
+
+func TestCmdDelete(t *testing.T) {
+	t.Run("tag is required", func(t *testing.T) {
+		cmd := del.NewCmdDelete(mocks.NewMockFactory(t), nil)
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		if assert.Error(t, err) {
+			assert.Regexp(t, "requires arg tag", err.Error())
+		}
+	})
+
+	t.Run("http error", func(t *testing.T) {
+		f, c := newFactory(t, false)
+		c.EXPECT().DeleteTag(api.DeleteTagParam{Workspace: "w", TagID: "t1"}).
+			Return(dto.Tag{}, errors.New("http error"))
+
+		cmd := del.NewCmdDelete(f, nil)
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{"t1", "--yes"})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "http error")
+	})
+
+	t.Run("delete by name without confirmation", func(t *testing.T) {
+		f, c := newFactory(t, true)
+		c.EXPECT().GetTags(api.GetTagsParam{
+			Workspace:       "w",
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Tag{
+			{ID: "t1", Name: "Meeting"},
+			{ID: "t2", Name: "Code Review"},
+		}, nil)
+
+		for _, id := range []string{"t2", "t1"} {
+			c.EXPECT().DeleteTag(api.DeleteTagParam{Workspace: "w", TagID: id}).
+				Return(dto.Tag{ID: id}, nil)
+		}
+
+		called := false
+		cmd := del.NewCmdDelete(f, func(
+			_ io.Writer, of *util.OutputFlags, ts []dto.Tag) error {
+			called = true
+			assert.True(t, of.Quiet)
+			assert.Equal(t, []dto.Tag{{ID: "t2"}, {ID: "t1"}}, ts)
+			return nil
+		})
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{"review", "meeting", "-y", "-q"})
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+		assert.True(t, called, "was not called")
+	})
+}
+
+func TestCmdDeleteShouldAskForConfirmation(t *testing.T) {
+	consoletest.RunTestConsole(t,
+		func(out consoletest.FileWriter, in consoletest.FileReader) error {
+			f, c := newFactory(t, false)
+			f.EXPECT().UI().Return(ui.NewUI(in, out, out))
+
+			c.EXPECT().GetTag(api.GetTagParam{Workspace: "w", TagID: "t1"}).
+				Return(&dto.Tag{ID: "t1", Name: "Meeting"}, nil)
+			c.EXPECT().GetTag(api.GetTagParam{Workspace: "w", TagID: "t2"}).
+				Return(&dto.Tag{ID: "t2", Name: "Code Review"}, nil)
+
+			c.EXPECT().DeleteTag(api.DeleteTagParam{Workspace: "w", TagID: "t2"}).
+				Return(dto.Tag{ID: "t2"}, nil)
+
+			called := false
+			t.Cleanup(func() { assert.True(t, called, "was not called") })
+			cmd := del.NewCmdDelete(f, func(
+				_ io.Writer, _ *util.OutputFlags, ts []dto.Tag) error {
+				called = true
+				assert.Equal(t, []dto.Tag{{ID: "t2"}}, ts)
+				return nil
+			})
+			cmd.SilenceUsage = true
+			cmd.SetArgs([]string{"t1", "t2"})
+
+			_, err := cmd.ExecuteC()
+			return err
+		},
+		func(c consoletest.ExpectConsole) {
+			c.ExpectString(`Delete tag "Meeting" (t1)?`)
+			c.SendLine("n")
+			c.ExpectString(`Delete tag "Code Review" (t2)?`)
+			c.SendLine("y")
+			c.ExpectEOF()
+		},
+	)
+}
@@ -0,0 +1,125 @@
+package edit
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewCmdEdit updates tags
+func NewCmdEdit(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Tag) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "edit <tag>...",
+		Aliases: []string{"update"},
+		Args:    cmdutil.RequiredNamedArgs("tag"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewTagAutoComplete(f)),
+		Short: "Renames, archives or activates tags",
+		Example: heredoc.Docf(`
+			# rename a tag
+			$ %[1]s 62194867edaba27d0a45b464 --name "Code Review"
+			+--------------------------+-------------+
+			|            ID            |    NAME     |
+			+--------------------------+-------------+
+			| 62194867edaba27d0a45b464 | Code Review |
+			+--------------------------+-------------+
+
+			# archive multiple tags
+			$ %[1]s meeting "code review" --archived \
+				--format "{{.Name}} | {{.Archived}}"
+			Meeting | true
+			Code Review | true
+
+			# activate a tag
+			$ %[1]s meeting --active -q
+			6219485e8cb9606d934ebb5f
+		`, "clockify-cli tag edit"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if err := cmdutil.XorFlagSet(
+				cmd.Flags(), "archived", "active"); err != nil {
+				return err
+			}
+
+			if len(args) > 1 && cmd.Flags().Changed("name") {
+				return errors.New(
+					"`--name` can't be changed for multiple tags")
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			ids := strhlp.Unique(strhlp.Map(strings.TrimSpace, args))
+			if f.Config().IsAllowNameForID() {
+				if ids, err = search.GetTagsByName(c, w, ids); err != nil {
+					return err
+				}
+			}
+
+			p := api.UpdateTagParam{Workspace: w}
+			p.Name, _ = cmd.Flags().GetString("name")
+			if cmd.Flags().Changed("archived") ||
+				cmd.Flags().Changed("active") {
+				b, _ := cmd.Flags().GetBool("archived")
+				p.Archived = &b
+			}
+
+			var g errgroup.Group
+			tags := make([]dto.Tag, len(ids))
+			for i := range ids {
+				j := i
+				g.Go(func() error {
+					tp := p
+					tp.TagID = ids[j]
+					t, err := c.UpdateTag(tp)
+					tags[j] = t
+					return err
+				})
+			}
+
+			if err := g.Wait(); err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, tags)
+			}
+
+			return util.Report(tags, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringP("name", "n", "", "new name of the tag")
+	cmd.Flags().BoolP("archived", "A", false, "set the tags as archived")
+	cmd.Flags().BoolP("active", "a", false, "set the tags as active")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
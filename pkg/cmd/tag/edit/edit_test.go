@@ -0,0 +1,155 @@
+package edit_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/edit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFactory(t *testing.T, allowNameForID bool) (
+	*mocks.MockFactory, *mocks.MockClient) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+	cf := mocks.NewMockConfig(t)
+	cf.EXPECT().IsAllowNameForID().Return(allowNameForID)
+	f.EXPECT().Config().Return(cf)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	return f, c
+}
+
+func TestCmdEdit(t *testing.T) {
+	bTrue := true
+	bFalse := false
+
+	tts := []struct {
+		name    string
+		args    []string
+		err     string
+		factory func(*testing.T) cmdutil.Factory
+		expect  []dto.Tag
+	}{
+		{
+			name: "tag is required",
+			err:  "requires arg tag",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "archived and active",
+			args: []string{"t1", "--archived", "--active"},
+			err:  "flags can't be used together.*active.*archived",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "name for multiple tags",
+			args: []string{"t1", "t2", "--name", "new"},
+			err:  "`--name` can't be changed for multiple tags",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "tag not found by name",
+			args: []string{"meet"},
+			err:  "No tag with id or name containing 'meet' was found",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f, c := newFactory(t, true)
+				c.EXPECT().GetTags(api.GetTagsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Tag{{ID: "t2", Name: "Review"}}, nil)
+				return f
+			},
+		},
+		{
+			name: "http error",
+			args: []string{"t1", "--archived"},
+			err:  "http error",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f, c := newFactory(t, false)
+				c.EXPECT().UpdateTag(api.UpdateTagParam{
+					Workspace: "w",
+					TagID:     "t1",
+					Archived:  &bTrue,
+				}).Return(dto.Tag{}, errors.New("http error"))
+				return f
+			},
+		},
+		{
+			name: "rename by name",
+			args: []string{"meet", "--name", "Meeting"},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f, c := newFactory(t, true)
+				c.EXPECT().GetTags(api.GetTagsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Tag{{ID: "t1", Name: "Meet"}}, nil)
+				c.EXPECT().UpdateTag(api.UpdateTagParam{
+					Workspace: "w",
+					TagID:     "t1",
+					Name:      "Meeting",
+				}).Return(dto.Tag{ID: "t1", Name: "Meeting"}, nil)
+				return f
+			},
+			expect: []dto.Tag{{ID: "t1", Name: "Meeting"}},
+		},
+		{
+			name: "activate multiple",
+			args: []string{"t1", "t2", "t1", "--active"},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f, c := newFactory(t, false)
+				for _, id := range []string{"t1", "t2"} {
+					c.EXPECT().UpdateTag(api.UpdateTagParam{
+						Workspace: "w",
+						TagID:     id,
+						Archived:  &bFalse,
+					}).Return(dto.Tag{ID: id}, nil).Once()
+				}
+				return f
+			},
+			expect: []dto.Tag{{ID: "t1"}, {ID: "t2"}},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			cmd := edit.NewCmdEdit(tt.factory(t), func(
+				_ io.Writer, _ *util.OutputFlags, ts []dto.Tag) error {
+				called = true
+				assert.Equal(t, tt.expect, ts)
+				return nil
+			})
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				assert.True(t, called, "report was not called")
+				return
+			}
+
+			if assert.Error(t, err) {
+				assert.Regexp(t, tt.err, err.Error())
+			}
+			assert.False(t, called, "report should not be called")
+		})
+	}
+}
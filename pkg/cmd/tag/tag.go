@@ -1,22 +1,24 @@
 package tag
 
 import (
-	"os"
-
 	"github.com/MakeNowJust/heredoc"
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/add"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/tag/delete"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/edit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
-	output "github.com/lucassabreu/clockify-cli/pkg/output/tag"
 	"github.com/spf13/cobra"
 )
 
 // NewCmdTag represents the tags command
 func NewCmdTag(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{}
 	cmd := &cobra.Command{
 		Use:     "tag",
 		Aliases: []string{"tags"},
-		Short:   "List tags on Clockify",
+		Short:   "List and manage tags on Clockify",
 		Example: heredoc.Docf(`
 			$ %[1]s
 			+--------------------------+------------------+
@@ -35,12 +37,7 @@ func NewCmdTag(f cmdutil.Factory) *cobra.Command {
 			Archived Tag
 		`, "clockify-cli tag"),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			format, _ := cmd.Flags().GetString("format")
-			quiet, _ := cmd.Flags().GetBool("quiet")
-			if err := cmdutil.XorFlag(map[string]bool{
-				"format": format != "",
-				"quiet":  quiet,
-			}); err != nil {
+			if err := of.Check(); err != nil {
 				return err
 			}
 
@@ -52,26 +49,20 @@ func NewCmdTag(f cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			out := cmd.OutOrStdout()
-			if format != "" {
-				return output.TagPrintWithTemplate(format)(tags, out)
-			}
-
-			if quiet {
-				return output.TagPrintQuietly(tags, out)
-			}
-
-			return output.TagPrint(tags, os.Stdout)
+			return util.Report(tags, cmd.OutOrStdout(), of)
 		},
 	}
 
 	cmd.Flags().StringP("name", "n", "",
 		"will be used to filter the tag by name")
-	cmd.Flags().StringP("format", "f", "",
-		"golang text/template format to be applied on each Tag")
-	cmd.Flags().BoolP("quiet", "q", false, "only display ids")
 	cmd.Flags().BoolP("archived", "", false, "only display archived tags")
 
+	util.AddReportFlags(cmd, &of)
+
+	cmd.AddCommand(add.NewCmdAdd(f, nil))
+	cmd.AddCommand(edit.NewCmdEdit(f, nil))
+	cmd.AddCommand(del.NewCmdDelete(f, nil))
+
 	return cmd
 }
 
@@ -0,0 +1,52 @@
+package util
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/tag"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out a list of tags
+type OutputFlags struct {
+	Format string
+	CSV    bool
+	JSON   bool
+	Quiet  bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"csv":    of.CSV,
+		"quiet":  of.Quiet,
+	})
+}
+
+// AddReportFlags adds the default output flags for tags
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each Tag")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
+}
+
+// Report prints out the tags
+func Report(ts []dto.Tag, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.TagsJSONPrint(ts, out)
+	case of.CSV:
+		return output.TagsCSVPrint(ts, out)
+	case of.Format != "":
+		return output.TagPrintWithTemplate(of.Format)(ts, out)
+	case of.Quiet:
+		return output.TagPrintQuietly(ts, out)
+	default:
+		return output.TagPrint(ts, out)
+	}
+}
@@ -68,12 +68,13 @@ func NewCmdAdd(
 			}
 
 			task, err := c.AddTask(api.AddTaskParam{
-				Workspace:   fl.Workspace,
-				ProjectID:   fl.ProjectID,
-				Name:        fl.Name,
-				Estimate:    fl.Estimate,
-				AssigneeIDs: fl.AssigneeIDs,
-				Billable:    fl.Billable,
+				Workspace:    fl.Workspace,
+				ProjectID:    fl.ProjectID,
+				Name:         fl.Name,
+				Estimate:     fl.Estimate,
+				AssigneeIDs:  fl.AssigneeIDs,
+				UserGroupIDs: fl.UserGroupIDs,
+				Billable:     fl.Billable,
 			})
 			if err != nil {
 				return err
@@ -60,6 +60,14 @@ func TestCmdAdd(t *testing.T) {
 				return mocks.NewMockFactory(t)
 			},
 		},
+		{
+			name: "group or no group",
+			args: []string{"--group=l", "--no-group", "-n=OK", "-p=OK"},
+			err:  "flags can't be used together.*group.*no-group",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
 		{
 			name: "name required",
 			args: []string{"-p=OK"},
@@ -271,6 +279,53 @@ func TestCmdAdd(t *testing.T) {
 			},
 			report: shouldCall,
 		},
+		{
+			name: "add task assigned to groups",
+			args: []string{
+				"-n", "Add Task",
+				"--project=p-1",
+				"--group", "dev",
+				"-G=admins",
+			},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				c := mocks.NewMockClient(t)
+				f.On("GetWorkspaceID").
+					Return("w", nil)
+				f.On("Client").Return(c, nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+
+				c.On("GetProjects", api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(
+					[]dto.Project{{ID: "p-1", Name: "Clockify CLI"}}, nil)
+
+				c.On("GetUserGroups", api.GetUserGroupsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(
+					[]dto.UserGroup{
+						{ID: "g-1", Name: "Developers"},
+						{ID: "g-2", Name: "Admins"},
+					}, nil)
+
+				gs := []string{"g-1", "g-2"}
+				c.On("AddTask", api.AddTaskParam{
+					Workspace:    "w",
+					Name:         "Add Task",
+					ProjectID:    "p-1",
+					UserGroupIDs: &gs,
+				}).
+					Return(dto.Task{ID: "t-id"}, nil)
+
+				return f
+			},
+			report: shouldCall,
+		},
 	}
 
 	for _, tt := range tts {
@@ -95,13 +95,14 @@ func NewCmdEdit(
 			}
 
 			p := api.UpdateTaskParam{
-				Workspace:   fl.Workspace,
-				ProjectID:   fl.ProjectID,
-				TaskID:      task,
-				Name:        fl.Name,
-				Estimate:    fl.Estimate,
-				AssigneeIDs: fl.AssigneeIDs,
-				Billable:    fl.Billable,
+				Workspace:    fl.Workspace,
+				ProjectID:    fl.ProjectID,
+				TaskID:       task,
+				Name:         fl.Name,
+				Estimate:     fl.Estimate,
+				AssigneeIDs:  fl.AssigneeIDs,
+				UserGroupIDs: fl.UserGroupIDs,
+				Billable:     fl.Billable,
 			}
 
 			if !cmd.Flags().Changed("name") {
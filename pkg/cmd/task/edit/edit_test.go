@@ -85,6 +85,14 @@ func TestCmdEdit(t *testing.T) {
 				return mocks.NewMockFactory(t)
 			},
 		},
+		{
+			name: "group or no group",
+			args: []string{"--group=l", "--no-group", "edit", "-p=OK"},
+			err:  "flags can't be used together.*group.*no-group",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
 		{
 			name: "client error",
 			err:  "client error",
@@ -274,6 +282,58 @@ func TestCmdEdit(t *testing.T) {
 			},
 			report: shouldCall,
 		},
+		{
+			name: "edit task groups",
+			args: []string{
+				"edit",
+				"--name=Edit",
+				"--project=cli",
+				"--group=dev",
+			},
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				c := mocks.NewMockClient(t)
+				f.On("GetWorkspaceID").
+					Return("w", nil)
+				f.On("Client").Return(c, nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+
+				c.On("GetProjects", api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(
+					[]dto.Project{{ID: "p-1", Name: "Clockify CLI"}}, nil)
+
+				c.On("GetUserGroups", api.GetUserGroupsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(
+					[]dto.UserGroup{{ID: "g-1", Name: "Developers"}}, nil)
+
+				c.On("GetTasks", api.GetTasksParam{
+					Workspace:       "w",
+					ProjectID:       "p-1",
+					PaginationParam: api.AllPages(),
+				}).Return(
+					[]dto.Task{{ID: "t-1", Name: "Edit Command"}}, nil)
+
+				gs := []string{"g-1"}
+				c.On("UpdateTask", api.UpdateTaskParam{
+					Workspace:    "w",
+					TaskID:       "t-1",
+					Name:         "Edit",
+					ProjectID:    "p-1",
+					UserGroupIDs: &gs,
+				}).
+					Return(te, nil)
+
+				return f
+			},
+			report: shouldCall,
+		},
 		{
 			name: "edit non-billable task",
 			args: []string{
@@ -25,17 +25,26 @@ func TaskAddPropFlags(cmd *cobra.Command, f cmdutil.Factory) {
 	cmd.Flags().Bool("no-assignee", false,
 		"cleans the assignee list")
 
+	cmd.Flags().StringSliceP("group", "G", []string{},
+		"list of user groups that are assigned to this task")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "group",
+		cmdcomplutil.NewUserGroupAutoComplete(f))
+
+	cmd.Flags().Bool("no-group", false,
+		"cleans the user group list")
+
 	cmdutil.AddProjectFlags(cmd, f)
 }
 
 // FlagsDTO holds data about editing or creating a Task
 type FlagsDTO struct {
-	Workspace   string
-	ProjectID   string
-	Name        string
-	Estimate    *time.Duration
-	AssigneeIDs *[]string
-	Billable    *bool
+	Workspace    string
+	ProjectID    string
+	Name         string
+	Estimate     *time.Duration
+	AssigneeIDs  *[]string
+	UserGroupIDs *[]string
+	Billable     *bool
 }
 
 // TaskReadFlags read the common flags expected when editing a task
@@ -47,6 +56,13 @@ func TaskReadFlags(cmd *cobra.Command, f cmdutil.Factory) (p FlagsDTO, err error
 		return p, err
 	}
 
+	if err := cmdutil.XorFlag(map[string]bool{
+		"group":    cmd.Flags().Changed("group"),
+		"no-group": cmd.Flags().Changed("no-group"),
+	}); err != nil {
+		return p, err
+	}
+
 	if err := cmdutil.XorFlag(map[string]bool{
 		"billable":     cmd.Flags().Changed("billable"),
 		"not-billable": cmd.Flags().Changed("not-billable"),
@@ -72,6 +88,11 @@ func TaskReadFlags(cmd *cobra.Command, f cmdutil.Factory) (p FlagsDTO, err error
 		p.AssigneeIDs = &assignees
 	}
 
+	if cmd.Flags().Changed("group") {
+		groups, _ := cmd.Flags().GetStringSlice("group")
+		p.UserGroupIDs = &groups
+	}
+
 	if f.Config().IsAllowNameForID() {
 		c, err := f.Client()
 		if err != nil {
@@ -91,6 +112,15 @@ func TaskReadFlags(cmd *cobra.Command, f cmdutil.Factory) (p FlagsDTO, err error
 			}
 			p.AssigneeIDs = &as
 		}
+
+		if p.UserGroupIDs != nil {
+			gs := *p.UserGroupIDs
+			if gs, err = search.GetUserGroupsByName(
+				c, p.Workspace, gs); err != nil {
+				return p, err
+			}
+			p.UserGroupIDs = &gs
+		}
 	}
 
 	if cmd.Flags().Changed("no-assignee") {
@@ -98,6 +128,11 @@ func TaskReadFlags(cmd *cobra.Command, f cmdutil.Factory) (p FlagsDTO, err error
 		p.AssigneeIDs = &a
 	}
 
+	if cmd.Flags().Changed("no-group") {
+		g := []string{}
+		p.UserGroupIDs = &g
+	}
+
 	switch {
 	case cmd.Flags().Changed("billable"):
 		b := true
@@ -128,6 +128,7 @@ func NewCmdClone(f cmdutil.Factory) *cobra.Command {
 					return util.ValidateClosingTimeEntry(f)(tec)
 				},
 				util.GetAllowNameForIDsFn(f.Config(), c),
+				util.ResolveCustomFieldsFn(c),
 				util.GetPropsInteractiveFn(dc, f),
 				util.GetDatesInteractiveFn(f),
 				util.GetValidateTimeEntryFn(f),
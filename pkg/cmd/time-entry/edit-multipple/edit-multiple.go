@@ -124,6 +124,8 @@ func NewCmdEditMultiple(f cmdutil.Factory) *cobra.Command {
 					ProjectID:   tei.ProjectID,
 					TaskID:      tei.TaskID,
 					TagIDs:      tei.TagIDs,
+
+					CustomFields: tei.CustomFields,
 				})
 
 				return util.TimeEntryImplToDTO(t), err
@@ -146,6 +148,7 @@ func NewCmdEditMultiple(f cmdutil.Factory) *cobra.Command {
 				return input, err
 			}
 
+			resolveCustomFields := util.ResolveCustomFieldsFn(c)
 			if !f.Config().IsInteractive() {
 				fn = func(input util.TimeEntryDTO) (util.TimeEntryDTO, error) {
 					c := cmd.Flags().Changed
@@ -170,6 +173,16 @@ func NewCmdEditMultiple(f cmdutil.Factory) *cobra.Command {
 							tei.Billable = input.Billable
 						}
 
+						if c("custom-field") {
+							if tei, err = util.Do(
+								tei,
+								util.FillCustomFieldsWithFlags(cmd.Flags()),
+								resolveCustomFields,
+							); err != nil {
+								return tei, err
+							}
+						}
+
 						teis[i] = tei
 						if _, err = editFn(tei); err != nil {
 							return tei, err
@@ -185,6 +198,7 @@ func NewCmdEditMultiple(f cmdutil.Factory) *cobra.Command {
 				tei,
 				util.FillTimeEntryWithFlags(cmd.Flags()),
 				util.GetAllowNameForIDsFn(f.Config(), c),
+				resolveCustomFields,
 				util.GetPropsInteractiveFn(dc, f),
 				util.GetValidateTimeEntryFn(f),
 				fn,
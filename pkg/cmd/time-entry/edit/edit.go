@@ -128,6 +128,7 @@ func NewCmdEdit(
 				te,
 				util.FillTimeEntryWithFlags(cmd.Flags()),
 				util.GetAllowNameForIDsFn(f.Config(), c),
+				util.ResolveCustomFieldsFn(c),
 				util.GetPropsInteractiveFn(dc, f),
 				util.GetDatesInteractiveFn(f),
 				util.GetValidateTimeEntryFn(f),
@@ -145,6 +146,8 @@ func NewCmdEdit(
 				ProjectID:   te.ProjectID,
 				TaskID:      te.TaskID,
 				TagIDs:      te.TagIDs,
+
+				CustomFields: te.CustomFields,
 			}); err != nil {
 				return err
 			}
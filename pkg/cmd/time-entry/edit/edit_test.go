@@ -104,6 +104,10 @@ func TestNewCmdEditWhenChangingProjectOrTask(t *testing.T) {
 			}).
 				Return(&te, nil)
 
+			c.EXPECT().GetWorkspaceCustomFields(
+				api.GetWorkspaceCustomFieldsParam{Workspace: w.ID}).
+				Return([]dto.WorkspaceCustomField{}, nil)
+
 			p := tt.project
 			if p != nil {
 				c.EXPECT().GetProjects(api.GetProjectsParam{
@@ -127,6 +127,7 @@ func NewCmdIn(
 				util.FillTimeEntryWithFlags(cmd.Flags()),
 				util.ValidateClosingTimeEntry(f),
 				util.GetAllowNameForIDsFn(f.Config(), c),
+				util.ResolveCustomFieldsFn(c),
 				util.GetPropsInteractiveFn(dc, f),
 				util.GetDatesInteractiveFn(f),
 				util.GetValidateTimeEntryFn(f),
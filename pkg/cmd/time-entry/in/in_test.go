@@ -2,7 +2,9 @@ package in_test
 
 import (
 	"bytes"
+	"errors"
 	"io"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/in"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 	"github.com/stretchr/testify/assert"
 )
@@ -117,6 +120,10 @@ func TestNewCmdIn_ShouldNotSetBillable_WhenNotAsked(t *testing.T) {
 			}).
 				Return(nil, nil)
 
+			c.EXPECT().GetWorkspaceCustomFields(
+				api.GetWorkspaceCustomFieldsParam{Workspace: w.ID}).
+				Return([]dto.WorkspaceCustomField{}, nil)
+
 			c.EXPECT().Out(api.OutParam{
 				Workspace: w.ID,
 				UserID:    "u",
@@ -155,3 +162,46 @@ func TestNewCmdIn_ShouldNotSetBillable_WhenNotAsked(t *testing.T) {
 	}
 
 }
+
+func TestNewCmdIn_ShouldRecordOffline_WithEmptyJournal(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().GetWorkspaceID().Return(w.ID, nil)
+	f.EXPECT().GetWorkspace().Return(dto.Workspace{},
+		errors.New("workspace w is not available while offline"))
+
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{Offline: true})
+
+	// nothing should be sent to clockify
+	p := filepath.Join(t.TempDir(), "journal.json")
+	f.EXPECT().Client().
+		Return(offline.NewClient(mocks.NewMockClient(t), p, true), nil)
+
+	var te dto.TimeEntryImpl
+	cmd := in.NewCmdIn(f, func(
+		t dto.TimeEntryImpl, _ io.Writer, _ util.OutputFlags) error {
+		te = t
+		return nil
+	})
+
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+
+	cmd.SetArgs([]string{"-s=08:00", "-d=offline", "-q"})
+	if _, err := cmd.ExecuteC(); !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "offline-1", te.ID)
+	assert.Equal(t, "offline", te.Description)
+
+	j, err := offline.Open(p)
+	if assert.NoError(t, err) && assert.Len(t, j.Ops, 1) {
+		assert.Equal(t, offline.OpCreate, j.Ops[0].Type)
+	}
+}
@@ -97,6 +97,7 @@ func NewCmdManual(f cmdutil.Factory) *cobra.Command {
 					return tei, nil
 				},
 				util.GetAllowNameForIDsFn(f.Config(), c),
+				util.ResolveCustomFieldsFn(c),
 				util.GetPropsInteractiveFn(dc, f),
 				util.GetDatesInteractiveFn(f),
 				util.ValidateClosingTimeEntry(f),
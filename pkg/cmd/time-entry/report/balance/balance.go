@@ -0,0 +1,172 @@
+package balance
+
+import (
+	"errors"
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/balance"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out the balance
+type OutputFlags struct {
+	Format string
+	CSV    bool
+	JSON   bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"csv":    of.CSV,
+	})
+}
+
+// Report prints out the balance
+func Report(b balance.Balance, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return balance.BalanceJSONPrint(b, out)
+	case of.CSV:
+		return balance.BalanceCSVPrint(b, out)
+	case of.Format != "":
+		return balance.BalancePrintWithTemplate(of.Format)(b, out)
+	default:
+		return balance.BalancePrint(b, out)
+	}
+}
+
+// NewCmdBalance represents the report balance command
+func NewCmdBalance(
+	f cmdutil.Factory,
+	report func(io.Writer, *OutputFlags, balance.Balance) error,
+) *cobra.Command {
+	of := OutputFlags{}
+	var holidaysFile string
+	cmd := &cobra.Command{
+		Use:   "balance [<start>] [<end>]",
+		Args:  cobra.MaximumNArgs(2),
+		Short: "Compares the hours logged with the hours expected to be worked",
+		Long: heredoc.Docf(`
+			Compares the hours logged with the hours expected to be worked for each day, showing the overtime (positive) or undertime (negative) balance as it accumulates
+
+			If no parameter is set, the balance of the current month until today is shown, if only <start> is set the balance goes until today
+			Aliases today/now can be used for <end> argument to represent current date
+			Alias yesterday can be used for <end> argument to represent previous date
+
+			The expected hours of each day of the week are set with the config %[1]sworkweek-hours.<day>%[1]s, days on %[1]sworkweek-days%[1]s without it are expected to have %[2]v hours.
+
+			Holidays and days off can be listed in a calendar file, set with %[1]sholidays-file%[1]s or the flag --holidays-file, they are not expected to be worked. Each line of the file is a date or range of dates followed by a description, like:
+
+			  # holidays
+			  2022-12-25 Christmas
+			  2023-01-02..2023-01-06 vacations
+		`, "`", cmdutil.DefaultWorkdayHours.Hours()),
+		Example: heredoc.Docf(`
+			$ clockify-cli config set workweek-days monday,tuesday,wednesday,thursday,friday
+			$ clockify-cli config set workweek-hours.friday 6
+			$ %[1]s 2022-12-19 2022-12-25 --holidays-file ~/holidays.txt
+			+------------+-----+----------+----------+------------+----------+-----------+
+			|    DATE    | DAY | EXPECTED |  LOGGED  | DIFFERENCE | BALANCE  |  HOLIDAY  |
+			+------------+-----+----------+----------+------------+----------+-----------+
+			| 2022-12-19 | Mon | 8:00:00  | 9:00:00  | 1:00:00    | 1:00:00  |           |
+			| 2022-12-20 | Tue | 8:00:00  | 7:30:00  | -0:30:00   | 0:30:00  |           |
+			| 2022-12-21 | Wed | 8:00:00  | 8:00:00  | 0:00:00    | 0:30:00  |           |
+			| 2022-12-22 | Thu | 8:00:00  | 8:15:00  | 0:15:00    | 0:45:00  |           |
+			| 2022-12-23 | Fri | 6:00:00  | 5:00:00  | -1:00:00   | -0:15:00 |           |
+			| 2022-12-24 | Sat | 0:00:00  | 0:00:00  | 0:00:00    | -0:15:00 |           |
+			| WEEK       |     | 38:00:00 | 37:45:00 | -0:15:00   | -0:15:00 |           |
+			| 2022-12-25 | Sun | 0:00:00  | 0:00:00  | 0:00:00    | -0:15:00 | Christmas |
+			| WEEK       |     | 0:00:00  | 0:00:00  | 0:00:00    | -0:15:00 |           |
+			| TOTAL      |     | 38:00:00 | 37:45:00 | -0:15:00   | -0:15:00 |           |
+			+------------+-----+----------+----------+------------+----------+-----------+
+
+			# only the final balance
+			$ %[1]s --format '{{ if .Last }}{{ .Balance }}{{ end }}'
+			-PT15M0S
+		`, "clockify-cli report balance"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			start, end, err := util.ParseRange(args)
+			if err != nil {
+				return err
+			}
+
+			if end.Before(start) {
+				return cmdutil.FlagErrorWrap(
+					errors.New("end date must be after the start date"))
+			}
+
+			if !cmd.Flags().Changed("holidays-file") {
+				holidaysFile = f.Config().GetString(cmdutil.CONF_HOLIDAYS_FILE)
+			}
+
+			holidays := timehlp.Holidays{}
+			if holidaysFile != "" {
+				if holidays, err = timehlp.ReadHolidaysFile(
+					holidaysFile); err != nil {
+					return err
+				}
+			}
+
+			expected := f.Config().GetWorkweekHours()
+			if len(expected) == 0 {
+				return errors.New("no workweek days or hours were set")
+			}
+
+			userID, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			workspace, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			log, err := c.LogRange(api.LogRangeParam{
+				Workspace:       workspace,
+				UserID:          userID,
+				FirstDate:       timehlp.TruncateDate(start),
+				LastDate:        timehlp.TruncateDate(end).AddDate(0, 0, 1),
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			b := balance.NewBalance(start, end, log, expected, holidays)
+			if report == nil {
+				return Report(b, cmd.OutOrStdout(), of)
+			}
+
+			return report(cmd.OutOrStdout(), &of, b)
+		},
+	}
+
+	cmd.Flags().StringVar(&holidaysFile, "holidays-file", "",
+		"calendar file with the holidays and days off "+
+			"(defaults to the config holidays-file)")
+	_ = cmd.MarkFlagFilename("holidays-file")
+
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each day")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
+
+	return cmd
+}
@@ -0,0 +1,292 @@
+package balance_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/balance"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	obalance "github.com/lucassabreu/clockify-cli/pkg/output/balance"
+	"github.com/stretchr/testify/assert"
+)
+
+type report func(io.Writer, *balance.OutputFlags, obalance.Balance) error
+
+func TestCmdBalance(t *testing.T) {
+	day := func(d int, h, m int) time.Time {
+		return time.Date(2022, 12, d, h, m, 0, 0, time.Local)
+	}
+
+	te := func(d, h, dur int) dto.TimeEntry {
+		end := day(d, h+dur, 0)
+		return dto.TimeEntry{TimeInterval: dto.NewTimeInterval(
+			day(d, h, 0), &end)}
+	}
+
+	weekdays := map[time.Weekday]time.Duration{
+		time.Monday:    8 * time.Hour,
+		time.Tuesday:   8 * time.Hour,
+		time.Wednesday: 8 * time.Hour,
+		time.Thursday:  8 * time.Hour,
+		time.Friday:    6 * time.Hour,
+	}
+
+	holidays := filepath.Join(t.TempDir(), "holidays.txt")
+	if err := os.WriteFile(holidays, []byte(heredoc.Doc(`
+		# holidays
+		2022-12-23 day before Christmas Eve
+		2022-12-25 Christmas
+	`)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "only one format",
+			args: []string{"--json", "--csv"},
+			err:  "can't be used together",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "invalid date",
+			args: []string{"2022-12-19", "tomorrow"},
+			err:  "cannot parse",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "end before start",
+			args: []string{"2022-12-19", "2022-12-01"},
+			err:  "end date must be after the start date",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "missing holidays file",
+			args: []string{"2022-12-19", "2022-12-25"},
+			err:  "no such file",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				cf := mocks.NewMockConfig(t)
+				f.EXPECT().Config().Return(cf)
+				cf.EXPECT().GetString(cmdutil.CONF_HOLIDAYS_FILE).
+					Return(filepath.Join(t.TempDir(), "missing.txt"))
+				return f, nil
+			},
+		},
+		{
+			name: "no workweek",
+			args: []string{"2022-12-19", "2022-12-25"},
+			err:  "no workweek days or hours were set",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				cf := mocks.NewMockConfig(t)
+				f.EXPECT().Config().Return(cf)
+				cf.EXPECT().GetString(cmdutil.CONF_HOLIDAYS_FILE).Return("")
+				cf.EXPECT().GetWorkweekHours().
+					Return(map[time.Weekday]time.Duration{})
+				return f, nil
+			},
+		},
+		{
+			name: "http error",
+			args: []string{"2022-12-19", "2022-12-25"},
+			err:  "http error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				cf := mocks.NewMockConfig(t)
+				f.EXPECT().Config().Return(cf)
+				cf.EXPECT().GetString(cmdutil.CONF_HOLIDAYS_FILE).Return("")
+				cf.EXPECT().GetWorkweekHours().Return(weekdays)
+
+				f.EXPECT().GetUserID().Return("u", nil)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+				c.EXPECT().LogRange(api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       time.Date(2022, 12, 19, 0, 0, 0, 0, time.UTC),
+					LastDate:        time.Date(2022, 12, 26, 0, 0, 0, 0, time.UTC),
+					PaginationParam: api.AllPages(),
+				}).Return(nil, errors.New("http error"))
+
+				return f, nil
+			},
+		},
+		{
+			name: "balance with holidays",
+			args: []string{"2022-12-19", "2022-12-25",
+				"--holidays-file", holidays},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				cf := mocks.NewMockConfig(t)
+				f.EXPECT().Config().Return(cf)
+				cf.EXPECT().GetWorkweekHours().Return(weekdays)
+
+				f.EXPECT().GetUserID().Return("u", nil)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+				c.EXPECT().LogRange(api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       time.Date(2022, 12, 19, 0, 0, 0, 0, time.UTC),
+					LastDate:        time.Date(2022, 12, 26, 0, 0, 0, 0, time.UTC),
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					te(19, 8, 9),
+					te(20, 8, 4),
+					te(20, 13, 3),
+					te(22, 8, 8),
+					te(24, 10, 1),
+				}, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, _ *balance.OutputFlags, b obalance.Balance,
+				) error {
+					called = true
+					h := func(h int) dto.Duration {
+						return dto.Duration{Duration: time.Duration(h) * time.Hour}
+					}
+
+					assert.Equal(t, day(19, 0, 0), b.Start)
+					assert.Equal(t, day(25, 0, 0), b.End)
+					assert.Equal(t, h(32), b.Expected)
+					assert.Equal(t, h(25), b.Logged)
+					assert.Equal(t, h(-7), b.Balance)
+
+					if !assert.Len(t, b.Weeks, 2) {
+						return nil
+					}
+
+					assert.Equal(t, day(19, 0, 0), b.Weeks[0].Start)
+					assert.Equal(t, h(32), b.Weeks[0].Expected)
+					assert.Equal(t, h(-7), b.Weeks[0].Difference)
+					assert.Equal(t, []obalance.Day{
+						{Date: day(19, 0, 0), Expected: h(8), Logged: h(9),
+							Difference: h(1), Balance: h(1)},
+						{Date: day(20, 0, 0), Expected: h(8), Logged: h(7),
+							Difference: h(-1), Balance: h(0)},
+						{Date: day(21, 0, 0), Expected: h(8), Logged: h(0),
+							Difference: h(-8), Balance: h(-8)},
+						{Date: day(22, 0, 0), Expected: h(8), Logged: h(8),
+							Difference: h(0), Balance: h(-8)},
+						{Date: day(23, 0, 0), Expected: h(0), Logged: h(0),
+							Difference: h(0), Balance: h(-8),
+							Holiday: "day before Christmas Eve"},
+						{Date: day(24, 0, 0), Expected: h(0), Logged: h(1),
+							Difference: h(1), Balance: h(-7)},
+					}, b.Weeks[0].Days)
+
+					assert.Equal(t, day(25, 0, 0), b.Weeks[1].Start)
+					assert.Equal(t, h(-7), b.Weeks[1].Balance)
+					assert.Equal(t, []obalance.Day{
+						{Date: day(25, 0, 0), Expected: h(0), Logged: h(0),
+							Difference: h(0), Balance: h(-7),
+							Holiday: "Christmas"},
+					}, b.Weeks[1].Days)
+
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(
+					io.Writer, *balance.OutputFlags, obalance.Balance) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := balance.NewCmdBalance(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			if assert.Error(t, err) {
+				assert.Regexp(t, tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestCmdBalanceDefaultOutput(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	cf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(cf)
+	cf.EXPECT().GetString(cmdutil.CONF_HOLIDAYS_FILE).Return("")
+	cf.EXPECT().GetWorkweekHours().Return(map[time.Weekday]time.Duration{
+		time.Monday:  8 * time.Hour,
+		time.Tuesday: 7*time.Hour + 30*time.Minute,
+	})
+
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	start := time.Date(2022, 12, 19, 8, 0, 0, 0, time.Local)
+	end := start.Add(8*time.Hour + 30*time.Minute)
+	c.EXPECT().LogRange(api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       time.Date(2022, 12, 19, 0, 0, 0, 0, time.UTC),
+		LastDate:        time.Date(2022, 12, 21, 0, 0, 0, 0, time.UTC),
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.TimeEntry{
+		{TimeInterval: dto.NewTimeInterval(start, &end)},
+	}, nil)
+
+	b := bytes.NewBufferString("")
+	cmd := balance.NewCmdBalance(f, nil)
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"2022-12-19", "2022-12-20"})
+
+	_, err := cmd.ExecuteC()
+	if assert.NoError(t, err) {
+		assert.Equal(t, heredoc.Doc(`
+			+------------+-----+----------+---------+------------+----------+---------+
+			|    DATE    | DAY | EXPECTED | LOGGED  | DIFFERENCE | BALANCE  | HOLIDAY |
+			+------------+-----+----------+---------+------------+----------+---------+
+			| 2022-12-19 | Mon | 8:00:00  | 8:30:00 | 0:30:00    | 0:30:00  |         |
+			| 2022-12-20 | Tue | 7:30:00  | 0:00:00 | -7:30:00   | -7:00:00 |         |
+			| WEEK       |     | 15:30:00 | 8:30:00 | -7:00:00   | -7:00:00 |         |
+			| TOTAL      |     | 15:30:00 | 8:30:00 | -7:00:00   | -7:00:00 |         |
+			+------------+-----+----------+---------+------------+----------+---------+
+		`), b.String())
+	}
+}
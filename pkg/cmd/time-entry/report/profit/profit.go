@@ -0,0 +1,194 @@
+package profit
+
+import (
+	"errors"
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/profit"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out the profitability
+type OutputFlags struct {
+	Format string
+	CSV    bool
+	JSON   bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"csv":    of.CSV,
+	})
+}
+
+// Report prints out the profitability
+func Report(pf profit.Profit, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return profit.ProfitJSONPrint(pf, out)
+	case of.CSV:
+		return profit.ProfitCSVPrint(pf, out)
+	case of.Format != "":
+		return profit.ProfitPrintWithTemplate(of.Format)(pf, out)
+	default:
+		return profit.ProfitPrint(pf, out)
+	}
+}
+
+// NewCmdProfit represents the report profit command
+func NewCmdProfit(
+	f cmdutil.Factory,
+	report func(io.Writer, *OutputFlags, profit.Profit) error,
+) *cobra.Command {
+	of := OutputFlags{}
+	rf := util.ReportFlags{}
+	cmd := &cobra.Command{
+		Use:   "profit [<start>] [<end>]",
+		Args:  cobra.MaximumNArgs(2),
+		Short: "Compares the billable amount with the labour cost by client and project",
+		Long: heredoc.Docf(`
+			Compares the billable amount of the time entries with their labour cost, showing the margin of each client and project
+
+			The billable amount uses the hourly rates and the cost uses the cost rates, both following the same hierarchy as Clockify: the rate of the task has precedence over the rate of the project member, that over the project, that over the workspace member, that over the workspace.
+			Only billable time entries have an amount, but all of them have a cost.
+
+			If no parameter is set, the profit of the current month until today is shown, if only <start> is set the profit goes until today
+			Aliases today/now can be used for <end> argument to represent current date
+			Alias yesterday can be used for <end> argument to represent previous date
+
+			By default only your time entries are used, use %[1]s--all-users%[1]s to see the profit of the whole team.
+		`, "`"),
+		Example: heredoc.Docf(`
+			$ %[1]s 2022-12-01 2022-12-31 --all-users
+			+----------------+-----------------+-----------+-----------+--------------+-------------+-------------+----------+
+			|     CLIENT     |     PROJECT     | DURATION  | BILLABLE  |    AMOUNT    |    COST     |   MARGIN    | MARGIN %% |
+			+----------------+-----------------+-----------+-----------+--------------+-------------+-------------+----------+
+			| Acme           |                 | 120:00:00 | 100:00:00 | 10000.00 USD | 6000.00 USD | 4000.00 USD | 40.00%%   |
+			|                | Support         | 40:00:00  | 20:00:00  | 2000.00 USD  | 2000.00 USD | 0.00 USD    | 0.00%%    |
+			|                | Website         | 80:00:00  | 80:00:00  | 8000.00 USD  | 4000.00 USD | 4000.00 USD | 50.00%%   |
+			| Without client |                 | 10:00:00  | 0:00:00   | 0.00 USD     | 500.00 USD  | -500.00 USD | -100.00%% |
+			|                | Without project | 10:00:00  | 0:00:00   | 0.00 USD     | 500.00 USD  | -500.00 USD | -100.00%% |
+			| TOTAL          |                 | 130:00:00 | 100:00:00 | 10000.00 USD | 6500.00 USD | 3500.00 USD | 35.00%%   |
+			+----------------+-----------------+-----------+-----------+--------------+-------------+-------------+----------+
+
+			# only the projects losing money
+			$ %[1]s --all-users --format '{{ if lt .Margin 0 }}{{ .Name }}{{ "\n" }}{{ end }}'
+			Without project
+		`, "clockify-cli report profit"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if err := cmdutil.XorFlag(map[string]bool{
+				"user":      len(rf.Users) > 0,
+				"all-users": rf.AllUsers,
+			}); err != nil {
+				return err
+			}
+
+			start, end, err := util.ParseRange(args)
+			if err != nil {
+				return err
+			}
+
+			if end.Before(start) {
+				return cmdutil.FlagErrorWrap(
+					errors.New("end date must be after the start date"))
+			}
+
+			var userID string
+			multipleUsers := rf.AllUsers || len(rf.Users) > 0
+			if !multipleUsers {
+				if userID, err = f.GetUserID(); err != nil {
+					return err
+				}
+			}
+
+			workspace, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			first := timehlp.TruncateDate(start)
+			last := timehlp.TruncateDate(end).AddDate(0, 0, 1)
+
+			var log []dto.TimeEntry
+			if multipleUsers {
+				log, err = util.GetUsersTimeEntries(
+					c, workspace, first, last, rf,
+					cmdutil.Concurrency(f.Config()))
+			} else {
+				log, err = c.LogRange(api.LogRangeParam{
+					Workspace:       workspace,
+					UserID:          userID,
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				})
+			}
+
+			if err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspace()
+			if err != nil {
+				return err
+			}
+
+			ps, err := c.GetProjects(api.GetProjectsParam{
+				Workspace:       workspace,
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			rates := timeentryhlp.NewRates(w, ps)
+			log = rates.WithCosts(rates.WithAmounts(log, userID), userID)
+
+			pf, err := profit.NewProfit(start, end, log, ps)
+			if err != nil {
+				return err
+			}
+
+			if report == nil {
+				return Report(pf, cmd.OutOrStdout(), of)
+			}
+
+			return report(cmd.OutOrStdout(), &of, pf)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&rf.Users, "user", []string{},
+		"Will use time entries of this user instead of yours "+
+			"(can be used multiple times, accepts id, name or email)")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "user",
+		cmdcomplutil.NewUserAutoComplete(f))
+	cmd.Flags().BoolVar(&rf.AllUsers, "all-users", false,
+		"Will use time entries of all users of the workspace")
+
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each project")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
+
+	return cmd
+}
@@ -0,0 +1,318 @@
+package profit_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/profit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	oprofit "github.com/lucassabreu/clockify-cli/pkg/output/profit"
+	"github.com/stretchr/testify/assert"
+)
+
+type report func(io.Writer, *profit.OutputFlags, oprofit.Profit) error
+
+func TestCmdProfit(t *testing.T) {
+	start := time.Date(2022, 12, 19, 0, 0, 0, 0, time.Local)
+	ti := func(h, dur int) dto.TimeInterval {
+		s := start.Add(time.Duration(h) * time.Hour)
+		e := s.Add(time.Duration(dur) * time.Hour)
+		return dto.NewTimeInterval(s, &e)
+	}
+
+	rate := func(a int64) *dto.Rate {
+		return &dto.Rate{Amount: a, Currency: "USD"}
+	}
+
+	w := dto.Workspace{
+		ID:         "w",
+		HourlyRate: *rate(5000),
+		CostRate:   rate(2000),
+		Memberships: []dto.Membership{
+			{UserID: "u2", CostRate: rate(3000)},
+		},
+	}
+
+	ps := []dto.Project{
+		{ID: "p1", Name: "Website", ClientID: "c1", ClientName: "Acme",
+			HourlyRate: *rate(10000)},
+		{ID: "p2", Name: "Support", ClientID: "c1", ClientName: "Acme",
+			Memberships: []dto.Membership{
+				{UserID: "u", CostRate: rate(4000)},
+			}},
+		{ID: "p3", Name: "Internal"},
+	}
+
+	logRange := api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       time.Date(2022, 12, 19, 0, 0, 0, 0, time.UTC),
+		LastDate:        time.Date(2022, 12, 24, 0, 0, 0, 0, time.UTC),
+		PaginationParam: api.AllPages(),
+	}
+
+	tts := []struct {
+		name   string
+		args   []string
+		err    string
+		params func(*testing.T) (cmdutil.Factory, report)
+	}{
+		{
+			name: "only one format",
+			args: []string{"--json", "--csv"},
+			err:  "can't be used together",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "only one user flag",
+			args: []string{"--user", "john", "--all-users"},
+			err:  "can't be used together",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "end before start",
+			args: []string{"2022-12-19", "2022-12-01"},
+			err:  "end date must be after the start date",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "http error",
+			args: []string{"2022-12-19", "2022-12-23"},
+			err:  "http error",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetUserID().Return("u", nil)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+				c.EXPECT().LogRange(logRange).
+					Return(nil, errors.New("http error"))
+
+				return f, nil
+			},
+		},
+		{
+			name: "more than one currency",
+			args: []string{"2022-12-19", "2022-12-23"},
+			err:  "more than one currency \\(USD and EUR\\)",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetUserID().Return("u", nil)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+				f.EXPECT().GetWorkspace().Return(w, nil)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+				c.EXPECT().LogRange(logRange).Return([]dto.TimeEntry{
+					{ProjectID: "p1", Billable: true, TimeInterval: ti(8, 1)},
+					{ProjectID: "p4", Billable: true, TimeInterval: ti(9, 1)},
+				}, nil)
+				c.EXPECT().GetProjects(api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(append(ps, dto.Project{
+					ID:         "p4",
+					HourlyRate: dto.Rate{Amount: 100, Currency: "EUR"},
+				}), nil)
+
+				return f, nil
+			},
+		},
+		{
+			name: "profit by client and project",
+			args: []string{"2022-12-19", "2022-12-23"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.EXPECT().GetUserID().Return("u", nil)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+				f.EXPECT().GetWorkspace().Return(w, nil)
+
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+				c.EXPECT().LogRange(logRange).Return([]dto.TimeEntry{
+					{ProjectID: "p1", Billable: true, TimeInterval: ti(8, 2)},
+					{ProjectID: "p2", Billable: true, TimeInterval: ti(10, 1)},
+					{ProjectID: "p2", TimeInterval: ti(11, 1)},
+					{ProjectID: "p3", TimeInterval: ti(13, 2)},
+					{TimeInterval: ti(15, 1)},
+				}, nil)
+				c.EXPECT().GetProjects(api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(ps, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, _ *profit.OutputFlags, pf oprofit.Profit,
+				) error {
+					called = true
+					h := func(h int) dto.Duration {
+						return dto.Duration{Duration: time.Duration(h) * time.Hour}
+					}
+
+					assert.Equal(t, "USD", pf.Currency)
+					assert.Equal(t, oprofit.Totals{
+						Duration:         h(7),
+						BillableDuration: h(3),
+						Amount:           25000,
+						Cost:             18000,
+						Margin:           7000,
+						MarginPercentage: 28,
+					}, pf.Totals)
+
+					assert.Equal(t, []oprofit.Client{
+						{ID: "c1", Name: "Acme", Totals: oprofit.Totals{
+							Duration:         h(4),
+							BillableDuration: h(3),
+							Amount:           25000,
+							Cost:             12000,
+							Margin:           13000,
+							MarginPercentage: 52,
+						}, Projects: []oprofit.Project{
+							{ID: "p2", Name: "Support", Totals: oprofit.Totals{
+								Duration:         h(2),
+								BillableDuration: h(1),
+								Amount:           5000,
+								Cost:             8000,
+								Margin:           -3000,
+								MarginPercentage: -60,
+							}},
+							{ID: "p1", Name: "Website", Totals: oprofit.Totals{
+								Duration:         h(2),
+								BillableDuration: h(2),
+								Amount:           20000,
+								Cost:             4000,
+								Margin:           16000,
+								MarginPercentage: 80,
+							}},
+						}},
+						{ID: "", Name: "Without client", Totals: oprofit.Totals{
+							Duration:         h(3),
+							Cost:             6000,
+							Margin:           -6000,
+							MarginPercentage: -100,
+						}, Projects: []oprofit.Project{
+							{ID: "p3", Name: "Internal", Totals: oprofit.Totals{
+								Duration:         h(2),
+								Cost:             4000,
+								Margin:           -4000,
+								MarginPercentage: -100,
+							}},
+							{ID: "", Name: "Without project", Totals: oprofit.Totals{
+								Duration:         h(1),
+								Cost:             2000,
+								Margin:           -2000,
+								MarginPercentage: -100,
+							}},
+						}},
+					}, pf.Clients)
+
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.params(t)
+			if r == nil {
+				r = func(
+					io.Writer, *profit.OutputFlags, oprofit.Profit) error {
+					t.Error("should not be called")
+					return nil
+				}
+			}
+
+			cmd := profit.NewCmdProfit(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			if assert.Error(t, err) {
+				assert.Regexp(t, tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestCmdProfitDefaultOutput(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetWorkspace().Return(dto.Workspace{
+		ID:         "w",
+		HourlyRate: dto.Rate{Amount: 5000, Currency: "USD"},
+		CostRate:   &dto.Rate{Amount: 2000, Currency: "USD"},
+	}, nil)
+
+	cf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(cf)
+	cf.EXPECT().Get(cmdutil.CONF_CONCURRENCY).Return(nil)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+	c.EXPECT().WorkspaceUsers(api.WorkspaceUsersParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.User{{ID: "u1"}}, nil)
+
+	first := time.Date(2022, 12, 19, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 0, 1)
+	s := time.Date(2022, 12, 19, 8, 0, 0, 0, time.Local)
+	e := s.Add(3 * time.Hour)
+	c.EXPECT().GetUsersHydratedTimeEntries(api.GetUserTimeEntriesParam{
+		Workspace:       "w",
+		UserID:          "u1",
+		Start:           &first,
+		End:             &last,
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.TimeEntry{
+		{ProjectID: "p1", Billable: true,
+			User:         &dto.User{ID: "u1"},
+			TimeInterval: dto.NewTimeInterval(s, &e)},
+	}, nil)
+	c.EXPECT().GetProjects(api.GetProjectsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Project{
+		{ID: "p1", Name: "Website", ClientID: "c1", ClientName: "Acme"},
+	}, nil)
+
+	b := bytes.NewBufferString("")
+	cmd := profit.NewCmdProfit(f, nil)
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"2022-12-19", "2022-12-19", "--all-users"})
+
+	_, err := cmd.ExecuteC()
+	if assert.NoError(t, err) {
+		assert.Equal(t, heredoc.Doc(`
+			+--------+---------+----------+----------+------------+-----------+-----------+----------+
+			| CLIENT | PROJECT | DURATION | BILLABLE |   AMOUNT   |   COST    |  MARGIN   | MARGIN % |
+			+--------+---------+----------+----------+------------+-----------+-----------+----------+
+			| Acme   |         | 3:00:00  | 3:00:00  | 150.00 USD | 60.00 USD | 90.00 USD | 60.00%   |
+			|        | Website | 3:00:00  | 3:00:00  | 150.00 USD | 60.00 USD | 90.00 USD | 60.00%   |
+			| TOTAL  |         | 3:00:00  | 3:00:00  | 150.00 USD | 60.00 USD | 90.00 USD | 60.00%   |
+			+--------+---------+----------+----------+------------+-----------+-----------+----------+
+		`), b.String())
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/balance"
 	lastday "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-day"
 	lastmonth "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-month"
 	lastweek "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-week"
 	lastweekday "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-week-day"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/profit"
 	thismonth "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/this-month"
 	thisweek "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/this-week"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/today"
@@ -116,6 +118,50 @@ func NewCmdReport(f cmdutil.Factory) *cobra.Command {
 			id,description,project.id,project.name,task.id,task.name,start,end,duration,user.id,user.email,user.name,tags...
 			62b87a9785815e619d7ce02e,Example for today,621948458cb9606d934ebb1c,Clockify Cli,62b87a7e984dba2c0669724d,Report Command,2022-06-26 12:25:56,2022-06-26 12:26:47,0:00:51,5c6bf21db079873a55facc08,joe@due.com,John Due,Development (62ae28b72518aa18da2acb49)
 			62b87abb85815e619d7ce034,Example for today (second one),621948458cb9606d934ebb1c,Clockify Cli,62b87a7e984dba2c0669724d,Report Command,2022-06-26 12:26:47,2022-06-26 13:00:00,0:33:13,5c6bf21db079873a55facc08,joe@due.com,John Due,Development (62ae28b72518aa18da2acb49)
+
+			# time entries of other members of the workspace
+			$ %[1]s --user joana@example.com --user "John Due" --quiet
+			62b87a9785815e619d7ce02e
+			62b8ce1edba0da0f21e7e688
+
+			# time entries of everyone on the workspace
+			$ %[1]s --all-users --format '{{ .User.Name }} - {{ .Description }}'
+			John Due - Example for today
+			Joana - Planning
+
+			# summary of the time entries by project and task
+			$ %[1]s 2022-06-20 2022-06-26 --group-by project,task
+			+--------------+----------------+----------+------------+---------+
+			|   PROJECT    |      TASK      | DURATION | PERCENTAGE | ENTRIES |
+			+--------------+----------------+----------+------------+---------+
+			| Clockify Cli |                | 3:34:04  | 100.00%%    |       4 |
+			|              | Report Command | 0:34:04  | 15.91%%     |       2 |
+			|              | Without task   | 3:00:00  | 84.09%%     |       2 |
+			| TOTAL        |                | 3:34:04  | 100.00%%    |       4 |
+			+--------------+----------------+----------+------------+---------+
+
+			# hours by day as csv
+			$ %[1]s 2022-06-24 2022-06-26 --group-by day --csv
+			day.id,day.name,duration,percentage,count
+			2022-06-24,2022-06-24,1:00:00,28.03,1
+			2022-06-25,2022-06-25,1:00:00,28.03,1
+			2022-06-26,2022-06-26,1:34:04,43.94,2
+
+			# timesheet of the week, non-working days are hidden if nothing was tracked on them
+			$ %[1]s 2022-06-19 2022-06-25 --timesheet
+			+--------------+-----------+-----------+-----------+-----------+-----------+-----------+---------+
+			|   Project    | Mon 06/20 | Tue 06/21 | Wed 06/22 | Thu 06/23 | Fri 06/24 | Sat 06/25 |  Total  |
+			+--------------+-----------+-----------+-----------+-----------+-----------+-----------+---------+
+			| Clockify Cli |           |           |           |           |   1:00:00 |   1:00:00 | 2:00:00 |
+			| TOTAL        |   0:00:00 |   0:00:00 |   0:00:00 |   0:00:00 |   1:00:00 |   1:00:00 | 2:00:00 |
+			+--------------+-----------+-----------+-----------+-----------+-----------+-----------+---------+
+
+			# billable amounts using the rates of the workspace, members, projects and tasks
+			# (--with-cost does the same with the cost rates, see "report profit" for the margins)
+			$ %[1]s 2022-06-24 2022-06-25 --with-amounts --csv
+			id,description,project.id,project.name,task.id,task.name,start,end,duration,user.id,user.email,user.name,billable,hourlyRate,amount,currency,tags...
+			62b8ce7185815e619d7d0a82,Example for before yesterday,621948458cb9606d934ebb1c,Clockify Cli,,,2022-06-24 08:00:00,2022-06-24 09:00:00,1:00:00,5c6bf21db079873a55facc08,joe@due.com,John Due,true,50.00,50.00,USD,Development (62ae28b72518aa18da2acb49)
+			62b8ce1edba0da0f21e7e688,Example for yesterday,621948458cb9606d934ebb1c,Clockify Cli,,,2022-06-25 08:00:00,2022-06-25 09:00:00,1:00:00,5c6bf21db079873a55facc08,joe@due.com,John Due,false,50.00,0.00,USD,Development (62ae28b72518aa18da2acb49)
 		`, "clockify-cli report", "`"),
 		Args:    cobra.MaximumNArgs(2),
 		Aliases: []string{"log"},
@@ -159,6 +205,8 @@ func NewCmdReport(f cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(lastweekday.NewCmdLastWeekDay(f))
 	cmd.AddCommand(today.NewCmdToday(f))
 	cmd.AddCommand(yesterday.NewCmdYesterday(f))
+	cmd.AddCommand(balance.NewCmdBalance(f, nil))
+	cmd.AddCommand(profit.NewCmdProfit(f, nil))
 
 	util.AddReportFlags(f, cmd, &of)
 	_ = cmd.MarkFlagRequired("workspace")
@@ -1,8 +1,10 @@
 package util
 
 import (
+	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/api"
@@ -11,9 +13,14 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/summary"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
 	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -30,9 +37,17 @@ type ReportFlags struct {
 	Billable    bool
 	NotBillable bool
 
-	Description string
-	Project     string
-	TagIDs      []string
+	Description  string
+	Project      string
+	TagIDs       []string
+	CustomFields []string
+
+	Users    []string
+	Groups   []string
+	AllUsers bool
+
+	GroupBy   []string
+	Timesheet bool
 }
 
 // Check will assure that there is no conflicting flag values
@@ -41,10 +56,110 @@ func (rf ReportFlags) Check() error {
 		return err
 	}
 
-	return cmdutil.XorFlag(map[string]bool{
+	if err := cmdutil.XorFlag(map[string]bool{
+		"user":      len(rf.Users) > 0,
+		"all-users": rf.AllUsers,
+	}); err != nil {
+		return err
+	}
+
+	if err := cmdutil.XorFlag(map[string]bool{
+		"group":     len(rf.Groups) > 0,
+		"all-users": rf.AllUsers,
+	}); err != nil {
+		return err
+	}
+
+	if err := cmdutil.XorFlag(map[string]bool{
 		"billable":     rf.Billable,
 		"not-billable": rf.NotBillable,
-	})
+	}); err != nil {
+		return err
+	}
+
+	if rf.Timesheet {
+		if err := cmdutil.XorFlag(map[string]bool{
+			"timesheet":          true,
+			"format":             rf.Format != "",
+			"json":               rf.JSON,
+			"quiet":              rf.Quiet,
+			"md":                 rf.Markdown,
+			"duration-float":     rf.DurationFloat,
+			"duration-formatted": rf.DurationFormatted,
+			"group-by":           len(rf.GroupBy) > 0,
+			"fill-missing-dates": rf.FillMissingDates,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for n, set := range map[string]bool{
+		"with-amounts": rf.WithAmounts,
+		"with-cost":    rf.WithCosts,
+	} {
+		if !set {
+			continue
+		}
+
+		if err := cmdutil.XorFlag(map[string]bool{
+			n:                    true,
+			"quiet":              rf.Quiet,
+			"duration-float":     rf.DurationFloat,
+			"duration-formatted": rf.DurationFormatted,
+			"group-by":           len(rf.GroupBy) > 0,
+			"timesheet":          rf.Timesheet,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, cf := range rf.CustomFields {
+		if strings.Index(cf, "=") < 1 {
+			return cmdutil.FlagErrorWrap(fmt.Errorf(
+				`custom field "%s" must be in the format name=value`, cf))
+		}
+	}
+
+	if len(rf.GroupBy) == 0 {
+		return nil
+	}
+
+	if err := cmdutil.XorFlag(map[string]bool{
+		"group-by":           true,
+		"quiet":              rf.Quiet,
+		"fill-missing-dates": rf.FillMissingDates,
+		"duration-float":     rf.DurationFloat,
+		"duration-formatted": rf.DurationFormatted,
+	}); err != nil {
+		return err
+	}
+
+	used := make(map[string]bool, len(rf.GroupBy))
+	for _, by := range rf.GroupBy {
+		by = strings.ToLower(strings.TrimSpace(by))
+		if used[by] {
+			return cmdutil.FlagErrorWrap(
+				fmt.Errorf("can't group by %s more than once", by))
+		}
+		used[by] = true
+
+		if !strhlp.InSlice(by, groupByOptions()) {
+			return cmdutil.FlagErrorWrap(fmt.Errorf(
+				"can't group by %s, use one of: %s",
+				by, strings.Join(groupByOptions(), ", ")))
+		}
+	}
+
+	return nil
+}
+
+func groupByOptions() []string {
+	o := make([]string, len(summary.GroupByOptions))
+	for i := range summary.GroupByOptions {
+		o[i] = string(summary.GroupByOptions[i])
+	}
+
+	return o
 }
 
 // NewReportFlags helps creating a util.ReportFlags for report commands
@@ -74,10 +189,46 @@ func AddReportFlags(
 	_ = cmdcompl.AddSuggestionsToFlag(cmd, "tag",
 		cmdcomplutil.NewTagAutoComplete(f))
 
+	cmd.Flags().StringArrayVar(&rf.CustomFields, "custom-field", []string{},
+		"Will filter time entries with this value on a custom field, "+
+			"as name=value (can be used multiple times)")
+
 	cmd.Flags().BoolVar(&rf.Billable, "billable", false,
 		"Will filter time entries that are billable")
 	cmd.Flags().BoolVar(&rf.NotBillable, "not-billable", false,
 		"Will filter time entries that are not billable")
+
+	cmd.Flags().StringSliceVar(&rf.Users, "user", []string{},
+		"Will report time entries of this user instead of yours "+
+			"(can be used multiple times, accepts id, name or email)")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "user",
+		cmdcomplutil.NewUserAutoComplete(f))
+	cmd.Flags().StringSliceVar(&rf.Groups, "group", []string{},
+		"Will report time entries of every member of this user group "+
+			"(can be used multiple times, accepts id or name)")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "group",
+		cmdcomplutil.NewUserGroupAutoComplete(f))
+	cmd.Flags().BoolVar(&rf.AllUsers, "all-users", false,
+		"Will report time entries of all users of the workspace")
+
+	cmd.Flags().StringSliceVar(&rf.GroupBy, "group-by", []string{},
+		"Will print a summary of the time entries grouped by these fields, "+
+			"can be nested like project,task")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "group-by",
+		cmdcompl.ValidArgsSlide(groupByOptions()))
+
+	cmd.Flags().BoolVar(&rf.Timesheet, "timesheet", false,
+		"Will print the durations of the projects by day of the week, "+
+			"can be used with --csv")
+
+	cmd.Flags().BoolVar(&rf.WithAmounts, "with-amounts", false,
+		"Will show the billable amount of each time entry and the totals "+
+			"by currency, using the rates of the workspace, projects, "+
+			"members and tasks")
+	cmd.Flags().BoolVar(&rf.WithCosts, "with-cost", false,
+		"Will show the labour cost of each time entry and the totals "+
+			"by currency, using the cost rates of the workspace, projects, "+
+			"members and tasks")
 }
 
 // ReportWithRange fetches and prints out time entries
@@ -85,9 +236,13 @@ func ReportWithRange(
 	f cmdutil.Factory, start, end time.Time,
 	out io.Writer, rf ReportFlags,
 ) error {
-	userId, err := f.GetUserID()
-	if err != nil {
-		return err
+	var err error
+	var userId string
+	multipleUsers := rf.AllUsers || len(rf.Users) > 0 || len(rf.Groups) > 0
+	if !multipleUsers {
+		if userId, err = f.GetUserID(); err != nil {
+			return err
+		}
 	}
 
 	workspace, err := f.GetWorkspaceID()
@@ -116,16 +271,23 @@ func ReportWithRange(
 
 	start = timehlp.TruncateDate(start)
 	end = timehlp.TruncateDate(end).Add(time.Hour * 24)
-	log, err := c.LogRange(api.LogRangeParam{
-		Workspace:       workspace,
-		UserID:          userId,
-		FirstDate:       start,
-		LastDate:        end,
-		Description:     rf.Description,
-		ProjectID:       rf.Project,
-		TagIDs:          rf.TagIDs,
-		PaginationParam: api.AllPages(),
-	})
+
+	var log []dto.TimeEntry
+	if multipleUsers {
+		log, err = GetUsersTimeEntries(
+			c, workspace, start, end, rf, cmdutil.Concurrency(f.Config()))
+	} else {
+		log, err = c.LogRange(api.LogRangeParam{
+			Workspace:       workspace,
+			UserID:          userId,
+			FirstDate:       start,
+			LastDate:        end,
+			Description:     rf.Description,
+			ProjectID:       rf.Project,
+			TagIDs:          rf.TagIDs,
+			PaginationParam: api.AllPages(),
+		})
+	}
 
 	if err != nil {
 		return err
@@ -135,7 +297,21 @@ func ReportWithRange(
 		log = filterBilling(log, rf.Billable)
 	}
 
-	sort.Slice(log, func(i, j int) bool {
+	if len(rf.CustomFields) > 0 {
+		if log, err = filterCustomFields(
+			c, workspace, log, rf.CustomFields); err != nil {
+			return err
+		}
+	}
+
+	if rf.WithAmounts || rf.WithCosts {
+		if log, err = withRates(
+			f, c, workspace, userId, log, rf); err != nil {
+			return err
+		}
+	}
+
+	sort.SliceStable(log, func(i, j int) bool {
 		return log[j].TimeInterval.Start.After(
 			log[i].TimeInterval.Start,
 		)
@@ -158,10 +334,219 @@ func ReportWithRange(
 		log = append(log, fillMissing(nextDay, end)...)
 	}
 
+	if len(rf.GroupBy) > 0 {
+		return printSummary(log, out, rf)
+	}
+
+	if rf.Timesheet {
+		return printTimesheet(log, start, end, out, f.Config(), rf)
+	}
+
+	rf.OutputFlags.ShowUser = multipleUsers
 	return util.PrintTimeEntries(
 		log, out, f.Config(), rf.OutputFlags)
 }
 
+// GetUsersTimeEntries fetches the time entries of the users and members of
+// the user groups informed, or of all users of the workspace, fetching at
+// most concurrency users at the same time
+func GetUsersTimeEntries(
+	c api.Client, workspace string, start, end time.Time, rf ReportFlags,
+	concurrency int,
+) ([]dto.TimeEntry, error) {
+	var err error
+	users := rf.Users
+	if rf.AllUsers {
+		us, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
+			Workspace:       workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		users = make([]string, len(us))
+		for i := range us {
+			users[i] = us[i].ID
+		}
+	} else {
+		if users, err = search.GetUsersByName(
+			c, workspace, strhlp.Unique(users)); err != nil {
+			return nil, err
+		}
+
+		members, err := getGroupsMembers(c, workspace, rf.Groups)
+		if err != nil {
+			return nil, err
+		}
+
+		users = strhlp.Unique(append(users, members...))
+	}
+
+	logs := make([][]dto.TimeEntry, len(users))
+	var g errgroup.Group
+	g.SetLimit(concurrency)
+	for i := range users {
+		j := i
+		g.Go(func() error {
+			var err error
+			logs[j], err = c.GetUsersHydratedTimeEntries(
+				api.GetUserTimeEntriesParam{
+					Workspace:       workspace,
+					UserID:          users[j],
+					Start:           &start,
+					End:             &end,
+					Description:     rf.Description,
+					ProjectID:       rf.Project,
+					TagIDs:          rf.TagIDs,
+					PaginationParam: api.AllPages(),
+				})
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	log := make([]dto.TimeEntry, 0)
+	for i := range logs {
+		log = append(log, logs[i]...)
+	}
+
+	return log, nil
+}
+
+// getGroupsMembers returns the ids of the users on the user groups
+func getGroupsMembers(
+	c api.Client, workspace string, groups []string) ([]string, error) {
+	if len(groups) == 0 {
+		return []string{}, nil
+	}
+
+	ids, err := search.GetUserGroupsByName(
+		c, workspace, strhlp.Unique(groups))
+	if err != nil {
+		return nil, err
+	}
+
+	gs, err := c.GetUserGroups(api.GetUserGroupsParam{
+		Workspace:       workspace,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]string, 0)
+	for _, g := range gs {
+		if strhlp.InSlice(g.ID, ids) {
+			members = append(members, g.UserIDs...)
+		}
+	}
+
+	return members, nil
+}
+
+// withRates sets the billable amount and/or the labour cost of the time
+// entries using the rates of the workspace and its projects
+func withRates(
+	f cmdutil.Factory, c api.Client, workspace, userID string,
+	log []dto.TimeEntry, rf ReportFlags,
+) ([]dto.TimeEntry, error) {
+	w, err := f.GetWorkspace()
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := c.GetProjects(api.GetProjectsParam{
+		Workspace:       workspace,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rates := timeentryhlp.NewRates(w, ps)
+	if rf.WithAmounts {
+		log = rates.WithAmounts(log, userID)
+	}
+
+	if rf.WithCosts {
+		log = rates.WithCosts(log, userID)
+	}
+
+	return log, nil
+}
+
+// printSummary prints the time entries grouped by the fields on --group-by
+func printSummary(log []dto.TimeEntry, out io.Writer, rf ReportFlags) error {
+	by := make([]summary.GroupBy, len(rf.GroupBy))
+	for i := range rf.GroupBy {
+		by[i] = summary.GroupBy(
+			strings.ToLower(strings.TrimSpace(rf.GroupBy[i])))
+	}
+
+	s := summary.NewSummary(log, by)
+	switch {
+	case rf.Markdown:
+		return summary.SummaryMarkdownPrint(s, out)
+	case rf.JSON:
+		return summary.SummaryJSONPrint(s, out)
+	case rf.CSV:
+		return summary.SummaryCSVPrint(s, out)
+	case rf.Format != "":
+		return summary.SummaryPrintWithTemplate(rf.Format)(s, out)
+	default:
+		return summary.SummaryPrint(s, out)
+	}
+}
+
+// printTimesheet prints the time entries as a grid of projects by days of the
+// week
+func printTimesheet(
+	log []dto.TimeEntry, start, end time.Time,
+	out io.Writer, config cmdutil.Config, rf ReportFlags,
+) error {
+	opts := []output.TimeEntryOutputOpt{
+		output.WithWorkweekDays(config.GetWorkWeekdays())}
+
+	if config.GetBool(cmdutil.CONF_SHOW_TASKS) {
+		opts = append(opts, output.WithShowTasks())
+	}
+
+	if rf.CSV {
+		return output.TimesheetCSVPrint(start, end, opts...)(log, out)
+	}
+
+	return output.TimesheetPrint(start, end, opts...)(log, out)
+}
+
+// ParseRange reads the start and end dates from the args, defaulting to the
+// current month until today
+func ParseRange(args []string) (start, end time.Time, err error) {
+	end = timehlp.Today()
+	start, _ = timehlp.GetMonthRange(end)
+	if len(args) > 0 {
+		if start, err = time.ParseInLocation(
+			"2006-01-02", args[0], time.Local); err != nil {
+			return
+		}
+	}
+
+	if len(args) > 1 {
+		switch args[1] {
+		case "now", "today":
+		case "yesterday":
+			end = end.AddDate(0, 0, -1)
+		default:
+			end, err = time.ParseInLocation("2006-01-02", args[1], time.Local)
+		}
+	}
+
+	return
+}
+
 func filterBilling(l []dto.TimeEntry, billable bool) []dto.TimeEntry {
 	r := make([]dto.TimeEntry, 0, len(l))
 	for i := 0; i < len(l); i++ {
@@ -173,6 +558,81 @@ func filterBilling(l []dto.TimeEntry, billable bool) []dto.TimeEntry {
 	return r
 }
 
+// filterCustomFields keeps only the time entries with all the custom fields
+// (name=value) informed, values are compared ignoring case, a empty value
+// matches time entries without the custom field
+func filterCustomFields(
+	c api.Client, workspace string, l []dto.TimeEntry, filters []string,
+) ([]dto.TimeEntry, error) {
+	cfs, err := c.GetWorkspaceCustomFields(api.GetWorkspaceCustomFieldsParam{
+		Workspace: workspace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(filters))
+	values := make([]string, len(filters))
+	for i, f := range filters {
+		n := strings.TrimSpace(f[:strings.Index(f, "=")])
+		values[i] = strings.TrimSpace(f[strings.Index(f, "=")+1:])
+
+		for _, cf := range cfs {
+			if cf.ID == n || strings.EqualFold(cf.Name, n) {
+				ids[i] = cf.ID
+				break
+			}
+		}
+
+		if ids[i] == "" {
+			return nil, fmt.Errorf(`custom field "%s" not found`, n)
+		}
+	}
+
+	r := make([]dto.TimeEntry, 0, len(l))
+	for _, te := range l {
+		match := true
+		for i := range ids {
+			match = match &&
+				hasCustomFieldValue(te.CustomFields, ids[i], values[i])
+		}
+
+		if match {
+			r = append(r, te)
+		}
+	}
+
+	return r, nil
+}
+
+func hasCustomFieldValue(
+	vs []dto.CustomFieldValue, id, value string) bool {
+	for _, v := range vs {
+		if v.CustomFieldID != id {
+			continue
+		}
+
+		switch l := v.Value.(type) {
+		case []string:
+			for _, i := range l {
+				if strings.EqualFold(i, value) {
+					return true
+				}
+			}
+		case []interface{}:
+			for _, i := range l {
+				if strings.EqualFold(fmt.Sprint(i), value) {
+					return true
+				}
+			}
+		}
+
+		return strings.EqualFold(v.String(), value)
+	}
+
+	return value == ""
+}
+
 func fillMissing(first, last time.Time) []dto.TimeEntry {
 	first = timehlp.TruncateDate(first)
 	last = timehlp.TruncateDate(last)
@@ -26,4 +26,102 @@ func TestReportFlagsChecks(t *testing.T) {
 	rf.NotBillable = false
 
 	assert.NoError(t, rf.Check())
+
+	rf.Users = []string{"john"}
+	rf.AllUsers = true
+
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t,
+		"can't be used together.*all-users.*user", err.Error())
+
+	rf.AllUsers = false
+	assert.NoError(t, rf.Check())
+
+	rf.Groups = []string{"devs"}
+	assert.NoError(t, rf.Check())
+
+	rf.AllUsers = true
+	rf.Users = []string{}
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t,
+		"can't be used together.*all-users.*group", err.Error())
+
+	rf.AllUsers = false
+	rf.Groups = []string{}
+	assert.NoError(t, rf.Check())
+
+	rf.GroupBy = []string{"project", "week"}
+	assert.NoError(t, rf.Check())
+
+	rf.GroupBy = []string{"project", "month"}
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "can't group by month", err.Error())
+
+	rf.GroupBy = []string{"project", "Project"}
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "can't group by project more than once", err.Error())
+
+	rf.GroupBy = []string{"project"}
+	rf.Quiet = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "can't be used together.*group-by.*quiet", err.Error())
+
+	rf.Quiet = false
+	rf.DurationFloat = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t,
+		"can't be used together.*duration-float.*group-by", err.Error())
+
+	rf.DurationFloat = false
+	rf.DurationFormatted = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t,
+		"can't be used together.*duration-formatted.*group-by", err.Error())
+
+	rf.DurationFormatted = false
+	rf.Timesheet = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "can't be used together.*group-by.*timesheet", err.Error())
+
+	rf.GroupBy = []string{}
+	rf.CSV = true
+	assert.NoError(t, rf.Check())
+
+	rf.Timesheet = false
+	rf.WithAmounts = true
+	assert.NoError(t, rf.Check())
+
+	rf.DurationFloat = true
+	rf.CSV = false
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t,
+		"can't be used together.*duration-float.*with-amounts", err.Error())
+
+	rf.WithAmounts = false
+	rf.WithCosts = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t,
+		"can't be used together.*duration-float.*with-cost", err.Error())
+
+	rf.DurationFloat = false
+	rf.WithAmounts = true
+	assert.NoError(t, rf.Check())
+
+	rf.CustomFields = []string{"ticket"}
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, `custom field "ticket" must be .*name=value`, err.Error())
+
+	rf.CustomFields = []string{"ticket=", "priority=high"}
+	assert.NoError(t, rf.Check())
 }
@@ -2,7 +2,9 @@ package util_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,7 +14,10 @@ import (
 	"github.com/lucassabreu/clockify-cli/internal/mocks"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func newDate(s string) time.Time {
@@ -297,6 +302,93 @@ func TestReportWithRange(t *testing.T) {
 				time-entry-3
 			`),
 		},
+		{
+			name: "custom fields",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "time-entry-1", CustomFields: []dto.CustomFieldValue{
+						{CustomFieldID: "cf1", Value: "TK-1"},
+						{CustomFieldID: "cf2",
+							Value: []interface{}{"bug", "urgent"}},
+					}},
+					{ID: "time-entry-2", CustomFields: []dto.CustomFieldValue{
+						{CustomFieldID: "cf1", Value: "TK-2"},
+						{CustomFieldID: "cf2", Value: []interface{}{"urgent"}},
+					}},
+					{ID: "time-entry-3", CustomFields: []dto.CustomFieldValue{
+						{CustomFieldID: "cf1", Value: "tk-1"},
+					}},
+				}, nil)
+
+				c.On("GetWorkspaceCustomFields",
+					api.GetWorkspaceCustomFieldsParam{Workspace: "w"}).
+					Return([]dto.WorkspaceCustomField{
+						{ID: "cf1", Name: "Ticket"},
+						{ID: "cf2", Name: "Labels"},
+					}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CustomFields = []string{"ticket=TK-1", "cf2=Urgent"}
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				time-entry-1
+			`),
+		},
+		{
+			name: "custom field not found",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{{ID: "time-entry-1"}}, nil)
+
+				c.On("GetWorkspaceCustomFields",
+					api.GetWorkspaceCustomFieldsParam{Workspace: "w"}).
+					Return([]dto.WorkspaceCustomField{
+						{ID: "cf1", Name: "Ticket"},
+					}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CustomFields = []string{"sprint=1"}
+				rf.Quiet = true
+				return rf
+			},
+			err: `custom field "sprint" not found`,
+		},
 		{
 			name: "not billable only",
 			factory: func(t *testing.T) cmdutil.Factory {
@@ -382,6 +474,616 @@ func TestReportWithRange(t *testing.T) {
 				te-4
 			`),
 		},
+		{
+			name: "all users",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.User{{ID: "u1"}, {ID: "u2"}}, nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("Get", cmdutil.CONF_CONCURRENCY).Return(2)
+				cf.On("GetInt", cmdutil.CONF_CONCURRENCY).Return(2)
+
+				u1 := dto.User{ID: "u1", Name: "John"}
+				c.On("GetUsersHydratedTimeEntries",
+					api.GetUserTimeEntriesParam{
+						Workspace:       "w",
+						UserID:          "u1",
+						Start:           &first,
+						End:             &last,
+						PaginationParam: api.AllPages(),
+					}).Return([]dto.TimeEntry{
+					{ID: "te-1", User: &u1, TimeInterval: dto.TimeInterval{
+						Start: first.Add(time.Hour)}},
+					{ID: "te-3", User: &u1, TimeInterval: dto.TimeInterval{
+						Start: first.Add(3 * time.Hour)}},
+				}, nil)
+
+				u2 := dto.User{ID: "u2", Name: "Joana"}
+				c.On("GetUsersHydratedTimeEntries",
+					api.GetUserTimeEntriesParam{
+						Workspace:       "w",
+						UserID:          "u2",
+						Start:           &first,
+						End:             &last,
+						PaginationParam: api.AllPages(),
+					}).Return([]dto.TimeEntry{
+					{ID: "te-2", User: &u2, TimeInterval: dto.TimeInterval{
+						Start: first.Add(2 * time.Hour)}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.AllUsers = true
+				rf.Format = "{{ .ID }} {{ .User.Name }}"
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-1 John
+				te-2 Joana
+				te-3 John
+			`),
+		},
+		{
+			name: "users by name and email",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+				cf.On("Get", cmdutil.CONF_CONCURRENCY).Return(nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("GetProjects", api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{{ID: "p1", Name: "Clockify"}}, nil)
+
+				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.User{
+					{ID: "u1", Name: "John", Email: "john@example.com"},
+					{ID: "u2", Name: "Joana", Email: "joana@example.com"},
+					{ID: "u3", Name: "Other"},
+				}, nil)
+
+				u1 := dto.User{ID: "u1", Name: "John"}
+				c.On("GetUsersHydratedTimeEntries",
+					api.GetUserTimeEntriesParam{
+						Workspace:       "w",
+						UserID:          "u1",
+						Start:           &first,
+						End:             &last,
+						ProjectID:       "p1",
+						PaginationParam: api.AllPages(),
+					}).Return([]dto.TimeEntry{
+					{ID: "te-1", User: &u1, TimeInterval: dto.NewTimeInterval(
+						first.Add(time.Hour), &last)},
+				}, nil)
+
+				c.On("GetUsersHydratedTimeEntries",
+					api.GetUserTimeEntriesParam{
+						Workspace:       "w",
+						UserID:          "u2",
+						Start:           &first,
+						End:             &last,
+						ProjectID:       "p1",
+						PaginationParam: api.AllPages(),
+					}).Return([]dto.TimeEntry{}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Users = []string{"joana@example.com", "john"}
+				rf.Project = "clockify"
+				return rf
+			},
+			expected: heredoc.Docf(`
+				+------+------+---------------------+---------------------+----------+---------+-------------+------+
+				|  ID  | USER |        START        |         END         |   DUR    | PROJECT | DESCRIPTION | TAGS |
+				+------+------+---------------------+---------------------+----------+---------+-------------+------+
+				| te-1 | John | %s | %s | 71:00:00 |         |             |      |
+				+------+------+---------------------+---------------------+----------+---------+-------------+------+
+			`,
+				first.Add(time.Hour).In(time.Local).Format(timehlp.FullTimeFormat),
+				last.In(time.Local).Format(timehlp.FullTimeFormat),
+			),
+		},
+		{
+			name: "users and members of user groups",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("Get", cmdutil.CONF_CONCURRENCY).Return(2)
+				cf.On("GetInt", cmdutil.CONF_CONCURRENCY).Return(2)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.User{
+					{ID: "u1", Name: "John"},
+					{ID: "u2", Name: "Joana"},
+					{ID: "u3", Name: "Other"},
+				}, nil)
+
+				c.On("GetUserGroups", api.GetUserGroupsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.UserGroup{
+					{ID: "g1", Name: "Developers", UserIDs: []string{"u1", "u2"}},
+					{ID: "g2", Name: "Admins", UserIDs: []string{"u3"}},
+				}, nil)
+
+				for _, u := range []dto.User{
+					{ID: "u1", Name: "John"},
+					{ID: "u2", Name: "Joana"},
+				} {
+					u := u
+					c.On("GetUsersHydratedTimeEntries",
+						api.GetUserTimeEntriesParam{
+							Workspace:       "w",
+							UserID:          u.ID,
+							Start:           &first,
+							End:             &last,
+							PaginationParam: api.AllPages(),
+						}).Return([]dto.TimeEntry{
+						{ID: "te-" + u.ID, User: &u,
+							TimeInterval: dto.TimeInterval{Start: first}},
+					}, nil)
+				}
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Users = []string{"john"}
+				rf.Groups = []string{"dev"}
+				rf.Format = "{{ .ID }} {{ .User.Name }}"
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-u1 John
+				te-u2 Joana
+			`),
+		},
+		{
+			name: "group by project and task",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				p1 := &dto.Project{ID: "p1", Name: "Clockify"}
+				p2 := &dto.Project{ID: "p2", Name: "Another"}
+				t1 := &dto.Task{ID: "t1", Name: "Report"}
+				t2 := &dto.Task{ID: "t2", Name: "Docs"}
+				ti := func(s, e time.Duration) dto.TimeInterval {
+					end := first.Add(e)
+					return dto.NewTimeInterval(first.Add(s), &end)
+				}
+
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Project: p1, Task: t1,
+						TimeInterval: ti(0, time.Hour)},
+					{ID: "te-2", Project: p2,
+						TimeInterval: ti(time.Hour, 2*time.Hour)},
+					{ID: "te-3", Project: p1, Task: t2,
+						TimeInterval: ti(2*time.Hour, 3*time.Hour)},
+					{ID: "te-4", Project: p1, Task: t1,
+						TimeInterval: ti(3*time.Hour, 4*time.Hour)},
+					{ID: "te-5", TimeInterval: ti(4*time.Hour, 5*time.Hour)},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.GroupBy = []string{"project", "Task"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+-----------------+--------------+----------+------------+---------+
+				|     PROJECT     |     TASK     | DURATION | PERCENTAGE | ENTRIES |
+				+-----------------+--------------+----------+------------+---------+
+				| Another         |              | 1:00:00  | 20.00%     |       1 |
+				|                 | Without task | 1:00:00  | 20.00%     |       1 |
+				| Clockify        |              | 3:00:00  | 60.00%     |       3 |
+				|                 | Docs         | 1:00:00  | 20.00%     |       1 |
+				|                 | Report       | 2:00:00  | 40.00%     |       2 |
+				| Without project |              | 1:00:00  | 20.00%     |       1 |
+				|                 | Without task | 1:00:00  | 20.00%     |       1 |
+				| TOTAL           |              | 5:00:00  | 100.00%    |       5 |
+				+-----------------+--------------+----------+------------+---------+
+			`),
+		},
+		{
+			name: "group by tag and day as csv",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				dev := dto.Tag{ID: "tg1", Name: "Dev"}
+				meet := dto.Tag{ID: "tg2", Name: "Meeting"}
+				ti := func(s, e time.Duration) dto.TimeInterval {
+					end := first.Add(e)
+					return dto.NewTimeInterval(first.Add(s), &end)
+				}
+
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Tags: []dto.Tag{dev},
+						TimeInterval: ti(12*time.Hour, 14*time.Hour)},
+					{ID: "te-2", Tags: []dto.Tag{dev, meet},
+						TimeInterval: ti(36*time.Hour, 37*time.Hour)},
+					{ID: "te-3", Tags: []dto.Tag{meet},
+						TimeInterval: ti(36*time.Hour, 37*time.Hour)},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.GroupBy = []string{"tag", "day"}
+				rf.CSV = true
+				return rf
+			},
+			expected: heredoc.Docf(`
+				tag.id,tag.name,day.id,day.name,duration,percentage,count
+				tg1,Dev,,,3:00:00,75.00,2
+				tg1,Dev,%[1]s,%[1]s,2:00:00,50.00,1
+				tg1,Dev,%[2]s,%[2]s,1:00:00,25.00,1
+				tg2,Meeting,,,2:00:00,50.00,2
+				tg2,Meeting,%[2]s,%[2]s,2:00:00,50.00,2
+			`,
+				first.Add(12*time.Hour).In(time.Local).Format("2006-01-02"),
+				first.Add(36*time.Hour).In(time.Local).Format("2006-01-02"),
+			),
+		},
+		{
+			name: "timesheet",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetWorkWeekdays").Return([]string{
+					"monday", "tuesday", "wednesday", "thursday", "friday"})
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				p1 := &dto.Project{ID: "p1", Name: "Clockify"}
+				p2 := &dto.Project{ID: "p2", Name: "Another"}
+				ti := func(s, e time.Duration) dto.TimeInterval {
+					end := first.Add(e)
+					return dto.NewTimeInterval(first.Add(s), &end)
+				}
+
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Project: p1,
+						TimeInterval: ti(12*time.Hour, 14*time.Hour)},
+					{ID: "te-2", Project: p2,
+						TimeInterval: ti(36*time.Hour, 37*time.Hour)},
+					{ID: "te-3", Project: p1,
+						TimeInterval: ti(36*time.Hour, 37*time.Hour+
+							30*time.Minute)},
+					{ID: "te-4",
+						TimeInterval: ti(132*time.Hour, 133*time.Hour)},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Timesheet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+-----------------+-----------+-----------+-----------+-----------+-----------+-----------+---------+
+				|     Project     | Mon 01/02 | Tue 01/03 | Wed 01/04 | Thu 01/05 | Fri 01/06 | Sat 01/07 |  Total  |
+				+-----------------+-----------+-----------+-----------+-----------+-----------+-----------+---------+
+				| Another         |           |   1:00:00 |           |           |           |           | 1:00:00 |
+				| Clockify        |   2:00:00 |   1:30:00 |           |           |           |           | 3:30:00 |
+				| Without project |           |           |           |           |           |   1:00:00 | 1:00:00 |
+				| TOTAL           |   2:00:00 |   2:30:00 |   0:00:00 |   0:00:00 |   0:00:00 |   1:00:00 | 5:30:00 |
+				+-----------------+-----------+-----------+-----------+-----------+-----------+-----------+---------+
+			`),
+		},
+		{
+			name: "timesheet as csv with tasks",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetWorkWeekdays").Return([]string{
+					"monday", "tuesday", "wednesday", "thursday", "friday"})
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				p1 := &dto.Project{ID: "p1", Name: "Clockify"}
+				t1 := &dto.Task{ID: "t1", Name: "Report"}
+				ti := func(s, e time.Duration) dto.TimeInterval {
+					end := first.Add(e)
+					return dto.NewTimeInterval(first.Add(s), &end)
+				}
+
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Project: p1, Task: t1,
+						TimeInterval: ti(12*time.Hour, 14*time.Hour)},
+					{ID: "te-2", Project: p1,
+						TimeInterval: ti(36*time.Hour, 37*time.Hour)},
+					{ID: "te-3", Project: p1, Task: t1,
+						TimeInterval: ti(36*time.Hour, 37*time.Hour+
+							30*time.Minute)},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Timesheet = true
+				rf.CSV = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				week,project.id,project.name,task.id,task.name,monday,tuesday,wednesday,thursday,friday,total
+				2006-01-01,p1,Clockify,t1,Report,2:00:00,1:30:00,0:00:00,0:00:00,0:00:00,3:30:00
+				2006-01-01,p1,Clockify,,,0:00:00,1:00:00,0:00:00,0:00:00,0:00:00,1:00:00
+			`),
+		},
+		{
+			name: "with amounts http error",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+				f.On("GetWorkspace").Return(dto.Workspace{ID: "w"}, nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{}, nil)
+
+				c.On("GetProjects", api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return(nil, errors.New("http error"))
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.WithAmounts = true
+				return rf
+			},
+			err: "http error",
+		},
+		{
+			name: "with amounts as csv",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				f.On("Config").Return(mocks.NewMockConfig(t))
+
+				memberRate := dto.Rate{Amount: 5000}
+				f.On("GetWorkspace").Return(dto.Workspace{
+					ID:         "w",
+					HourlyRate: dto.Rate{Amount: 1000, Currency: "USD"},
+					Memberships: []dto.Membership{
+						{UserID: "u", HourlyRate: &memberRate},
+					},
+				}, nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				taskRate := dto.Rate{Amount: 12000, Currency: "USD"}
+				p1 := &dto.Project{ID: "p1", Name: "Clockify"}
+				t1 := &dto.Task{ID: "t1", Name: "Report",
+					HourlyRate: &taskRate}
+				ti := func(s, e time.Duration) dto.TimeInterval {
+					end := first.Add(e)
+					return dto.NewTimeInterval(first.Add(s), &end)
+				}
+
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Billable: true,
+						TimeInterval: ti(12*time.Hour, 14*time.Hour)},
+					{ID: "te-2", Billable: true, Project: p1,
+						TimeInterval: ti(36*time.Hour, 37*time.Hour+
+							30*time.Minute)},
+					{ID: "te-3", Billable: true, Project: p1, Task: t1,
+						TimeInterval: ti(37*time.Hour+30*time.Minute,
+							38*time.Hour)},
+					{ID: "te-4", Project: p1,
+						TimeInterval: ti(38*time.Hour, 39*time.Hour)},
+				}, nil)
+
+				c.On("GetProjects", api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{{
+					ID:         "p1",
+					Name:       "Clockify",
+					HourlyRate: dto.Rate{Amount: 8000, Currency: "EUR"},
+				}}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.WithAmounts = true
+				rf.CSV = true
+				return rf
+			},
+			expected: heredoc.Docf(`
+				id,description,project.id,project.name,task.id,task.name,start,end,duration,user.id,user.email,user.name,billable,hourlyRate,amount,currency,tags...
+				te-1,,,,,,%[1]s,%[2]s,2:00:00,,,,true,50.00,100.00,USD
+				te-2,,p1,Clockify,,,%[3]s,%[4]s,1:30:00,,,,true,80.00,120.00,EUR
+				te-3,,p1,Clockify,t1,Report,%[4]s,%[5]s,0:30:00,,,,true,120.00,60.00,USD
+				te-4,,p1,Clockify,,,%[5]s,%[6]s,1:00:00,,,,false,80.00,0.00,EUR
+				total,,,,,,,,,,,,,,120.00,EUR
+				total,,,,,,,,,,,,,,160.00,USD
+			`,
+				first.Add(12*time.Hour).In(time.Local).Format(timehlp.FullTimeFormat),
+				first.Add(14*time.Hour).In(time.Local).Format(timehlp.FullTimeFormat),
+				first.Add(36*time.Hour).In(time.Local).Format(timehlp.FullTimeFormat),
+				first.Add(37*time.Hour+30*time.Minute).In(time.Local).
+					Format(timehlp.FullTimeFormat),
+				first.Add(38*time.Hour).In(time.Local).Format(timehlp.FullTimeFormat),
+				first.Add(39*time.Hour).In(time.Local).Format(timehlp.FullTimeFormat),
+			),
+		},
+		{
+			name: "with cost as csv",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+				f.On("Config").Return(mocks.NewMockConfig(t))
+
+				memberCost := dto.Rate{Amount: 3000}
+				f.On("GetWorkspace").Return(dto.Workspace{
+					ID:         "w",
+					HourlyRate: dto.Rate{Amount: 1000, Currency: "USD"},
+					CostRate:   &dto.Rate{Amount: 2000},
+					Memberships: []dto.Membership{
+						{UserID: "u", CostRate: &memberCost},
+					},
+				}, nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				projectCost := dto.Rate{Amount: 4000, Currency: "USD"}
+				p1 := &dto.Project{ID: "p1", Name: "Clockify"}
+				ti := func(s, e time.Duration) dto.TimeInterval {
+					end := first.Add(e)
+					return dto.NewTimeInterval(first.Add(s), &end)
+				}
+
+				c.On("LogRange", api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Billable: true,
+						TimeInterval: ti(12*time.Hour, 14*time.Hour)},
+					{ID: "te-2", Project: p1,
+						TimeInterval: ti(36*time.Hour, 37*time.Hour+
+							30*time.Minute)},
+				}, nil)
+
+				c.On("GetProjects", api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{{
+					ID:       "p1",
+					Name:     "Clockify",
+					CostRate: &projectCost,
+				}}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.WithCosts = true
+				rf.CSV = true
+				return rf
+			},
+			expected: heredoc.Docf(`
+				id,description,project.id,project.name,task.id,task.name,start,end,duration,user.id,user.email,user.name,costRate,cost,costCurrency,tags...
+				te-1,,,,,,%[1]s,%[2]s,2:00:00,,,,30.00,60.00,USD
+				te-2,,p1,Clockify,,,%[3]s,%[4]s,1:30:00,,,,40.00,60.00,USD
+				total,,,,,,,,,,,,,120.00,USD
+			`,
+				first.Add(12*time.Hour).In(time.Local).Format(timehlp.FullTimeFormat),
+				first.Add(14*time.Hour).In(time.Local).Format(timehlp.FullTimeFormat),
+				first.Add(36*time.Hour).In(time.Local).Format(timehlp.FullTimeFormat),
+				first.Add(37*time.Hour+30*time.Minute).In(time.Local).
+					Format(timehlp.FullTimeFormat),
+			),
+		},
 	}
 
 	for _, tt := range tts {
@@ -407,3 +1109,108 @@ func TestReportWithRange(t *testing.T) {
 		})
 	}
 }
+
+func TestReportWithRangeJSONTotals(t *testing.T) {
+	first := newDate("2006-01-02")
+	last := first.AddDate(0, 0, 3)
+
+	f := mocks.NewMockFactory(t)
+	f.On("GetUserID").Return("u", nil)
+	f.On("GetWorkspaceID").Return("w", nil)
+	f.On("Config").Return(mocks.NewMockConfig(t))
+
+	f.On("GetWorkspace").Return(dto.Workspace{
+		ID:         "w",
+		HourlyRate: dto.Rate{Amount: 1000, Currency: "USD"},
+		CostRate:   &dto.Rate{Amount: 500, Currency: "USD"},
+	}, nil)
+
+	c := mocks.NewMockClient(t)
+	f.On("Client").Return(c, nil)
+
+	ti := func(s, e time.Duration) dto.TimeInterval {
+		end := first.Add(e)
+		return dto.NewTimeInterval(first.Add(s), &end)
+	}
+
+	c.On("LogRange", api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       first,
+		LastDate:        last,
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.TimeEntry{
+		{ID: "te-1", Billable: true,
+			TimeInterval: ti(12*time.Hour, 14*time.Hour)},
+		{ID: "te-2", Billable: true, Project: &dto.Project{ID: "p1"},
+			TimeInterval: ti(36*time.Hour, 37*time.Hour)},
+	}, nil)
+
+	c.On("GetProjects", api.GetProjectsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Project{{
+		ID:         "p1",
+		HourlyRate: dto.Rate{Amount: 8000, Currency: "EUR"},
+	}}, nil)
+
+	rf := util.NewReportFlags()
+	rf.WithAmounts = true
+	rf.WithCosts = true
+	rf.JSON = true
+
+	b := bytes.NewBufferString("")
+	err := util.ReportWithRange(f, first, first.AddDate(0, 0, 2), b, rf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var out output.TimeEntriesWithTotals
+	if !assert.NoError(t, json.Unmarshal(b.Bytes(), &out)) {
+		return
+	}
+
+	assert.Len(t, out.TimeEntries, 2)
+
+	i := func(v int64) *int64 { return &v }
+	assert.Equal(t, map[string]output.CurrencyTotals{
+		"EUR": {Amount: i(8000), Cost: i(0)},
+		"USD": {Amount: i(2000), Cost: i(1500)},
+	}, out.Totals)
+}
+
+func TestGetUsersTimeEntriesConcurrency(t *testing.T) {
+	first := newDate("2006-01-02")
+	last := first.AddDate(0, 0, 1)
+
+	c := mocks.NewMockClient(t)
+	c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.User{{ID: "u1"}, {ID: "u2"}, {ID: "u3"}, {ID: "u4"}}, nil)
+
+	var m sync.Mutex
+	running, most := 0, 0
+	c.On("GetUsersHydratedTimeEntries", mock.Anything).
+		Run(func(mock.Arguments) {
+			m.Lock()
+			running++
+			if running > most {
+				most = running
+			}
+			m.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			m.Lock()
+			running--
+			m.Unlock()
+		}).
+		Return([]dto.TimeEntry{}, nil)
+
+	_, err := util.GetUsersTimeEntries(c, "w", first, last,
+		util.ReportFlags{AllUsers: true}, 2)
+	if assert.NoError(t, err) {
+		assert.LessOrEqual(t, most, 2)
+	}
+}
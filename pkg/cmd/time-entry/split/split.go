@@ -0,0 +1,290 @@
+package split
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSplit represents the split command
+func NewCmdSplit(
+	f cmdutil.Factory,
+	report func([]dto.TimeEntryImpl, io.Writer, util.OutputFlags) error,
+) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: output.TimeFormatSimple}
+	va := cmdcompl.ValidArgsSlide{
+		timeentryhlp.AliasCurrent, timeentryhlp.AliasLast}
+	cmd := &cobra.Command{
+		Use: "split { <time-entry-id> | " + va.IntoUseOptions() +
+			" | ^n } --at <time>",
+		Args: cobra.MatchAll(
+			cmdutil.RequiredNamedArgs("time entry id"),
+			cobra.ExactArgs(1),
+		),
+		ValidArgs: va.IntoValidArgs(),
+		Short:     `Split a time entry at one or more points in time`,
+		Long: heredoc.Docf(`
+			Split a time entry at one or more points in time.
+
+			The time entry will end at the first point informed with %[1]s--at%[1]s, and a new time entry will be created starting at it and ending at the next point, or when the original entry ended.
+			Tags, billable, custom fields and the other properties are kept on every part, but the flags informed (like %[1]s--project%[1]s or %[1]s--description%[1]s) will change the new parts.
+
+			If the time entry is running, the last part will be the one running after the split.
+
+			If you want to split the current (running) time entry you can use "%[2]s" instead of its ID.
+			To split the last ended time entry you can use "%[3]s" for it, for the one before that you can use "^2", for the previous "^3" and so on.
+
+			The rules defined in the workspace and project will be checked on the new parts before changing anything.
+			The new parts are created before the time entry is shortened, if anything fails they are removed and a running time entry is restarted.
+
+			When setting %[1]s--at%[1]s you can use any of the following formats:
+			%[4]s
+			%[5]s
+			%[6]s
+		`,
+			"`",
+			timeentryhlp.AliasCurrent,
+			timeentryhlp.AliasLast,
+			util.HelpDateTimeFormats,
+			util.HelpNamesForIds,
+			util.HelpMoreInfoAboutPrinting,
+		),
+		Example: heredoc.Docf(`
+			# forgot to switch tasks at 11:00
+			$ %[1]s split current --at 11:00 --task "split command" -d "Splitting entries"
+			+--------------------------+----------+----------+---------+--------------+--------------------+--------------------------------+
+			|            ID            |  START   |   END    |   DUR   |   PROJECT    |    DESCRIPTION     |              TAGS              |
+			+--------------------------+----------+----------+---------+--------------+--------------------+--------------------------------+
+			| 62ae4b304ebb4f143c931d50 | 09:00:00 | 11:00:00 | 2:00:00 | Clockify Cli | Adding docs        | Development                    |
+			|                          |          |          |         |              |                    | (62ae28b72518aa18da2acb49)     |
+			| 62ae4dfe4ebb4f143c932106 | 11:00:00 | 11:32:10 | 0:32:10 | Clockify Cli | Splitting entries  | Development                    |
+			|                          |          |          |         |              |                    | (62ae28b72518aa18da2acb49)     |
+			+--------------------------+----------+----------+---------+--------------+--------------------+--------------------------------+
+
+			# splitting the last entry in three parts
+			$ %[1]s split last --at 14:00 --at 15:30 -q
+			62ae4b304ebb4f143c931d50
+			62ae4dfe4ebb4f143c932106
+			62ae4e1a4ebb4f143c932151
+		`, "clockify-cli"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			ats, _ := cmd.Flags().GetStringArray("at")
+			points := make([]time.Time, len(ats))
+			for i := range ats {
+				var err error
+				if points[i], err = timehlp.ConvertToTime(ats[i]); err != nil {
+					return err
+				}
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			userID, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			tei, err := timeentryhlp.GetTimeEntry(c, w, userID, args[0])
+			if err != nil {
+				return err
+			}
+
+			te := util.TimeEntryImplToDTO(tei)
+			if err := checkSplitPoints(te, points); err != nil {
+				return err
+			}
+
+			dc := util.NewDescriptionCompleter(f)
+			next, err := util.Do(
+				te,
+				util.FillTimeEntryWithFlags(cmd.Flags()),
+				util.GetAllowNameForIDsFn(f.Config(), c),
+				util.ResolveCustomFieldsFn(c),
+				util.GetPropsInteractiveFn(dc, f),
+			)
+			if err != nil {
+				return err
+			}
+
+			parts, err := splitTimeEntry(
+				next, te.End, points, util.GetValidateTimeEntryFn(f))
+			if err != nil {
+				return err
+			}
+
+			// the parts are created before changing the time entry, so if
+			// anything fails the time entry can be kept as it was
+			created := make([]dto.TimeEntryImpl, 0, len(parts))
+			create := util.CreateTimeEntryFn(c)
+			for i := range parts {
+				p, err := create(parts[i])
+				if err != nil {
+					return rollback(c, te, created, err)
+				}
+
+				created = append(created, util.TimeEntryDTOToImpl(p))
+			}
+
+			if tei, err = c.UpdateTimeEntry(api.UpdateTimeEntryParam{
+				Workspace:   te.Workspace,
+				TimeEntryID: te.ID,
+				Description: te.Description,
+				Start:       te.Start,
+				End:         &points[0],
+				Billable:    *te.Billable,
+				ProjectID:   te.ProjectID,
+				TaskID:      te.TaskID,
+				TagIDs:      te.TagIDs,
+
+				CustomFields: te.CustomFields,
+			}); err != nil {
+				return rollback(c, te, created, err)
+			}
+
+			tes := append([]dto.TimeEntryImpl{tei}, created...)
+			if report != nil {
+				return report(tes, cmd.OutOrStdout(), of)
+			}
+
+			return util.PrintTimeEntryImpls(tes, f, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringArray("at", []string{},
+		"when the time entry should be split (can be used multiple times)")
+	_ = cmd.MarkFlagRequired("at")
+
+	util.AddTimeEntryFlags(cmd, f, &of)
+
+	return cmd
+}
+
+// rollback removes the parts already created, returning the error that
+// stopped the split. Creating a running part stops the time entry on
+// Clockify, so if it was running it is restarted
+func rollback(
+	c api.Client, te util.TimeEntryDTO, created []dto.TimeEntryImpl, err error,
+) error {
+	stopped := false
+	for i := range created {
+		stopped = stopped || created[i].TimeInterval.End == nil
+		if dErr := c.DeleteTimeEntry(api.DeleteTimeEntryParam{
+			Workspace:   te.Workspace,
+			TimeEntryID: created[i].ID,
+		}); dErr != nil {
+			return fmt.Errorf("%w (time entry %s was created, "+
+				"but could not be removed: %s)", err, created[i].ID, dErr)
+		}
+	}
+
+	if !stopped || te.End != nil {
+		return err
+	}
+
+	if _, rErr := c.UpdateTimeEntry(api.UpdateTimeEntryParam{
+		Workspace:   te.Workspace,
+		TimeEntryID: te.ID,
+		Description: te.Description,
+		Start:       te.Start,
+		Billable:    *te.Billable,
+		ProjectID:   te.ProjectID,
+		TaskID:      te.TaskID,
+		TagIDs:      te.TagIDs,
+
+		CustomFields: te.CustomFields,
+	}); rErr != nil {
+		return fmt.Errorf("%w (time entry %s was stopped, "+
+			"but could not be restarted: %s)", err, te.ID, rErr)
+	}
+
+	return err
+}
+
+// checkSplitPoints sorts the points and assures all of them are inside of the
+// time entry interval, and that none repeats
+func checkSplitPoints(te util.TimeEntryDTO, points []time.Time) error {
+	if len(points) == 0 {
+		return errors.New("at least one split point must be informed")
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Before(points[j])
+	})
+
+	end := timehlp.Now()
+	if te.End != nil {
+		end = *te.End
+	}
+
+	for i := range points {
+		if !points[i].After(te.Start) || !points[i].Before(end) {
+			return fmt.Errorf(
+				"split point %s must be between %s and %s",
+				points[i].In(time.Local).Format(timehlp.FullTimeFormat),
+				te.Start.In(time.Local).Format(timehlp.FullTimeFormat),
+				end.In(time.Local).Format(timehlp.FullTimeFormat),
+			)
+		}
+
+		if i > 0 && points[i].Equal(points[i-1]) {
+			return fmt.Errorf(
+				"split point %s was informed more than once",
+				points[i].In(time.Local).Format(timehlp.FullTimeFormat),
+			)
+		}
+	}
+
+	return nil
+}
+
+// splitTimeEntry creates the new parts of the time entry, each one starting
+// at a split point and ending at the next one, the last part ends when the
+// time entry ended
+func splitTimeEntry(
+	te util.TimeEntryDTO, end *time.Time, points []time.Time,
+	validate util.Step,
+) ([]util.TimeEntryDTO, error) {
+	parts := make([]util.TimeEntryDTO, len(points))
+	for i := range points {
+		p := te
+		p.ID = ""
+		p.Start = points[i]
+		p.End = end
+		if i+1 < len(points) {
+			e := points[i+1]
+			p.End = &e
+		}
+
+		var err error
+		if parts[i], err = validate(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,310 @@
+package split_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/split"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdSplitErrors(t *testing.T) {
+	start, _ := timehlp.ConvertToTime("2022-12-01 09:00")
+	end, _ := timehlp.ConvertToTime("2022-12-01 12:00")
+	te := dto.TimeEntryImpl{
+		WorkspaceID:  "w",
+		ID:           "te",
+		TimeInterval: dto.NewTimeInterval(start, &end),
+	}
+
+	tts := []struct {
+		name  string
+		args  []string
+		fetch bool
+		err   string
+	}{
+		{
+			name: "at is required",
+			args: []string{"te"},
+			err:  `"at" not set`,
+		},
+		{
+			name: "invalid time",
+			args: []string{"te", "--at", "eleven"},
+			err:  "supported formats are",
+		},
+		{
+			name: "the start can't be changed",
+			args: []string{"te", "--at", "2022-12-01 10:00",
+				"--when", "2022-12-01 08:00"},
+			err: "unknown flag: --when",
+		},
+		{
+			name:  "before the start",
+			args:  []string{"te", "--at", "2022-12-01 08:00"},
+			fetch: true,
+			err: "split point 2022-12-01 08:00:00 must be between " +
+				"2022-12-01 09:00:00 and 2022-12-01 12:00:00",
+		},
+		{
+			name:  "after the end",
+			args:  []string{"te", "--at", "2022-12-01 12:00"},
+			fetch: true,
+			err:   "split point 2022-12-01 12:00:00 must be between",
+		},
+		{
+			name: "repeated points",
+			args: []string{"te",
+				"--at", "2022-12-01 10:00", "--at", "2022-12-01 10:00"},
+			fetch: true,
+			err:   "split point 2022-12-01 10:00:00 was informed more than once",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			if tt.fetch {
+				c := mocks.NewMockClient(t)
+				f.EXPECT().Client().Return(c, nil)
+				f.EXPECT().GetUserID().Return("u", nil)
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+				c.EXPECT().GetTimeEntry(api.GetTimeEntryParam{
+					Workspace:   "w",
+					TimeEntryID: "te",
+				}).Return(&te, nil)
+			}
+
+			cmd := split.NewCmdSplit(f, func(
+				[]dto.TimeEntryImpl, io.Writer, util.OutputFlags) error {
+				assert.Fail(t, "should not report")
+				return nil
+			})
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetOut(bytes.NewBufferString(""))
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+func TestCmdSplit(t *testing.T) {
+	at := func(s string) time.Time {
+		v, _ := timehlp.ConvertToTime(s)
+		return v
+	}
+
+	te := dto.TimeEntryImpl{
+		WorkspaceID:  "w",
+		ID:           "te",
+		UserID:       "u",
+		Description:  "Something",
+		ProjectID:    "p",
+		TaskID:       "t",
+		TagIDs:       []string{"tag"},
+		Billable:     true,
+		TimeInterval: dto.NewTimeInterval(at("2022-12-01 09:00"), nil),
+	}
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{AllowIncomplete: true})
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().GetTimeEntryInProgress(api.GetTimeEntryInProgressParam{
+		Workspace: "w",
+		UserID:    "u",
+	}).Return(&te, nil)
+
+	first := at("2022-12-01 10:00")
+	c.EXPECT().UpdateTimeEntry(api.UpdateTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te",
+		Description: "Something",
+		Start:       te.TimeInterval.Start,
+		End:         &first,
+		Billable:    true,
+		ProjectID:   "p",
+		TaskID:      "t",
+		TagIDs:      []string{"tag"},
+	}).Return(dto.TimeEntryImpl{ID: "te"}, nil)
+
+	b := true
+	second := at("2022-12-01 11:00")
+	c.EXPECT().CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Billable:    &b,
+		Start:       first,
+		End:         &second,
+		ProjectID:   "p",
+		TaskID:      "t",
+		TagIDs:      []string{"tag"},
+		Description: "Splitting",
+	}).Return(dto.TimeEntryImpl{ID: "te-2"}, nil)
+
+	c.EXPECT().CreateTimeEntry(mock.MatchedBy(
+		func(p api.CreateTimeEntryParam) bool {
+			return p.Start.Equal(second) && p.End == nil &&
+				p.Description == "Splitting"
+		})).Return(dto.TimeEntryImpl{ID: "te-3"}, nil)
+
+	called := false
+	t.Cleanup(func() { assert.True(t, called, "was not called") })
+	cmd := split.NewCmdSplit(f, func(
+		tes []dto.TimeEntryImpl, _ io.Writer, _ util.OutputFlags) error {
+		called = true
+		if assert.Len(t, tes, 3) {
+			assert.Equal(t, "te", tes[0].ID)
+			assert.Equal(t, "te-2", tes[1].ID)
+			assert.Equal(t, "te-3", tes[2].ID)
+		}
+		return nil
+	})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"current", "-d", "Splitting",
+		"--at", "2022-12-01 11:00", "--at", "2022-12-01 10:00"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+}
+
+func TestCmdSplitRollback(t *testing.T) {
+	at := func(s string) time.Time {
+		v, _ := timehlp.ConvertToTime(s)
+		return v
+	}
+
+	end := at("2022-12-01 12:00")
+
+	tts := []struct {
+		name      string
+		running   bool
+		createErr bool
+		deleted   []string
+		err       string
+	}{
+		{
+			name:      "creating a part fails",
+			createErr: true,
+			deleted:   []string{"te-2"},
+			err:       "create failed",
+		},
+		{
+			name:    "shortening the time entry fails",
+			deleted: []string{"te-2", "te-3"},
+			err:     "update failed",
+		},
+		{
+			name:    "shortening the running time entry fails",
+			running: true,
+			deleted: []string{"te-2", "te-3"},
+			err:     "update failed",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			te := dto.TimeEntryImpl{
+				WorkspaceID:  "w",
+				ID:           "te",
+				UserID:       "u",
+				Description:  "Something",
+				TimeInterval: dto.NewTimeInterval(at("2022-12-01 09:00"), &end),
+			}
+			if tt.running {
+				te.TimeInterval.End = nil
+			}
+
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetUserID().Return("u", nil)
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().Config().
+				Return(&mocks.SimpleConfig{AllowIncomplete: true})
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+
+			c.EXPECT().GetTimeEntry(api.GetTimeEntryParam{
+				Workspace:   "w",
+				TimeEntryID: "te",
+			}).Return(&te, nil)
+
+			second := at("2022-12-01 11:00")
+			c.EXPECT().CreateTimeEntry(mock.MatchedBy(
+				func(p api.CreateTimeEntryParam) bool {
+					return p.Start.Equal(at("2022-12-01 10:00"))
+				})).Return(dto.TimeEntryImpl{ID: "te-2"}, nil)
+
+			if tt.createErr {
+				c.EXPECT().CreateTimeEntry(mock.MatchedBy(
+					func(p api.CreateTimeEntryParam) bool {
+						return p.Start.Equal(second)
+					})).Return(dto.TimeEntryImpl{},
+					errors.New("create failed"))
+			} else {
+				c.EXPECT().CreateTimeEntry(mock.MatchedBy(
+					func(p api.CreateTimeEntryParam) bool {
+						return p.Start.Equal(second)
+					})).Return(dto.TimeEntryImpl{ID: "te-3"}, nil)
+
+				c.EXPECT().UpdateTimeEntry(mock.MatchedBy(
+					func(p api.UpdateTimeEntryParam) bool {
+						return p.End != nil
+					})).
+					Return(dto.TimeEntryImpl{}, errors.New("update failed"))
+			}
+
+			if tt.running {
+				c.EXPECT().UpdateTimeEntry(api.UpdateTimeEntryParam{
+					Workspace:   "w",
+					TimeEntryID: "te",
+					Description: "Something",
+					Start:       te.TimeInterval.Start,
+				}).Return(te, nil).Once()
+			}
+
+			for _, id := range tt.deleted {
+				c.EXPECT().DeleteTimeEntry(api.DeleteTimeEntryParam{
+					Workspace:   "w",
+					TimeEntryID: id,
+				}).Return(nil).Once()
+			}
+
+			cmd := split.NewCmdSplit(f, func(
+				[]dto.TimeEntryImpl, io.Writer, util.OutputFlags) error {
+				assert.Fail(t, "should not report")
+				return nil
+			})
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetOut(bytes.NewBufferString(""))
+			cmd.SetArgs([]string{"te",
+				"--at", "2022-12-01 10:00", "--at", "2022-12-01 11:00"})
+
+			_, err := cmd.ExecuteC()
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/out"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/show"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/split"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -25,6 +26,7 @@ func NewCmdTimeEntry(f cmdutil.Factory) (cmds []*cobra.Command) {
 
 		edit.NewCmdEdit(f, nil),
 		em.NewCmdEditMultiple(f),
+		split.NewCmdSplit(f, nil),
 
 		out.NewCmdOut(f),
 
@@ -16,6 +16,8 @@ func CreateTimeEntryFn(c api.Client) Step {
 			Description: dto.Description,
 			TagIDs:      dto.TagIDs,
 			TaskID:      dto.TaskID,
+
+			CustomFields: dto.CustomFields,
 		})
 
 		if err != nil {
@@ -0,0 +1,229 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
+)
+
+// customFieldsOf returns the custom fields of the workspace that can be used
+// on time entries
+func customFieldsOf(c api.Client, workspace string) (
+	[]dto.WorkspaceCustomField, error) {
+	cfs, err := c.GetWorkspaceCustomFields(api.GetWorkspaceCustomFieldsParam{
+		Workspace: workspace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fs := make([]dto.WorkspaceCustomField, 0, len(cfs))
+	for _, cf := range cfs {
+		if cf.Status == dto.CustomFieldStatusInactive ||
+			(cf.EntityType != "" && cf.EntityType != "TIMEENTRY") {
+			continue
+		}
+
+		fs = append(fs, cf)
+	}
+
+	return fs, nil
+}
+
+// setCustomField replaces the value of the custom field with the id or name
+// informed, the new value is kept unresolved (without id)
+func setCustomField(
+	cfs []dto.CustomFieldValue, name, value string) []dto.CustomFieldValue {
+	vs := make([]dto.CustomFieldValue, 0, len(cfs)+1)
+	for _, v := range cfs {
+		if v.CustomFieldID == name || strings.EqualFold(v.Name, name) {
+			continue
+		}
+
+		vs = append(vs, v)
+	}
+
+	return append(vs, dto.CustomFieldValue{Name: name, Value: value})
+}
+
+// ResolveCustomFieldsFn will find the custom fields set by name on the time
+// entry, and convert theirs values to the type of the field
+func ResolveCustomFieldsFn(c api.Client) Step {
+	fields := map[string][]dto.WorkspaceCustomField{}
+
+	return func(te TimeEntryDTO) (TimeEntryDTO, error) {
+		pending := false
+		for _, v := range te.CustomFields {
+			pending = pending || v.CustomFieldID == ""
+		}
+
+		if !pending {
+			return te, nil
+		}
+
+		cfs, ok := fields[te.Workspace]
+		if !ok {
+			var err error
+			if cfs, err = customFieldsOf(c, te.Workspace); err != nil {
+				return te, err
+			}
+			fields[te.Workspace] = cfs
+		}
+
+		vs := make([]dto.CustomFieldValue, 0, len(te.CustomFields))
+		for _, v := range te.CustomFields {
+			if v.CustomFieldID == "" {
+				var err error
+				if v, err = resolveCustomField(cfs, v); err != nil {
+					return te, err
+				}
+			}
+
+			for i := range vs {
+				if vs[i].CustomFieldID == v.CustomFieldID {
+					vs = append(vs[:i], vs[i+1:]...)
+					break
+				}
+			}
+
+			vs = append(vs, v)
+		}
+
+		te.CustomFields = vs
+		return te, nil
+	}
+}
+
+func resolveCustomField(
+	cfs []dto.WorkspaceCustomField, v dto.CustomFieldValue) (
+	dto.CustomFieldValue, error) {
+	var cf *dto.WorkspaceCustomField
+	for i := range cfs {
+		if cfs[i].ID == v.Name || strings.EqualFold(cfs[i].Name, v.Name) {
+			cf = &cfs[i]
+			break
+		}
+	}
+
+	if cf == nil {
+		return v, fmt.Errorf(`custom field "%s" not found`, v.Name)
+	}
+
+	value, err := customFieldValue(*cf, strings.TrimSpace(v.String()))
+	if err != nil {
+		return v, err
+	}
+
+	return dto.CustomFieldValue{
+		CustomFieldID: cf.ID,
+		Name:          cf.Name,
+		Type:          cf.Type,
+		Value:         value,
+	}, nil
+}
+
+// customFieldValue converts the text into the type of value the custom field
+// accepts, a empty text clears the field
+func customFieldValue(
+	cf dto.WorkspaceCustomField, s string) (interface{}, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	switch cf.Type {
+	case dto.CustomFieldTypeNumber:
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf(
+				`custom field "%s" must be a number, "%s" was informed`,
+				cf.Name, s)
+		}
+		return n, nil
+	case dto.CustomFieldTypeCheckbox:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf(
+				`custom field "%s" must be true or false, "%s" was informed`,
+				cf.Name, s)
+		}
+		return b, nil
+	case dto.CustomFieldTypeLink:
+		u, err := url.ParseRequestURI(s)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf(
+				`custom field "%s" must be a link, "%s" was informed`,
+				cf.Name, s)
+		}
+		return s, nil
+	case dto.CustomFieldTypeDropdownSingle:
+		return allowedValue(cf, s)
+	case dto.CustomFieldTypeDropdownMultiple:
+		ps := strings.Split(s, ",")
+		vs := make([]string, len(ps))
+		for i := range ps {
+			v, err := allowedValue(cf, strings.TrimSpace(ps[i]))
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = v
+		}
+		return vs, nil
+	default:
+		return s, nil
+	}
+}
+
+func allowedValue(cf dto.WorkspaceCustomField, s string) (string, error) {
+	for _, a := range cf.AllowedValues {
+		if strings.EqualFold(a, s) {
+			return a, nil
+		}
+	}
+
+	return "", fmt.Errorf(
+		`value "%s" is not allowed for custom field "%s", use one of: %s`,
+		s, cf.Name, strings.Join(cf.AllowedValues, ", "))
+}
+
+// validateCustomFields checks if the custom fields required by the workspace
+// have values on the time entry, if the custom fields are not available
+// while offline nothing is checked
+func validateCustomFields(c api.Client, te TimeEntryDTO) error {
+	cfs, err := customFieldsOf(c, te.Workspace)
+	if errors.Is(err, offline.ErrNotAvailable) ||
+		errors.Is(err, offline.ErrOffline) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, cf := range cfs {
+		if !cf.Required {
+			continue
+		}
+
+		filled := false
+		for _, v := range te.CustomFields {
+			if (v.CustomFieldID == cf.ID ||
+				(v.CustomFieldID == "" && strings.EqualFold(v.Name, cf.Name))) &&
+				strings.TrimSpace(v.String()) != "" {
+				filled = true
+				break
+			}
+		}
+
+		if !filled {
+			return fmt.Errorf(`workspace requires custom field "%s"`, cf.Name)
+		}
+	}
+
+	return nil
+}
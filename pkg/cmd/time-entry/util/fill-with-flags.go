@@ -1,6 +1,8 @@
 package util
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
@@ -11,6 +13,7 @@ type flagSet interface {
 	Changed(string) bool
 	GetString(string) (string, error)
 	GetStringSlice(string) ([]string, error)
+	GetStringArray(string) ([]string, error)
 }
 
 // FillTimeEntryWithFlags will read the flags and fill the time entry with they
@@ -76,6 +79,31 @@ func FillTimeEntryWithFlags(flags flagSet) Step {
 			dto.End = &v
 		}
 
+		return FillCustomFieldsWithFlags(flags)(dto)
+	}
+}
+
+// FillCustomFieldsWithFlags will read the values of the flag custom-field
+// into the time entry, the values are kept as text and identified by the
+// name until ResolveCustomFieldsFn is used
+func FillCustomFieldsWithFlags(flags flagSet) Step {
+	return func(dto TimeEntryDTO) (TimeEntryDTO, error) {
+		if !flags.Changed("custom-field") {
+			return dto, nil
+		}
+
+		vs, _ := flags.GetStringArray("custom-field")
+		for _, v := range vs {
+			i := strings.Index(v, "=")
+			if i < 1 {
+				return dto, fmt.Errorf(
+					`custom field "%s" must be in the format name=value`, v)
+			}
+
+			dto.CustomFields = setCustomField(dto.CustomFields,
+				strings.TrimSpace(v[:i]), v[i+1:])
+		}
+
 		return dto, nil
 	}
 }
@@ -38,6 +38,10 @@ func AddTimeEntryFlags(
 		newDescriptionAutoComplete(f),
 	)
 
+	cmd.Flags().StringArray("custom-field", []string{},
+		"set a custom field of the entry as name=value, an empty value "+
+			"clears it (can be used multiple times)")
+
 	AddPrintTimeEntriesFlags(cmd, of)
 
 	// deprecations
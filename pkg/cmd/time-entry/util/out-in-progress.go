@@ -6,9 +6,12 @@ import (
 
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
 )
 
-// OutInProgressFn will stop the in progress time entry, if it exists
+// OutInProgressFn will stop the in progress time entry, if it exists. While
+// offline, if the running time entry is unknown it is left to be stopped by
+// Clockify when the new one is synced
 func OutInProgressFn(c api.Client) Step {
 	return func(tei TimeEntryDTO) (TimeEntryDTO, error) {
 		return tei, out(c, tei.Workspace, tei.UserID, tei.Start)
@@ -20,18 +23,10 @@ func out(c api.Client, w, u string, end time.Time) error {
 		Workspace: w,
 		UserID:    u,
 		End:       end,
-	}); getErrorCode(err) != 404 {
+	}); !errors.Is(err, dto.ErrNotFound) &&
+		!errors.Is(err, offline.ErrRunningUnknown) {
 		return err
 	}
 
 	return nil
 }
-
-func getErrorCode(err error) int {
-	var e dto.Error
-	if errors.As(err, &e) {
-		return e.Code
-	}
-
-	return 0
-}
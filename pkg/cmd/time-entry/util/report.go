@@ -21,6 +21,16 @@ type OutputFlags struct {
 	DurationFloat     bool
 
 	TimeFormat string
+	// ShowUser adds the user of the time entries to the table output, it
+	// is set by commands that list time entries of multiple users
+	ShowUser bool
+	// WithAmounts adds the billable amount of the time entries to the
+	// table, CSV and Markdown outputs, the amounts must be already set on
+	// the time entries
+	WithAmounts bool
+	// WithCosts adds the labour cost of the time entries to the table, CSV
+	// and Markdown outputs, the costs must be already set on the time entries
+	WithCosts bool
 }
 
 func (of OutputFlags) Check() error {
@@ -79,6 +89,35 @@ func PrintTimeEntryImpl(
 	return PrintTimeEntry(fte, out, f.Config(), of)
 }
 
+// PrintTimeEntryImpls will print out a list of time entries using parameters
+// and flags
+func PrintTimeEntryImpls(
+	teis []dto.TimeEntryImpl,
+	f cmdutil.Factory,
+	out io.Writer,
+	of OutputFlags,
+) error {
+	c, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	tes := make([]dto.TimeEntry, len(teis))
+	for i := range teis {
+		fte, err := c.GetHydratedTimeEntry(api.GetTimeEntryParam{
+			Workspace:   teis[i].WorkspaceID,
+			TimeEntryID: teis[i].ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		tes[i] = *fte
+	}
+
+	return PrintTimeEntries(tes, out, f.Config(), of)
+}
+
 // PrintTimeEntry will print out a time entries using parameters and flags
 func PrintTimeEntry(
 	te *dto.TimeEntry, out io.Writer, config cmdutil.Config, of OutputFlags,
@@ -103,13 +142,22 @@ func PrintTimeEntry(
 func PrintTimeEntries(
 	tes []dto.TimeEntry, out io.Writer, config cmdutil.Config, of OutputFlags,
 ) error {
+	var amountOpts []output.TimeEntryOutputOpt
+	if of.WithAmounts {
+		amountOpts = append(amountOpts, output.WithAmounts())
+	}
+
+	if of.WithCosts {
+		amountOpts = append(amountOpts, output.WithCosts())
+	}
+
 	switch {
 	case of.Markdown:
-		return output.TimeEntriesMarkdownPrint(tes, out)
+		return output.TimeEntriesMarkdownPrint(tes, out, amountOpts...)
 	case of.JSON:
-		return output.TimeEntriesJSONPrint(tes, out)
+		return output.TimeEntriesJSONPrint(tes, out, amountOpts...)
 	case of.CSV:
-		return output.TimeEntriesCSVPrint(tes, out)
+		return output.TimeEntriesCSVPrint(tes, out, amountOpts...)
 	case of.Format != "":
 		return output.TimeEntriesPrintWithTemplate(of.Format)(tes, out)
 	case of.Quiet:
@@ -119,8 +167,8 @@ func PrintTimeEntries(
 	case of.DurationFormatted:
 		return output.TimeEntriesTotalDurationOnlyFormatted(tes, out)
 	default:
-		opts := []output.TimeEntryOutputOpt{
-			output.WithTimeFormat(of.TimeFormat)}
+		opts := append([]output.TimeEntryOutputOpt{
+			output.WithTimeFormat(of.TimeFormat)}, amountOpts...)
 
 		if config.GetBool(cmdutil.CONF_SHOW_TASKS) {
 			opts = append(opts, output.WithShowTasks())
@@ -130,6 +178,10 @@ func PrintTimeEntries(
 			opts = append(opts, output.WithTotalDuration())
 		}
 
+		if of.ShowUser {
+			opts = append(opts, output.WithShowUser())
+		}
+
 		return output.TimeEntriesPrint(opts...)(tes, out)
 	}
 }
@@ -22,6 +22,8 @@ type TimeEntryDTO struct {
 	TagIDs      []string
 	Billable    *bool
 	Locked      *bool
+
+	CustomFields []dto.CustomFieldValue
 }
 
 // Step is used to stack multiple actions to be executed over a TimeEntryDTO
@@ -65,6 +67,8 @@ func TimeEntryImplToDTO(t dto.TimeEntryImpl) TimeEntryDTO {
 		TagIDs:      t.TagIDs,
 		Billable:    &t.Billable,
 		Locked:      &t.IsLocked,
+
+		CustomFields: t.CustomFields,
 	}
 }
 
@@ -92,5 +96,6 @@ func TimeEntryDTOToImpl(t TimeEntryDTO) dto.TimeEntryImpl {
 		TimeInterval: dto.NewTimeInterval(t.Start, t.End),
 		Billable:     *t.Billable,
 		IsLocked:     *t.Locked,
+		CustomFields: t.CustomFields,
 	}
 }
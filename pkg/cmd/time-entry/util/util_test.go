@@ -2,6 +2,7 @@ package util
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/internal/mocks"
 	. "github.com/lucassabreu/clockify-cli/internal/testhlp"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -136,6 +138,10 @@ func (f *flagSetMock) GetStringSlice(k string) ([]string, error) {
 	return []string{}, nil
 }
 
+func (f *flagSetMock) GetStringArray(k string) ([]string, error) {
+	return f.GetStringSlice(k)
+}
+
 func TestFillTimeEntryWithFlags_ShouldNotSetProperties_WhenNotChanged(
 	t *testing.T) {
 	tm := MustParseTime(timehlp.SimplerTimeFormat, "2022-11-07 11:00").Local()
@@ -311,6 +317,34 @@ func TestFillTimeEntryWithFlags_ShouldNotSetProperties_WhenNotChanged(
 				Billable:    &bFalse,
 			},
 		},
+		{
+			name: "should replace custom fields by id or name",
+			flags: &flagSetMock{flags: map[string]interface{}{
+				"custom-field": []string{"Ticket=TK-2", "cf2=", "Sprint=3"},
+			}},
+			input: TimeEntryDTO{
+				CustomFields: []dto.CustomFieldValue{
+					{CustomFieldID: "cf1", Name: "Ticket", Value: "TK-1"},
+					{CustomFieldID: "cf2", Value: "keep?"},
+					{CustomFieldID: "cf3", Name: "Other", Value: "keep"},
+				},
+			},
+			output: TimeEntryDTO{
+				CustomFields: []dto.CustomFieldValue{
+					{CustomFieldID: "cf3", Name: "Other", Value: "keep"},
+					{Name: "Ticket", Value: "TK-2"},
+					{Name: "cf2", Value: ""},
+					{Name: "Sprint", Value: "3"},
+				},
+			},
+		},
+		{
+			name: "should fail for custom fields without value",
+			flags: &flagSetMock{flags: map[string]interface{}{
+				"custom-field": []string{"Ticket"},
+			}},
+			err: `custom field "Ticket" must be in the format name=value`,
+		},
 		{
 			name: "should not be billable and not billable",
 			flags: &flagSetMock{flags: map[string]interface{}{
@@ -357,16 +391,54 @@ func TestGetValidateTimeEntry_ShouldValidate_UsingSettingsAndConfigs(
 		}
 	}
 
+	wCustomFieldsFn := func(
+		ws dto.WorkspaceSettings,
+		cfs ...dto.WorkspaceCustomField,
+	) func(t *testing.T) (cmdutil.Factory, *mocks.MockClient) {
+		return func(t *testing.T) (cmdutil.Factory, *mocks.MockClient) {
+			f := wSettingsFn(ws)(t).(*mocks.MockFactory)
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+
+			c.EXPECT().GetWorkspaceCustomFields(mock.Anything).
+				Return(cfs, nil)
+
+			return f, c
+		}
+	}
+
+	wCustomFieldsErrFn := func(err error) func(*testing.T) cmdutil.Factory {
+		return func(t *testing.T) cmdutil.Factory {
+			f := wSettingsFn(dto.WorkspaceSettings{})(t).(*mocks.MockFactory)
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+
+			c.EXPECT().GetWorkspaceCustomFields(mock.Anything).
+				Return(nil, err)
+
+			return f
+		}
+	}
+
+	wSettingsOnlyFn := func(
+		ws dto.WorkspaceSettings,
+		cfs ...dto.WorkspaceCustomField,
+	) func(t *testing.T) cmdutil.Factory {
+		return func(t *testing.T) cmdutil.Factory {
+			f, _ := wCustomFieldsFn(ws, cfs...)(t)
+			return f
+		}
+	}
+
 	wSettingsAndProjectFn := func(
 		w dto.WorkspaceSettings,
 		p *dto.Project,
 		err error,
 	) func(t *testing.T) cmdutil.Factory {
 		return func(t *testing.T) cmdutil.Factory {
-			f := wSettingsFn(w)(t).(*mocks.MockFactory)
-
-			c := mocks.NewMockClient(t)
-			f.EXPECT().Client().Return(c, nil)
+			f, c := wCustomFieldsFn(w)(t)
 
 			c.EXPECT().GetProject(mock.Anything).Return(p, err)
 
@@ -374,6 +446,14 @@ func TestGetValidateTimeEntry_ShouldValidate_UsingSettingsAndConfigs(
 		}
 	}
 
+	ticket := dto.WorkspaceCustomField{
+		ID:       "cf1",
+		Name:     "Ticket",
+		Type:     dto.CustomFieldTypeText,
+		Status:   dto.CustomFieldStatusVisible,
+		Required: true,
+	}
+
 	tts := []struct {
 		name    string
 		input   TimeEntryDTO
@@ -523,7 +603,57 @@ func TestGetValidateTimeEntry_ShouldValidate_UsingSettingsAndConfigs(
 				TaskID:      "task",
 				TagIDs:      []string{"tag"},
 			},
-			factory: wSettingsFn(dto.WorkspaceSettings{}),
+			factory: wSettingsOnlyFn(dto.WorkspaceSettings{}),
+		},
+		{
+			name:    "required custom field is missing",
+			input:   TimeEntryDTO{Workspace: "w"},
+			err:     `workspace requires custom field "Ticket"`,
+			factory: wSettingsOnlyFn(dto.WorkspaceSettings{}, ticket),
+		},
+		{
+			name: "required custom field is empty",
+			input: TimeEntryDTO{
+				Workspace: "w",
+				CustomFields: []dto.CustomFieldValue{
+					{CustomFieldID: "cf1", Value: nil},
+				},
+			},
+			err:     `workspace requires custom field "Ticket"`,
+			factory: wSettingsOnlyFn(dto.WorkspaceSettings{}, ticket),
+		},
+		{
+			name:    "custom fields can't be loaded",
+			input:   TimeEntryDTO{Workspace: "w"},
+			err:     "forbidden",
+			factory: wCustomFieldsErrFn(errors.New("forbidden")),
+		},
+		{
+			name:  "custom fields are not available offline",
+			input: TimeEntryDTO{Workspace: "w"},
+			factory: wCustomFieldsErrFn(fmt.Errorf(
+				"custom fields are %w", offline.ErrNotAvailable)),
+		},
+		{
+			name:  "required custom field is inactive",
+			input: TimeEntryDTO{Workspace: "w"},
+			factory: wSettingsOnlyFn(dto.WorkspaceSettings{},
+				dto.WorkspaceCustomField{
+					ID:       "cf1",
+					Name:     "Ticket",
+					Status:   dto.CustomFieldStatusInactive,
+					Required: true,
+				}),
+		},
+		{
+			name: "required custom field is filled",
+			input: TimeEntryDTO{
+				Workspace: "w",
+				CustomFields: []dto.CustomFieldValue{
+					{CustomFieldID: "cf1", Value: "TK-1"},
+				},
+			},
+			factory: wSettingsOnlyFn(dto.WorkspaceSettings{}, ticket),
 		},
 		{
 			name: "everything is right",
@@ -551,7 +681,7 @@ func TestGetValidateTimeEntry_ShouldValidate_UsingSettingsAndConfigs(
 		{
 			name:    "nothing is required",
 			input:   TimeEntryDTO{},
-			factory: wSettingsFn(dto.WorkspaceSettings{}),
+			factory: wSettingsOnlyFn(dto.WorkspaceSettings{}),
 		},
 	}
 
@@ -745,3 +875,134 @@ func TestGetAllowNameForIDsFn_ShouldBeQuiet_WhenInteractive(t *testing.T) {
 		return
 	}
 }
+
+func TestResolveCustomFieldsFn(t *testing.T) {
+	cfs := []dto.WorkspaceCustomField{
+		{ID: "cf1", Name: "Ticket", Type: dto.CustomFieldTypeText},
+		{ID: "cf2", Name: "Hours", Type: dto.CustomFieldTypeNumber},
+		{ID: "cf3", Name: "Done", Type: dto.CustomFieldTypeCheckbox},
+		{ID: "cf4", Name: "Link", Type: dto.CustomFieldTypeLink},
+		{ID: "cf5", Name: "Priority", Type: dto.CustomFieldTypeDropdownSingle,
+			AllowedValues: []string{"Low", "High"}},
+		{ID: "cf6", Name: "Labels", Type: dto.CustomFieldTypeDropdownMultiple,
+			AllowedValues: []string{"bug", "feature"}},
+		{ID: "cf7", Name: "Old", Type: dto.CustomFieldTypeText,
+			Status: dto.CustomFieldStatusInactive},
+	}
+
+	tts := []struct {
+		name   string
+		input  []dto.CustomFieldValue
+		output []dto.CustomFieldValue
+		err    string
+	}{
+		{
+			name: "typed values",
+			input: []dto.CustomFieldValue{
+				{CustomFieldID: "cf1", Value: "TK-1"},
+				{Name: "ticket", Value: "TK-2"},
+				{Name: "hours", Value: "1.5"},
+				{Name: "done", Value: "true"},
+				{Name: "cf4", Value: "https://example.com/TK-2"},
+				{Name: "priority", Value: "high"},
+				{Name: "labels", Value: "Bug, feature"},
+			},
+			output: []dto.CustomFieldValue{
+				{CustomFieldID: "cf1", Name: "Ticket",
+					Type: dto.CustomFieldTypeText, Value: "TK-2"},
+				{CustomFieldID: "cf2", Name: "Hours",
+					Type: dto.CustomFieldTypeNumber, Value: 1.5},
+				{CustomFieldID: "cf3", Name: "Done",
+					Type: dto.CustomFieldTypeCheckbox, Value: true},
+				{CustomFieldID: "cf4", Name: "Link",
+					Type:  dto.CustomFieldTypeLink,
+					Value: "https://example.com/TK-2"},
+				{CustomFieldID: "cf5", Name: "Priority",
+					Type: dto.CustomFieldTypeDropdownSingle, Value: "High"},
+				{CustomFieldID: "cf6", Name: "Labels",
+					Type:  dto.CustomFieldTypeDropdownMultiple,
+					Value: []string{"bug", "feature"}},
+			},
+		},
+		{
+			name:  "empty value clears",
+			input: []dto.CustomFieldValue{{Name: "hours", Value: ""}},
+			output: []dto.CustomFieldValue{{CustomFieldID: "cf2",
+				Name: "Hours", Type: dto.CustomFieldTypeNumber}},
+		},
+		{
+			name:  "not found",
+			input: []dto.CustomFieldValue{{Name: "sprint", Value: "1"}},
+			err:   `custom field "sprint" not found`,
+		},
+		{
+			name:  "inactive",
+			input: []dto.CustomFieldValue{{Name: "old", Value: "1"}},
+			err:   `custom field "old" not found`,
+		},
+		{
+			name:  "not a number",
+			input: []dto.CustomFieldValue{{Name: "hours", Value: "one"}},
+			err:   `custom field "Hours" must be a number`,
+		},
+		{
+			name:  "not a bool",
+			input: []dto.CustomFieldValue{{Name: "done", Value: "maybe"}},
+			err:   `custom field "Done" must be true or false`,
+		},
+		{
+			name:  "not a link",
+			input: []dto.CustomFieldValue{{Name: "link", Value: "TK-2"}},
+			err:   `custom field "Link" must be a link`,
+		},
+		{
+			name:  "not allowed",
+			input: []dto.CustomFieldValue{{Name: "labels", Value: "bug,docs"}},
+			err: `value "docs" is not allowed for custom field "Labels", ` +
+				`use one of: bug, feature`,
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			c := mocks.NewMockClient(t)
+			c.EXPECT().GetWorkspaceCustomFields(
+				api.GetWorkspaceCustomFieldsParam{Workspace: "w"}).
+				Return(cfs, nil).Once()
+
+			fn := ResolveCustomFieldsFn(c)
+			te, err := fn(TimeEntryDTO{
+				Workspace: "w", CustomFields: tt.input})
+			if tt.err != "" {
+				if assert.Error(t, err) {
+					assert.Regexp(t, tt.err, err.Error())
+				}
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.output, te.CustomFields)
+
+			_, err = fn(TimeEntryDTO{Workspace: "w",
+				CustomFields: []dto.CustomFieldValue{{Name: "Ticket"}}})
+			assert.NoError(t, err, "should not fetch the fields again")
+		})
+	}
+}
+
+func TestResolveCustomFieldsFn_ShouldNotFetch_WhenResolved(t *testing.T) {
+	c := mocks.NewMockClient(t)
+
+	te, err := ResolveCustomFieldsFn(c)(TimeEntryDTO{
+		CustomFields: []dto.CustomFieldValue{
+			{CustomFieldID: "cf1", Value: "TK-1"},
+		},
+	})
+
+	if assert.NoError(t, err) {
+		assert.Len(t, te.CustomFields, 1)
+	}
+}
@@ -1,10 +1,12 @@
 package util
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
 )
 
 // ValidateClosingTimeEntry checks if the current time entry will fail to be
@@ -21,6 +23,10 @@ func ValidateClosingTimeEntry(f cmdutil.Factory) Step {
 			UserID:    dto.UserID,
 		})
 
+		if errors.Is(err, offline.ErrRunningUnknown) {
+			return dto, nil
+		}
+
 		if te == nil || err != nil {
 			return dto, err
 		}
@@ -22,8 +22,12 @@ func GetValidateTimeEntryFn(f cmdutil.Factory) Step {
 }
 
 func validateTimeEntry(te TimeEntryDTO, f cmdutil.Factory) error {
+	// while offline the workspace and projects may not be known, so only the
+	// rules that can be loaded are checked
+	isOffline := f.Config().GetBool(cmdutil.CONF_OFFLINE)
+
 	w, err := f.GetWorkspace()
-	if err != nil {
+	if err != nil && !isOffline {
 		return err
 	}
 
@@ -43,21 +47,29 @@ func validateTimeEntry(te TimeEntryDTO, f cmdutil.Factory) error {
 		return errors.New("workspace requires at least one tag")
 	}
 
-	if te.ProjectID == "" {
-		return nil
-	}
-
 	c, err := f.Client()
 	if err != nil {
 		return err
 	}
 
+	if err := validateCustomFields(c, te); err != nil {
+		return err
+	}
+
+	if te.ProjectID == "" {
+		return nil
+	}
+
 	p, err := c.GetProject(api.GetProjectParam{
 		Workspace: te.Workspace,
 		ProjectID: te.ProjectID,
 	})
 
 	if err != nil {
+		if isOffline {
+			return nil
+		}
+
 		return err
 	}
 
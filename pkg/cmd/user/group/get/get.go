@@ -0,0 +1,118 @@
+package get
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/group/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/user-group"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdGet shows a user group and its members
+func NewCmdGet(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, output.UserGroupSummary) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "get <group>",
+		Aliases: []string{"show"},
+		Args:    cmdutil.RequiredNamedArgs("group"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			cmdcomplutil.NewUserGroupAutoComplete(f)),
+		Short: "Shows a user group and its members",
+		Example: heredoc.Docf(`
+			$ %[1]s developers
+			+--------------------------+------------+-----------------------------------------+
+			|            ID            |    NAME    |                  USERS                  |
+			+--------------------------+------------+-----------------------------------------+
+			| 63a5a5e2d8f1ad7bbed7ab2f | Developers | John Due (eeeeeeeeeeeeeeeeeeeeeeee)     |
+			|                          |            | John JD Due (ffffffffffffffffffffffff)  |
+			+--------------------------+------------+-----------------------------------------+
+
+			$ %[1]s developers --csv
+			id,name,user.id,user.name,user.email
+			63a5a5e2d8f1ad7bbed7ab2f,Developers,eeeeeeeeeeeeeeeeeeeeeeee,John Due,john@due.net
+			63a5a5e2d8f1ad7bbed7ab2f,Developers,ffffffffffffffffffffffff,John JD Due,due@john.net
+
+			$ %[1]s developers --format '{{ range .Users }}{{ .Email }}{{ "\n" }}{{ end }}'
+			john@due.net
+			due@john.net
+		`, "clockify-cli user group get"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := strings.TrimSpace(args[0])
+			if id == "" {
+				return errors.New("user group id should not be empty")
+			}
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if f.Config().IsAllowNameForID() {
+				if id, err = search.GetUserGroupByName(c, w, id); err != nil {
+					return err
+				}
+			}
+
+			gs, err := c.GetUserGroups(api.GetUserGroupsParam{
+				Workspace:       w,
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			var s *output.UserGroupSummary
+			for _, g := range gs {
+				if g.ID != id {
+					continue
+				}
+
+				us, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
+					Workspace:       w,
+					PaginationParam: api.AllPages(),
+				})
+				if err != nil {
+					return err
+				}
+
+				gs := output.NewUserGroupSummary(g, us)
+				s = &gs
+				break
+			}
+
+			if s == nil {
+				return api.EntityNotFound{EntityName: "user group", ID: id}
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, *s)
+			}
+
+			return util.ReportSummary(*s, cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,137 @@
+package get_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/group/get"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/group/util"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/user-group"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFactory(t *testing.T, allowNameForID bool) (
+	*mocks.MockFactory, *mocks.MockClient) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+	cf := mocks.NewMockConfig(t)
+	cf.EXPECT().IsAllowNameForID().Return(allowNameForID)
+	f.EXPECT().Config().Return(cf)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	return f, c
+}
+
+func TestCmdGet(t *testing.T) {
+	gs := []dto.UserGroup{
+		{ID: "g1", Name: "Admins", UserIDs: []string{"u1"}},
+		{ID: "g2", Name: "Developers", UserIDs: []string{"u1", "u2"}},
+	}
+
+	allGroups := api.GetUserGroupsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}
+
+	allUsers := api.WorkspaceUsersParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}
+
+	t.Run("group is required", func(t *testing.T) {
+		cmd := get.NewCmdGet(mocks.NewMockFactory(t), nil)
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		if assert.Error(t, err) {
+			assert.Regexp(t, "requires arg group", err.Error())
+		}
+	})
+
+	t.Run("http error", func(t *testing.T) {
+		f, c := newFactory(t, false)
+		c.EXPECT().GetUserGroups(allGroups).
+			Return(nil, errors.New("http error"))
+
+		cmd := get.NewCmdGet(f, nil)
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{"g1"})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "http error")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		f, c := newFactory(t, false)
+		c.EXPECT().GetUserGroups(allGroups).Return(gs, nil)
+
+		cmd := get.NewCmdGet(f, nil)
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{"g3"})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "user group with id g3 was not found")
+	})
+
+	t.Run("by name with members", func(t *testing.T) {
+		f, c := newFactory(t, true)
+		c.EXPECT().GetUserGroups(allGroups).Return(gs, nil)
+		c.EXPECT().WorkspaceUsers(allUsers).Return([]dto.User{
+			{ID: "u1", Name: "John Due", Email: "john@due.net"},
+			{ID: "u2", Name: "John JD Due", Email: "due@john.net"},
+		}, nil)
+
+		called := false
+		cmd := get.NewCmdGet(f, func(
+			_ io.Writer, _ *util.OutputFlags, s output.UserGroupSummary) error {
+			called = true
+			assert.Equal(t, output.UserGroupSummary{
+				UserGroup: gs[1],
+				Users: []output.MemberSummary{
+					{ID: "u1", Name: "John Due", Email: "john@due.net"},
+					{ID: "u2", Name: "John JD Due", Email: "due@john.net"},
+				},
+			}, s)
+			return nil
+		})
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{"dev"})
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+		assert.True(t, called, "was not called")
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		f, c := newFactory(t, false)
+		c.EXPECT().GetUserGroups(allGroups).Return(gs, nil)
+		c.EXPECT().WorkspaceUsers(allUsers).Return([]dto.User{
+			{ID: "u1", Name: "John Due", Email: "john@due.net"},
+		}, nil)
+
+		b := bytes.NewBufferString("")
+		cmd := get.NewCmdGet(f, nil)
+		cmd.SilenceUsage = true
+		cmd.SetOut(b)
+		cmd.SetArgs([]string{"g1", "--csv"})
+
+		_, err := cmd.ExecuteC()
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t,
+			"id,name,user.id,user.name,user.email\n"+
+				"g1,Admins,u1,John Due,john@due.net\n",
+			b.String())
+	})
+}
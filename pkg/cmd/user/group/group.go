@@ -0,0 +1,22 @@
+package group
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/group/get"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/group/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdGroup represents the user group command
+func NewCmdGroup(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "group",
+		Aliases: []string{"groups"},
+		Short:   "Work with the user groups of a Clockify workspace",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(get.NewCmdGet(f, nil))
+
+	return cmd
+}
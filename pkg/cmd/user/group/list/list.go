@@ -0,0 +1,80 @@
+package list
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/group/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList represents the list command
+func NewCmdList(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.UserGroup) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List user groups from a Clockify workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s
+			+--------------------------+------------+-------+
+			|            ID            |    NAME    | USERS |
+			+--------------------------+------------+-------+
+			| 63a5a5c9d8f1ad7bbed7ab0b | Admins     |     1 |
+			| 63a5a5e2d8f1ad7bbed7ab2f | Developers |     3 |
+			+--------------------------+------------+-------+
+
+			$ %[1]s --name dev --csv
+			id,name,users
+			63a5a5e2d8f1ad7bbed7ab2f,Developers,3
+
+			$ %[1]s --format "{{ .Name }}: {{ len .UserIDs }}"
+			Admins: 1
+			Developers: 3
+		`, "clockify-cli user group list"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			p := api.GetUserGroupsParam{
+				PaginationParam: api.AllPages(),
+			}
+
+			var err error
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			p.Name, _ = cmd.Flags().GetString("name")
+			gs, err := c.GetUserGroups(p)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, gs)
+			}
+
+			return util.Report(gs, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringP("name", "n", "",
+		"will be used to filter the user groups by name")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,158 @@
+package list_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/group/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/group/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type report func(io.Writer, *util.OutputFlags, []dto.UserGroup) error
+
+func TestCmdList(t *testing.T) {
+	defReport := func(io.Writer, *util.OutputFlags, []dto.UserGroup) error {
+		return errors.New("should not call")
+	}
+
+	gs := []dto.UserGroup{{ID: "g1", Name: "Developers"}}
+	tts := []struct {
+		name    string
+		args    []string
+		factory func(*testing.T) (cmdutil.Factory, report)
+		err     string
+	}{
+		{
+			name: "only one format",
+			args: []string{"--format={}", "-q", "-j"},
+			err:  "flags can't be used together.*format.*json.*quiet",
+			factory: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), defReport
+			},
+		},
+		{
+			name: "workspace error",
+			err:  "workspace error",
+			factory: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").
+					Return("", errors.New("workspace error"))
+				return f, defReport
+			},
+		},
+		{
+			name: "client error",
+			err:  "client error",
+			factory: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").
+					Return("w", nil)
+				f.On("Client").Return(nil, errors.New("client error"))
+				return f, defReport
+			},
+		},
+		{
+			name: "http error",
+			err:  "http error",
+			factory: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").
+					Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("GetUserGroups", api.GetUserGroupsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).
+					Return([]dto.UserGroup{}, errors.New("http error"))
+				return f, defReport
+			},
+		},
+		{
+			name: "filter by name",
+			args: []string{"--name=dev", "-q"},
+			factory: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").
+					Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("GetUserGroups", api.GetUserGroupsParam{
+					Workspace:       "w",
+					Name:            "dev",
+					PaginationParam: api.AllPages(),
+				}).
+					Return(gs, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, of *util.OutputFlags, l []dto.UserGroup) error {
+					called = true
+					assert.True(t, of.Quiet)
+					assert.Equal(t, gs, l)
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			f, r := tt.factory(t)
+			cmd := list.NewCmdList(f, r)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
+
+func TestCmdListCSV(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.On("GetWorkspaceID").Return("w", nil)
+
+	c := mocks.NewMockClient(t)
+	f.On("Client").Return(c, nil)
+	c.On("GetUserGroups", api.GetUserGroupsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.UserGroup{
+		{ID: "g1", Name: "Developers", UserIDs: []string{"u1", "u2"}},
+		{ID: "g2", Name: "Admins", UserIDs: []string{"u1"}},
+	}, nil)
+
+	b := bytes.NewBufferString("")
+	cmd := list.NewCmdList(f, nil)
+	cmd.SilenceUsage = true
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"--csv"})
+
+	_, err := cmd.ExecuteC()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t,
+		"id,name,users\n"+
+			"g1,Developers,2\n"+
+			"g2,Admins,1\n",
+		b.String())
+}
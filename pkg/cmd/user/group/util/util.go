@@ -0,0 +1,69 @@
+package util
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/user-group"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out a list of user groups
+type OutputFlags struct {
+	Format string
+	CSV    bool
+	JSON   bool
+	Quiet  bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"csv":    of.CSV,
+		"quiet":  of.Quiet,
+	})
+}
+
+// AddReportFlags adds the default output flags for user groups
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each user group")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
+}
+
+// Report prints out the user groups
+func Report(gs []dto.UserGroup, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.UserGroupsJSONPrint(gs, out)
+	case of.CSV:
+		return output.UserGroupsCSVPrint(gs, out)
+	case of.Format != "":
+		return output.UserGroupPrintWithTemplate(of.Format)(gs, out)
+	case of.Quiet:
+		return output.UserGroupPrintQuietly(gs, out)
+	default:
+		return output.UserGroupPrint(gs, out)
+	}
+}
+
+// ReportSummary prints out the user group and its members
+func ReportSummary(
+	s output.UserGroupSummary, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.UserGroupSummaryJSONPrint(s, out)
+	case of.CSV:
+		return output.UserGroupSummaryCSVPrint(s, out)
+	case of.Format != "":
+		return output.UserGroupSummaryPrintWithTemplate(of.Format)(s, out)
+	case of.Quiet:
+		return output.UserGroupPrintQuietly([]dto.UserGroup{s.UserGroup}, out)
+	default:
+		return output.UserGroupSummaryPrint(s, out)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/group"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/me"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
@@ -86,6 +87,7 @@ func NewCmdUser(
 	_ = cmd.MarkFlagRequired("workspace")
 
 	cmd.AddCommand(me.NewCmdMe(f, nil))
+	cmd.AddCommand(group.NewCmdGroup(f))
 
 	return cmd
 }
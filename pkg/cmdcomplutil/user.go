@@ -45,3 +45,40 @@ func NewUserAutoComplete(f factory) cmdcompl.SuggestFn {
 		return va, nil
 	}
 }
+
+// NewUserGroupAutoComplete will provice auto-completion for flags or args
+func NewUserGroupAutoComplete(f factory) cmdcompl.SuggestFn {
+	return func(
+		cmd *cobra.Command, args []string, toComplete string,
+	) (cmdcompl.ValidArgs, error) {
+		w, err := f.GetWorkspaceID()
+		if err != nil {
+			return cmdcompl.EmptyValidArgs(), err
+		}
+
+		c, err := f.Client()
+		if err != nil {
+			return cmdcompl.EmptyValidArgs(), err
+		}
+
+		gs, err := c.GetUserGroups(api.GetUserGroupsParam{
+			Workspace:       w,
+			PaginationParam: api.AllPages(),
+		})
+
+		if err != nil {
+			return cmdcompl.EmptyValidArgs(), err
+		}
+
+		va := make(cmdcompl.ValidArgsMap)
+		toComplete = strings.ToLower(toComplete)
+		for i := range gs {
+			if toComplete != "" && !strings.Contains(gs[i].ID, toComplete) {
+				continue
+			}
+			va.Set(gs[i].ID, gs[i].Name)
+		}
+
+		return va, nil
+	}
+}
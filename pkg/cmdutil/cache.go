@@ -0,0 +1,35 @@
+package cmdutil
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
+)
+
+// NewCacheStore creates the cache.Store set by the configs cache-dir and
+// cache-ttl, for the account of the configs token and api-url
+func NewCacheStore(c Config) (*cache.Store, error) {
+	ttl, err := GetDuration(c, CONF_CACHE_TTL, DefaultCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := c.GetString(CONF_CACHE_DIR)
+	if dir == "" {
+		if dir, err = cache.DefaultDir(); err != nil {
+			return nil, err
+		}
+	}
+
+	return cache.NewStore(dir, ttl).
+		ForAccount(c.GetString(CONF_TOKEN), APIURL(c)), nil
+}
+
+// JournalPath returns the file set by the config journal-file, where the
+// changes made offline are recorded
+func JournalPath(c Config) (string, error) {
+	if p := c.GetString(CONF_JOURNAL_FILE); p != "" {
+		return p, nil
+	}
+
+	return offline.DefaultPath()
+}
@@ -1,10 +1,14 @@
 package cmdutil
 
 import (
+	"fmt"
+	"net/url"
 	"path"
 	"strings"
 	"time"
 
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 	"github.com/lucassabreu/clockify-cli/strhlp"
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/viper"
@@ -12,6 +16,8 @@ import (
 
 const (
 	CONF_WORKWEEK_DAYS         = "workweek-days"
+	CONF_WORKWEEK_HOURS        = "workweek-hours"
+	CONF_HOLIDAYS_FILE         = "holidays-file"
 	CONF_INTERACTIVE           = "interactive"
 	CONF_ALLOW_NAME_FOR_ID     = "allow-name-for-id"
 	CONF_USER_ID               = "user.id"
@@ -23,8 +29,22 @@ const (
 	CONF_DESCR_AUTOCOMP_DAYS   = "description-autocomplete-days"
 	CONF_SHOW_TOTAL_DURATION   = "show-total-duration"
 	CONF_LOG_LEVEL             = "log-level"
+	CONF_LOG_FORMAT            = "log-format"
+	CONF_LOG_FILE              = "log-file"
 	CONF_ALLOW_ARCHIVED_TAGS   = "allow-archived-tags"
 	CONF_INTERACTIVE_PAGE_SIZE = "interactive-page-size"
+	CONF_MAX_RETRIES           = "max-retries"
+	CONF_RATE_LIMIT            = "rate-limit"
+	CONF_REQUEST_TIMEOUT       = "request-timeout"
+	CONF_TIMEOUT               = "timeout"
+	CONF_API_URL               = "api-url"
+	CONF_REPORTS_API_URL       = "reports-api-url"
+	CONF_NO_CACHE              = "no-cache"
+	CONF_CACHE_TTL             = "cache-ttl"
+	CONF_CACHE_DIR             = "cache-dir"
+	CONF_OFFLINE               = "offline"
+	CONF_JOURNAL_FILE          = "journal-file"
+	CONF_CONCURRENCY           = "concurrency"
 )
 
 const (
@@ -63,6 +83,9 @@ type Config interface {
 	IsInteractive() bool
 	// GetWorkWeekdays set which days of the week the user is expected to work
 	GetWorkWeekdays() []string
+	// GetWorkweekHours set how many hours the user is expected to work on
+	// each day of the week
+	GetWorkweekHours() map[time.Weekday]time.Duration
 	// InteractivePageSize sets how many items are shown when prompting
 	// projects
 	InteractivePageSize() int
@@ -79,7 +102,75 @@ type Config interface {
 	Save() error
 }
 
-type config struct{}
+// DefaultRequestTimeout is how long a request to Clockify can take when the
+// config request-timeout is not set
+const DefaultRequestTimeout = 30 * time.Second
+
+// DefaultConcurrency is how many pages are fetched at the same time when the
+// config concurrency is not set
+const DefaultConcurrency = 4
+
+// Concurrency returns how many requests can be made at the same time, set by
+// the config concurrency, never less than one
+func Concurrency(c Config) int {
+	if c.Get(CONF_CONCURRENCY) == nil {
+		return DefaultConcurrency
+	}
+
+	if n := c.GetInt(CONF_CONCURRENCY); n > 1 {
+		return n
+	}
+
+	return 1
+}
+
+// DefaultCacheTTL is how long projects, tasks, tags, clients and users are
+// kept on the local cache when the config cache-ttl is not set
+const DefaultCacheTTL = time.Hour
+
+// GetDuration reads a config as a duration (like "30s" or "2m"), returning
+// def if it is not set
+func GetDuration(c Config, param string, def time.Duration) (
+	time.Duration, error) {
+	v := c.GetString(param)
+	if v == "" {
+		return def, nil
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("config %s is not a valid duration: %s", param, v)
+	}
+
+	return d, nil
+}
+
+// APIURL returns the base URL of the Clockify API set by the config api-url,
+// or the global one when not set
+func APIURL(c Config) string {
+	if u := c.GetString(CONF_API_URL); u != "" {
+		return u
+	}
+
+	return api.GlobalRegion.APIURL
+}
+
+// IsValidURL checks if the value can be used as the base URL of an API
+func IsValidURL(v string) bool {
+	u, err := url.Parse(v)
+	return err == nil && u.Host != "" &&
+		(u.Scheme == "http" || u.Scheme == "https")
+}
+
+type config struct {
+	v *viper.Viper
+}
+
+// NewConfig creates a Config which reads and writes on the viper instance,
+// the CLI uses the global one
+func NewConfig(v *viper.Viper) Config {
+	return &config{v: v}
+}
 
 func (c *config) InteractivePageSize() int {
 	i := c.GetInt(CONF_INTERACTIVE_PAGE_SIZE)
@@ -99,36 +190,36 @@ func (c *config) LogLevel() string {
 	}
 }
 
-func (*config) GetBool(param string) bool {
-	return viper.GetBool(param)
+func (c *config) GetBool(param string) bool {
+	return c.v.GetBool(param)
 }
 
-func (*config) SetBool(p string, b bool) {
-	viper.Set(p, b)
+func (c *config) SetBool(p string, b bool) {
+	c.v.Set(p, b)
 }
 
-func (*config) GetString(param string) string {
-	return viper.GetString(param)
+func (c *config) GetString(param string) string {
+	return c.v.GetString(param)
 }
 
-func (*config) SetString(p, s string) {
-	viper.Set(p, s)
+func (c *config) SetString(p, s string) {
+	c.v.Set(p, s)
 }
 
-func (*config) GetInt(param string) int {
-	return viper.GetInt(param)
+func (c *config) GetInt(param string) int {
+	return c.v.GetInt(param)
 }
 
-func (*config) SetInt(p string, i int) {
-	viper.Set(p, i)
+func (c *config) SetInt(p string, i int) {
+	c.v.Set(p, i)
 }
 
-func (*config) GetStringSlice(param string) []string {
-	return viper.GetStringSlice(param)
+func (c *config) GetStringSlice(param string) []string {
+	return c.v.GetStringSlice(param)
 }
 
-func (*config) SetStringSlice(p string, ss []string) {
-	viper.Set(p, ss)
+func (c *config) SetStringSlice(p string, ss []string) {
+	c.v.Set(p, ss)
 }
 
 func (c *config) IsDebuging() bool {
@@ -139,6 +230,30 @@ func (c *config) GetWorkWeekdays() []string {
 	return strhlp.Map(strings.ToLower, c.GetStringSlice(CONF_WORKWEEK_DAYS))
 }
 
+// DefaultWorkdayHours is how many hours are expected on days set on
+// CONF_WORKWEEK_DAYS without hours set on CONF_WORKWEEK_HOURS
+const DefaultWorkdayHours = 8 * time.Hour
+
+func (c *config) GetWorkweekHours() map[time.Weekday]time.Duration {
+	days := c.GetWorkWeekdays()
+	hs := make(map[time.Weekday]time.Duration, len(GetWeekdays()))
+	for i, d := range GetWeekdays() {
+		wd := time.Weekday(i)
+		if v := c.GetString(CONF_WORKWEEK_HOURS + "." + d); v != "" {
+			if h, err := timehlp.ParseHours(v); err == nil {
+				hs[wd] = h
+				continue
+			}
+		}
+
+		if strhlp.InSlice(d, days) {
+			hs[wd] = DefaultWorkdayHours
+		}
+	}
+
+	return hs
+}
+
 func (c *config) IsAllowNameForID() bool {
 	return c.GetBool(CONF_ALLOW_NAME_FOR_ID)
 }
@@ -147,16 +262,16 @@ func (c *config) IsInteractive() bool {
 	return c.GetBool(CONF_INTERACTIVE)
 }
 
-func (*config) Get(p string) interface{} {
-	return viper.Get(p)
+func (c *config) Get(p string) interface{} {
+	return c.v.Get(p)
 }
 
-func (*config) All() map[string]interface{} {
-	return viper.AllSettings()
+func (c *config) All() map[string]interface{} {
+	return c.v.AllSettings()
 }
 
-func (*config) Save() error {
-	filename := viper.ConfigFileUsed()
+func (c *config) Save() error {
+	filename := c.v.ConfigFileUsed()
 	if filename == "" {
 		home, err := homedir.Dir()
 		if err != nil {
@@ -166,12 +281,12 @@ func (*config) Save() error {
 		filename = path.Join(home, ".clockify-cli.yaml")
 	}
 
-	return viper.WriteConfigAs(filename)
+	return c.v.WriteConfigAs(filename)
 }
 
 func configFunc() func() (c Config) {
 	return func() Config {
-		return &config{}
+		return NewConfig(viper.GetViper())
 	}
 }
 
@@ -0,0 +1,87 @@
+package cmdutil
+
+import (
+	"errors"
+	"net"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// Exit codes of the CLI, scripts can use them to know why it failed
+const (
+	ExitOK           = 0
+	ExitError        = 1
+	ExitCancel       = 2
+	ExitUnauthorized = 3
+	ExitForbidden    = 4
+	ExitNotFound     = 5
+	ExitBadRequest   = 6
+	ExitConflict     = 7
+	ExitRateLimited  = 8
+	ExitServerError  = 9
+	ExitNetworkError = 10
+)
+
+var exitCodes = []struct {
+	err  error
+	code int
+	hint string
+}{
+	{
+		err:  dto.ErrUnauthorized,
+		code: ExitUnauthorized,
+		hint: "the token was not accepted, check it or run " +
+			"`clockify-cli config init` to set a new one",
+	},
+	{
+		err:  dto.ErrForbidden,
+		code: ExitForbidden,
+		hint: "the user of the token is not allowed to do it, check if the " +
+			"workspace is the right one",
+	},
+	{err: dto.ErrNotFound, code: ExitNotFound},
+	{err: dto.ErrBadRequest, code: ExitBadRequest},
+	{err: dto.ErrConflict, code: ExitConflict},
+	{
+		err:  dto.ErrRateLimited,
+		code: ExitRateLimited,
+		hint: "clockify is limiting the requests, try again later or set " +
+			"the config `" + CONF_RATE_LIMIT + "` to send fewer of them",
+	},
+	{
+		err:  dto.ErrServer,
+		code: ExitServerError,
+		hint: "clockify failed to answer, try again later",
+	},
+}
+
+// ExitCode returns which exit code the CLI should use for the error
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+
+	for _, e := range exitCodes {
+		if errors.Is(err, e.err) {
+			return e.code
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ExitNetworkError
+	}
+
+	return ExitError
+}
+
+// ErrorHint returns a suggestion of how to fix the error, if there is one
+func ErrorHint(err error) string {
+	for _, e := range exitCodes {
+		if errors.Is(err, e.err) {
+			return e.hint
+		}
+	}
+
+	return ""
+}
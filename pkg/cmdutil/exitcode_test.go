@@ -0,0 +1,88 @@
+package cmdutil_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitCode(t *testing.T) {
+	tts := []struct {
+		name string
+		err  error
+		code int
+		hint string
+	}{
+		{name: "no error", code: cmdutil.ExitOK},
+		{
+			name: "other errors",
+			err:  errors.New("failed"),
+			code: cmdutil.ExitError,
+		},
+		{
+			name: "unauthorized",
+			err: fmt.Errorf("get me: %w",
+				dto.Error{Code: 4003, Message: "wrong token", Status: 401}),
+			code: cmdutil.ExitUnauthorized,
+			hint: "config init",
+		},
+		{
+			name: "forbidden",
+			err:  dto.Error{Code: 403, Message: "Forbidden"},
+			code: cmdutil.ExitForbidden,
+			hint: "workspace",
+		},
+		{
+			name: "not found",
+			err:  dto.Error{Code: 404, Status: 404},
+			code: cmdutil.ExitNotFound,
+		},
+		{
+			name: "validation",
+			err:  dto.Error{Code: 501, Status: 400},
+			code: cmdutil.ExitBadRequest,
+		},
+		{
+			name: "conflict",
+			err:  dto.Error{Code: 409, Status: 409},
+			code: cmdutil.ExitConflict,
+		},
+		{
+			name: "rate limited",
+			err:  dto.Error{Code: 429, Status: 429},
+			code: cmdutil.ExitRateLimited,
+			hint: "rate-limit",
+		},
+		{
+			name: "server error",
+			err:  dto.Error{Code: 502, Status: 502},
+			code: cmdutil.ExitServerError,
+			hint: "try again later",
+		},
+		{
+			name: "network",
+			err: fmt.Errorf("get me: %w", &net.OpError{
+				Op: "dial", Err: errors.New("refused")}),
+			code: cmdutil.ExitNetworkError,
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.code, cmdutil.ExitCode(tt.err))
+
+			hint := cmdutil.ErrorHint(tt.err)
+			if tt.hint == "" {
+				assert.Empty(t, hint)
+				return
+			}
+
+			assert.Contains(t, hint, tt.hint)
+		})
+	}
+}
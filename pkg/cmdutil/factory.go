@@ -1,11 +1,17 @@
 package cmdutil
 
 import (
-	"log"
+	"context"
+	"io"
+	"math"
 	"os"
+	"time"
 
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/lucassabreu/clockify-cli/pkg/logging"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
 	"github.com/lucassabreu/clockify-cli/pkg/ui"
 )
 
@@ -16,6 +22,9 @@ type Factory interface {
 
 	// Config returns configurations set by the user
 	Config() Config
+	// Context is used by the client on its requests, it is cancelled when
+	// the operation timeout (config timeout) is reached
+	Context() context.Context
 	// Client builds a client for Clockify's API
 	Client() (api.Client, error)
 	// UI builds a control to prompt information from the user
@@ -27,14 +36,25 @@ type Factory interface {
 	GetWorkspaceID() (string, error)
 	// GetWorkspaceID returns the current workspace
 	GetWorkspace() (dto.Workspace, error)
+
+	// Close releases the resources held by the factory, it should be called
+	// when the command finishes
+	Close() error
 }
 
 type factory struct {
 	version func() Version
 
-	config func() Config
-	client func() (api.Client, error)
-	ui     func() ui.UI
+	config  func() Config
+	context func() context.Context
+	client  func() (api.Client, error)
+	ui      func() ui.UI
+
+	// cancelTimeout releases the operation deadline, it is called by Close
+	cancelTimeout context.CancelFunc
+	// logFile is the log-file opened for the client logger, it is closed by
+	// Close
+	logFile io.Closer
 
 	getUserID      func() (string, error)
 	getWorkspaceID func() (string, error)
@@ -49,6 +69,10 @@ func (f *factory) Config() Config {
 	return f.config()
 }
 
+func (f *factory) Context() context.Context {
+	return f.context()
+}
+
 func (f *factory) Client() (api.Client, error) {
 	return f.client()
 }
@@ -69,12 +93,28 @@ func (f *factory) GetWorkspace() (dto.Workspace, error) {
 	return f.getWorkspace()
 }
 
-func NewFactory(v Version) Factory {
+func (f *factory) Close() error {
+	if f.cancelTimeout != nil {
+		f.cancelTimeout()
+	}
+
+	if f.logFile != nil {
+		return f.logFile.Close()
+	}
+
+	return nil
+}
+
+// NewFactory creates a Factory, ctx should be cancelled when the user
+// interrupts the CLI
+func NewFactory(ctx context.Context, v Version) Factory {
 	f := &factory{
 		version: func() Version { return v },
 		config:  configFunc(),
 	}
 
+	f.context = contextFunc(ctx, f)
+
 	f.ui = getUi(f)
 
 	f.client = clientFunc(f)
@@ -172,7 +212,24 @@ func getWorkspaceIDFunc(f Factory) func() (string, error) {
 	}
 }
 
-func clientFunc(f Factory) func() (api.Client, error) {
+func contextFunc(parent context.Context, f *factory) func() context.Context {
+	var ctx context.Context
+
+	return func() context.Context {
+		if ctx != nil {
+			return ctx
+		}
+
+		ctx = parent
+		if d, err := GetDuration(f.Config(), CONF_TIMEOUT, 0); err == nil && d > 0 {
+			ctx, f.cancelTimeout = context.WithTimeout(parent, d)
+		}
+
+		return ctx
+	}
+}
+
+func clientFunc(f *factory) func() (api.Client, error) {
 	var c api.Client
 	var err error
 
@@ -181,30 +238,129 @@ func clientFunc(f Factory) func() (api.Client, error) {
 			return c, err
 		}
 
-		c, err = api.NewClient(f.Config().GetString(CONF_TOKEN))
+		var timeout time.Duration
+		if timeout, err = GetDuration(f.Config(), CONF_REQUEST_TIMEOUT,
+			DefaultRequestTimeout); err != nil {
+			return c, err
+		}
+
+		if _, err = GetDuration(f.Config(), CONF_TIMEOUT, 0); err != nil {
+			return c, err
+		}
+
+		c, err = api.NewClientFromUrlAndKey(
+			f.Config().GetString(CONF_TOKEN), APIURL(f.Config()))
 		if err != nil {
 			return c, err
 		}
 
-		ll := f.Config().LogLevel()
-		if ll == LOG_LEVEL_NONE {
+		rp := api.DefaultRetryPolicy
+		if f.Config().Get(CONF_MAX_RETRIES) != nil {
+			rp.MaxRetries = f.Config().GetInt(CONF_MAX_RETRIES)
+		}
+		if f.Config().GetBool(CONF_OFFLINE) {
+			// clockify is not expected to be reachable, so don't wait for it
+			rp.MaxRetries = 0
+		}
+		c.SetRetryPolicy(rp)
+		c.SetRateLimit(f.Config().GetInt(CONF_RATE_LIMIT))
+		c.SetConcurrency(Concurrency(f.Config()))
+		c.SetRequestTimeout(timeout)
+		c = c.WithContext(f.Context())
+
+		if c, err = withCache(f.Config(), c); err != nil {
+			return c, err
+		}
+
+		if c, err = withOffline(f.Config(), c); err != nil {
 			return c, err
 		}
 
-		c.SetInfoLogger(
-			log.New(os.Stdout, "INFO  ", log.LstdFlags),
-		)
+		if f.Config().LogLevel() == LOG_LEVEL_NONE {
+			return c, err
+		}
 
-		if ll == LOG_LEVEL_INFO {
+		var l *logging.Logger
+		if l, f.logFile, err = newLogger(f.Config()); err != nil {
 			return c, err
 		}
 
-		c.SetDebugLogger(
-			log.New(os.Stdout, "DEBUG ", log.LstdFlags),
-		)
+		c = c.SetStructuredLogger(l)
+		return c, err
+	}
+}
+
+// newLogger creates the logger of the client, it writes into the config
+// log-file or stderr, removing the token from the entries. The log-file is
+// returned to be closed when the logger is not needed anymore
+func newLogger(conf Config) (*logging.Logger, io.Closer, error) {
+	format := conf.GetString(CONF_LOG_FORMAT)
+	level := api.LogInfo
+	if conf.LogLevel() == LOG_LEVEL_DEBUG {
+		level = api.LogDebug
+	}
+
+	p := conf.GetString(CONF_LOG_FILE)
+	if p == "" {
+		l, err := logging.New(
+			os.Stderr, format, level, conf.GetString(CONF_TOKEN))
+		return l, nil, err
+	}
+
+	f, err := os.OpenFile(p, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l, err := logging.New(f, format, level, conf.GetString(CONF_TOKEN))
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
 
+	return l, f, nil
+}
+
+// withCache wraps the client with the local cache, unless it is disabled by
+// the config no-cache or by a cache-ttl of zero
+func withCache(conf Config, c api.Client) (api.Client, error) {
+	isOffline := conf.GetBool(CONF_OFFLINE)
+	if conf.GetBool(CONF_NO_CACHE) && !isOffline {
+		return c, nil
+	}
+
+	s, err := NewCacheStore(conf)
+	if err != nil {
+		return c, err
+	}
+
+	if isOffline {
+		// while offline the cache is all there is, so it does not expire
+		s = s.WithTTL(math.MaxInt64)
+	} else if s.TTL() == 0 {
+		return c, nil
+	}
+
+	return cache.NewClient(c, s), nil
+}
+
+// withOffline wraps the client with the offline journal, when the config
+// offline is set changes on time entries are recorded instead of sent.
+//
+// While online the journal keeps the user, the workspace and the recent time
+// entries of the user, so they are available when offline
+func withOffline(conf Config, c api.Client) (api.Client, error) {
+	isOffline := conf.GetBool(CONF_OFFLINE)
+	p, err := JournalPath(conf)
+	if err != nil && !isOffline {
+		return c, nil
+	}
+
+	if err != nil {
 		return c, err
 	}
+
+	return offline.NewClient(c, p, isOffline), nil
 }
 
 func getUi(f Factory) func() ui.UI {
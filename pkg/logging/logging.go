@@ -0,0 +1,136 @@
+// Package logging writes the log entries of api.Client as text or JSON,
+// removing secrets from them, so they can be attached to bug reports
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+)
+
+// Formats in which the entries can be written
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Logger writes the entries as lines of text or JSON, replacing the secrets
+// by api.RedactedValue
+type Logger struct {
+	m       sync.Mutex
+	out     io.Writer
+	json    bool
+	debug   bool
+	secrets []string
+}
+
+// New creates a Logger for the format and level, empty secrets are ignored
+func New(
+	out io.Writer, format string, level api.LogLevel, secrets ...string,
+) (*Logger, error) {
+	if format != "" && format != FormatText && format != FormatJSON {
+		return nil, fmt.Errorf(
+			"log format should be %s or %s, not %s",
+			FormatText, FormatJSON, format)
+	}
+
+	l := &Logger{
+		out:   out,
+		json:  format == FormatJSON,
+		debug: level == api.LogDebug,
+	}
+
+	for _, s := range secrets {
+		if s != "" {
+			l.secrets = append(l.secrets, s)
+		}
+	}
+
+	return l, nil
+}
+
+// Enabled tells if entries of the level will be written
+func (l *Logger) Enabled(level api.LogLevel) bool {
+	return level == api.LogInfo || l.debug
+}
+
+// Log writes the entry, if its level is enabled
+func (l *Logger) Log(level api.LogLevel, msg string, f api.Fields) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	t := time.Now().UTC().Format(time.RFC3339Nano)
+
+	var line string
+	if l.json {
+		line = jsonLine(t, level, msg, f)
+	} else {
+		line = textLine(t, level, msg, f)
+	}
+
+	for _, s := range l.secrets {
+		line = strings.ReplaceAll(line, s, api.RedactedValue)
+	}
+
+	l.m.Lock()
+	defer l.m.Unlock()
+	_, _ = io.WriteString(l.out, line+"\n")
+}
+
+func jsonLine(t string, level api.LogLevel, msg string, f api.Fields) string {
+	e := make(map[string]interface{}, len(f)+3)
+	for k, v := range f {
+		e[k] = v
+	}
+	e["time"] = t
+	e["level"] = level
+	e["msg"] = msg
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		b, _ = json.Marshal(map[string]interface{}{
+			"time":  t,
+			"level": level,
+			"msg":   msg,
+			"error": "fields could not be encoded: " + err.Error(),
+		})
+	}
+
+	return string(b)
+}
+
+func textLine(t string, level api.LogLevel, msg string, f api.Fields) string {
+	keys := make([]string, 0, len(f))
+	for k := range f {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	b := strings.Builder{}
+	b.WriteString("time=" + t)
+	b.WriteString(" level=" + string(level))
+	b.WriteString(" msg=" + quote(msg))
+	for _, k := range keys {
+		b.WriteString(" " + k + "=" + quote(fmt.Sprint(f[k])))
+	}
+
+	return b.String()
+}
+
+// quote puts the value between quotes when it has spaces or symbols that
+// would make the line ambiguous
+func quote(s string) string {
+	if s == "" || strings.ContainsAny(s, " =\"\t\n\r") {
+		return strconv.Quote(s)
+	}
+
+	return s
+}
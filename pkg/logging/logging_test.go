@@ -0,0 +1,74 @@
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggerText(t *testing.T) {
+	b := bytes.NewBufferString("")
+	l, err := logging.New(b, logging.FormatText, api.LogInfo, "a-token", "")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.True(t, l.Enabled(api.LogInfo))
+	assert.False(t, l.Enabled(api.LogDebug))
+
+	l.Log(api.LogInfo, "request", api.Fields{
+		"name":   "GetMe",
+		"status": 200,
+		"url":    "http://localhost/v1/user?key=a-token",
+		"error":  "it has spaces",
+	})
+	l.Log(api.LogDebug, "not shown", nil)
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if !assert.Len(t, lines, 1) {
+		return
+	}
+
+	assert.Regexp(t, "^time=[^ ]+ level=info msg=request "+
+		`error="it has spaces" name=GetMe status=200 `+
+		`url="http://localhost/v1/user\?key=\[REDACTED\]"$`, lines[0])
+}
+
+func TestLoggerJSON(t *testing.T) {
+	b := bytes.NewBufferString("")
+	l, err := logging.New(b, logging.FormatJSON, api.LogDebug, "a-token")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.True(t, l.Enabled(api.LogDebug))
+
+	l.Log(api.LogDebug, "request", api.Fields{
+		"status":        401,
+		"response_body": `{"message":"a-token is not valid"}`,
+	})
+
+	var e map[string]interface{}
+	if !assert.NoError(t, json.Unmarshal(b.Bytes(), &e)) {
+		return
+	}
+
+	assert.NotEmpty(t, e["time"])
+	delete(e, "time")
+	assert.Equal(t, map[string]interface{}{
+		"level":         "debug",
+		"msg":           "request",
+		"status":        float64(401),
+		"response_body": `{"message":"[REDACTED] is not valid"}`,
+	}, e)
+}
+
+func TestLoggerInvalidFormat(t *testing.T) {
+	_, err := logging.New(bytes.NewBufferString(""), "xml", api.LogInfo)
+	assert.EqualError(t, err, "log format should be text or json, not xml")
+}
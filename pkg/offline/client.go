@@ -0,0 +1,868 @@
+package offline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// ErrOffline is returned by operations that can't be recorded while offline
+var ErrOffline = errors.New("operation not available while offline")
+
+// ErrNotAvailable is wrapped by the errors of reads while offline of what
+// was not kept on the journal
+var ErrNotAvailable = errors.New("not available while offline")
+
+// ErrRunningUnknown is returned while offline when the journal does not know
+// if there is a time entry running, because it was never fetched online
+var ErrRunningUnknown = errors.New(
+	"running time entry is unknown while offline, run `sync` first")
+
+// NewClient wraps the api.Client keeping the time entries of the user fetched
+// on the journal at path, together with the user, the workspace and the time
+// entry running, which are refreshed when time entries are changed online.
+//
+// If offline is true, changes on time entries are recorded on the journal
+// instead of sent to Clockify, and time entries are read from it. Changes on
+// time entries created offline are always recorded on the journal.
+func NewClient(c api.Client, path string, offline bool) api.Client {
+	return &client{Client: c, path: path, offline: offline}
+}
+
+type client struct {
+	api.Client
+	path    string
+	offline bool
+}
+
+func (c *client) SetDebugLogger(l api.Logger) api.Client {
+	c.Client = c.Client.SetDebugLogger(l)
+	return c
+}
+
+func (c *client) SetInfoLogger(l api.Logger) api.Client {
+	c.Client = c.Client.SetInfoLogger(l)
+	return c
+}
+
+func (c *client) SetStructuredLogger(l api.StructuredLogger) api.Client {
+	c.Client = c.Client.SetStructuredLogger(l)
+	return c
+}
+
+func (c *client) SetRetryPolicy(p api.RetryPolicy) api.Client {
+	c.Client = c.Client.SetRetryPolicy(p)
+	return c
+}
+
+func (c *client) SetRateLimit(perSecond int) api.Client {
+	c.Client = c.Client.SetRateLimit(perSecond)
+	return c
+}
+
+func (c *client) SetRequestTimeout(d time.Duration) api.Client {
+	c.Client = c.Client.SetRequestTimeout(d)
+	return c
+}
+
+func (c *client) SetConcurrency(pages int) api.Client {
+	c.Client = c.Client.SetConcurrency(pages)
+	return c
+}
+
+func (c *client) WithContext(ctx context.Context) api.Client {
+	return &client{
+		Client:  c.Client.WithContext(ctx),
+		path:    c.path,
+		offline: c.offline,
+	}
+}
+
+// update changes the journal, saving it only when fn reports a change;
+// failing to keep the snapshot should not fail the command
+func (c *client) update(fn func(*Journal) bool) {
+	j, err := Open(c.path)
+	if err != nil {
+		return
+	}
+
+	if fn(j) {
+		_ = j.Save()
+	}
+}
+
+// remember keeps the time entries on the journal's snapshot
+func (c *client) remember(tes ...dto.TimeEntry) {
+	c.update(func(j *Journal) bool {
+		return j.Remember(tes...)
+	})
+}
+
+// rememberImpl keeps the time entries on the journal's snapshot, reusing
+// the related entities already known
+func (c *client) rememberImpl(tes ...dto.TimeEntryImpl) {
+	c.update(func(j *Journal) bool {
+		return j.Remember(hydrated(j, tes...)...)
+	})
+}
+
+// hydrated converts the time entries reusing the related entities known by
+// the journal
+func hydrated(j *Journal, tes ...dto.TimeEntryImpl) []dto.TimeEntry {
+	hs := make([]dto.TimeEntry, len(tes))
+	for i := range tes {
+		hs[i] = fromImpl(tes[i], j.TimeEntries[tes[i].WorkspaceID][tes[i].ID])
+	}
+
+	return hs
+}
+
+// keep fetches the user and the workspace if the journal does not know them
+// yet, so they are available while offline
+func (c *client) keep(j *Journal, workspace string) bool {
+	changed := false
+	if j.Me == nil {
+		if u, err := c.Client.GetMe(); err == nil {
+			changed = j.SetMe(u)
+		}
+	}
+
+	if _, ok := j.Workspaces[workspace]; !ok {
+		w, err := c.Client.GetWorkspace(api.GetWorkspace{ID: workspace})
+		if err == nil {
+			j.Workspaces[workspace] = w
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// running keeps te as the time entry running for userID, if it is the user
+// of the journal, otherwise te is only remembered
+func (c *client) running(userID, workspace string, te *dto.TimeEntry) {
+	c.update(func(j *Journal) bool {
+		changed := c.keep(j, workspace)
+		if userID != j.UserID {
+			return te != nil && j.Remember(*te) || changed
+		}
+
+		return j.Running(workspace, te) || changed
+	})
+}
+
+// fromImpl converts the time entry, keeping the project, task, tags and
+// user of old if they still are the same
+func fromImpl(t dto.TimeEntryImpl, old dto.TimeEntry) dto.TimeEntry {
+	te := dto.TimeEntry{
+		ID:           t.ID,
+		Billable:     t.Billable,
+		Description:  t.Description,
+		IsLocked:     t.IsLocked,
+		ProjectID:    t.ProjectID,
+		TimeInterval: t.TimeInterval,
+		WorkspaceID:  t.WorkspaceID,
+		Tags:         tagsOf(t.TagIDs),
+		CustomFields: t.CustomFields,
+	}
+
+	if old.Project != nil && old.Project.ID == t.ProjectID {
+		te.Project = old.Project
+		te.HourlyRate = old.HourlyRate
+		te.CostRate = old.CostRate
+	}
+
+	if old.Task != nil && old.Task.ID == t.TaskID {
+		te.Task = old.Task
+	} else if t.TaskID != "" {
+		te.Task = &dto.Task{ID: t.TaskID, ProjectID: t.ProjectID}
+	}
+
+	known := map[string]dto.Tag{}
+	for _, tg := range old.Tags {
+		known[tg.ID] = tg
+	}
+	for i := range te.Tags {
+		if tg, ok := known[te.Tags[i].ID]; ok {
+			te.Tags[i] = tg
+		}
+	}
+
+	if old.User != nil && old.User.ID == t.UserID {
+		te.User = old.User
+	} else if t.UserID != "" {
+		te.User = &dto.User{ID: t.UserID}
+	}
+
+	return te
+}
+
+func (c *client) GetMe() (dto.User, error) {
+	if c.offline {
+		j, err := Open(c.path)
+		if err != nil || j.Me == nil {
+			return dto.User{}, ErrOffline
+		}
+
+		return *j.Me, nil
+	}
+
+	u, err := c.Client.GetMe()
+	if err == nil {
+		c.update(func(j *Journal) bool {
+			return j.SetMe(u)
+		})
+	}
+
+	return u, err
+}
+
+func (c *client) GetWorkspace(p api.GetWorkspace) (dto.Workspace, error) {
+	if c.offline {
+		j, err := Open(c.path)
+		if err != nil {
+			return dto.Workspace{}, err
+		}
+
+		w, ok := j.Workspaces[p.ID]
+		if !ok {
+			return w, fmt.Errorf("workspace %s is %w", p.ID, ErrNotAvailable)
+		}
+
+		return w, nil
+	}
+
+	w, err := c.Client.GetWorkspace(p)
+	if err == nil {
+		c.update(func(j *Journal) bool {
+			if old, ok := j.Workspaces[w.ID]; ok &&
+				reflect.DeepEqual(old, w) {
+				return false
+			}
+
+			j.Workspaces[w.ID] = w
+			return true
+		})
+	}
+
+	return w, err
+}
+
+func (c *client) GetWorkspaceCustomFields(
+	p api.GetWorkspaceCustomFieldsParam) ([]dto.WorkspaceCustomField, error) {
+	if c.offline {
+		j, err := Open(c.path)
+		if err != nil {
+			return nil, err
+		}
+
+		cfs, ok := j.CustomFields[p.Workspace]
+		if !ok {
+			return nil, fmt.Errorf("custom fields of workspace %s are %w",
+				p.Workspace, ErrNotAvailable)
+		}
+
+		fs := make([]dto.WorkspaceCustomField, 0, len(cfs))
+		for _, cf := range cfs {
+			if p.Name != "" && !strings.Contains(
+				strings.ToLower(cf.Name), strings.ToLower(p.Name)) {
+				continue
+			}
+
+			if p.Status != "" && cf.Status != p.Status {
+				continue
+			}
+
+			if p.EntityType != "" && cf.EntityType != p.EntityType {
+				continue
+			}
+
+			fs = append(fs, cf)
+		}
+
+		return fs, nil
+	}
+
+	cfs, err := c.Client.GetWorkspaceCustomFields(p)
+	if err == nil && p.Name == "" && p.Status == "" && p.EntityType == "" {
+		c.update(func(j *Journal) bool {
+			if old, ok := j.CustomFields[p.Workspace]; ok &&
+				reflect.DeepEqual(old, cfs) {
+				return false
+			}
+
+			j.CustomFields[p.Workspace] = cfs
+			return true
+		})
+	}
+
+	return cfs, err
+}
+
+// entries returns the time entries of the workspace with the changes not
+// synced yet
+func (c *client) entries(workspace string) (map[string]dto.TimeEntry, error) {
+	j, err := Open(c.path)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.Entries(workspace), nil
+}
+
+// find looks for the time entry on the journal
+func (c *client) find(workspace, id string) (*dto.TimeEntry, error) {
+	tes, err := c.entries(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	te, ok := tes[id]
+	if !ok {
+		return nil, fmt.Errorf("time entry %s is %w", id, ErrNotAvailable)
+	}
+
+	return &te, nil
+}
+
+// hydrate fills the project, task, tags and user of the time entry, using
+// the ones known by the journal or the client (which may be cached)
+func (c *client) hydrate(
+	te dto.TimeEntry, known map[string]dto.TimeEntry) dto.TimeEntry {
+	projects := map[string]*dto.Project{}
+	tasks := map[string]*dto.Task{}
+	tags := map[string]dto.Tag{}
+	for _, k := range known {
+		if k.Project != nil {
+			projects[k.Project.ID] = k.Project
+		}
+
+		if k.Task != nil && k.Task.Name != "" {
+			tasks[k.Task.ID] = k.Task
+		}
+
+		for _, t := range k.Tags {
+			if t.Name != "" {
+				tags[t.ID] = t
+			}
+		}
+	}
+
+	if te.Project == nil && te.ProjectID != "" {
+		te.Project = projects[te.ProjectID]
+		if te.Project == nil {
+			te.Project, _ = c.Client.GetProject(api.GetProjectParam{
+				Workspace: te.WorkspaceID,
+				ProjectID: te.ProjectID,
+			})
+		}
+
+		if te.Project == nil {
+			te.Project = &dto.Project{ID: te.ProjectID, Name: te.ProjectID}
+		}
+	}
+
+	if te.Task != nil && te.Task.Name == "" {
+		if t, ok := tasks[te.Task.ID]; ok {
+			te.Task = t
+		} else if t, err := c.Client.GetTask(api.GetTaskParam{
+			Workspace: te.WorkspaceID,
+			ProjectID: te.ProjectID,
+			TaskID:    te.Task.ID,
+		}); err == nil {
+			te.Task = &t
+		} else {
+			te.Task.Name = te.Task.ID
+		}
+	}
+
+	for i := range te.Tags {
+		if te.Tags[i].Name != "" {
+			continue
+		}
+
+		if t, ok := tags[te.Tags[i].ID]; ok {
+			te.Tags[i] = t
+		} else if t, err := c.Client.GetTag(api.GetTagParam{
+			Workspace: te.WorkspaceID,
+			TagID:     te.Tags[i].ID,
+		}); err == nil && t != nil {
+			te.Tags[i] = *t
+		} else {
+			te.Tags[i].Name = te.Tags[i].ID
+		}
+	}
+
+	return te
+}
+
+// list filters the time entries known by the journal as Clockify would
+func (c *client) list(p api.GetUserTimeEntriesParam) ([]dto.TimeEntry, error) {
+	tes, err := c.entries(p.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]dto.TimeEntry, 0, len(tes))
+	for _, te := range tes {
+		if !isFromUser(te, p.UserID) {
+			continue
+		}
+
+		if p.OnlyInProgress != nil && *p.OnlyInProgress &&
+			te.TimeInterval.End != nil {
+			continue
+		}
+
+		s := te.TimeInterval.Start
+		if (p.Start != nil && s.Before(*p.Start)) ||
+			(p.End != nil && s.After(*p.End)) {
+			continue
+		}
+
+		if p.Description != "" && !strings.Contains(
+			strings.ToLower(te.Description),
+			strings.ToLower(p.Description)) {
+			continue
+		}
+
+		if p.ProjectID != "" && te.ProjectID != p.ProjectID {
+			continue
+		}
+
+		if !hasTags(te, p.TagIDs) {
+			continue
+		}
+
+		l = append(l, te)
+	}
+
+	sort.SliceStable(l, func(i, j int) bool {
+		return l[i].TimeInterval.Start.After(l[j].TimeInterval.Start)
+	})
+
+	if !p.AllPages && p.PageSize > 0 {
+		page := p.Page
+		if page < 1 {
+			page = 1
+		}
+
+		start := (page - 1) * p.PageSize
+		if start > len(l) {
+			start = len(l)
+		}
+
+		end := start + p.PageSize
+		if end > len(l) {
+			end = len(l)
+		}
+
+		l = l[start:end]
+	}
+
+	for i := range l {
+		l[i] = c.hydrate(l[i], tes)
+	}
+
+	return l, nil
+}
+
+func hasTags(te dto.TimeEntry, ids []string) bool {
+	for _, id := range ids {
+		found := false
+		for _, t := range te.Tags {
+			found = found || t.ID == id
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *client) GetUserTimeEntries(p api.GetUserTimeEntriesParam) (
+	[]dto.TimeEntryImpl, error) {
+	if c.offline {
+		l, err := c.list(p)
+		if err != nil {
+			return nil, err
+		}
+
+		tes := make([]dto.TimeEntryImpl, len(l))
+		for i := range l {
+			tes[i] = toImpl(l[i])
+		}
+
+		return tes, nil
+	}
+
+	tes, err := c.Client.GetUserTimeEntries(p)
+	if err == nil {
+		c.rememberImpl(tes...)
+	}
+
+	return tes, err
+}
+
+func (c *client) GetUsersHydratedTimeEntries(
+	p api.GetUserTimeEntriesParam) ([]dto.TimeEntry, error) {
+	if c.offline {
+		return c.list(p)
+	}
+
+	tes, err := c.Client.GetUsersHydratedTimeEntries(p)
+	if err == nil {
+		c.remember(tes...)
+	}
+
+	return tes, err
+}
+
+func (c *client) Log(p api.LogParam) ([]dto.TimeEntry, error) {
+	d := p.Date.Round(time.Hour)
+	d = d.Add(time.Hour * time.Duration(d.Hour()) * -1)
+
+	return c.LogRange(api.LogRangeParam{
+		Workspace:       p.Workspace,
+		UserID:          p.UserID,
+		FirstDate:       d,
+		LastDate:        d.Add(time.Hour * 24),
+		PaginationParam: p.PaginationParam,
+	})
+}
+
+func (c *client) LogRange(p api.LogRangeParam) ([]dto.TimeEntry, error) {
+	return c.GetUsersHydratedTimeEntries(api.GetUserTimeEntriesParam{
+		Workspace:       p.Workspace,
+		UserID:          p.UserID,
+		Start:           &p.FirstDate,
+		End:             &p.LastDate,
+		Description:     p.Description,
+		ProjectID:       p.ProjectID,
+		TagIDs:          p.TagIDs,
+		PaginationParam: p.PaginationParam,
+	})
+}
+
+func (c *client) LogRangeByPage(
+	p api.LogRangeParam, fn func([]dto.TimeEntry) error) error {
+	if c.offline {
+		tes, err := c.LogRange(p)
+		if err != nil {
+			return err
+		}
+
+		return fn(tes)
+	}
+
+	return c.Client.LogRangeByPage(p, func(tes []dto.TimeEntry) error {
+		c.remember(tes...)
+		return fn(tes)
+	})
+}
+
+func (c *client) GetTimeEntryInProgress(p api.GetTimeEntryInProgressParam) (
+	*dto.TimeEntryImpl, error) {
+	if !c.offline {
+		te, err := c.Client.GetTimeEntryInProgress(p)
+		if err != nil {
+			return te, err
+		}
+
+		var r *dto.TimeEntry
+		if te != nil {
+			j, _ := Open(c.path)
+			r = &hydrated(j, *te)[0]
+		}
+
+		c.running(p.UserID, p.Workspace, r)
+		return te, err
+	}
+
+	te, _, err := c.inProgress(p)
+	if te == nil || err != nil {
+		return nil, err
+	}
+
+	t := toImpl(*te)
+	return &t, nil
+}
+
+// inProgress returns the time entry running on the journal, and the time
+// entries of the workspace known
+func (c *client) inProgress(p api.GetTimeEntryInProgressParam) (
+	*dto.TimeEntry, map[string]dto.TimeEntry, error) {
+	j, err := Open(c.path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tes := j.Entries(p.Workspace)
+	te := running(tes, p.UserID)
+	if te == nil && !j.knowsRunning(p.Workspace) {
+		return nil, tes, ErrRunningUnknown
+	}
+
+	return te, tes, nil
+}
+
+func (c *client) GetHydratedTimeEntryInProgress(
+	p api.GetTimeEntryInProgressParam) (*dto.TimeEntry, error) {
+	if !c.offline {
+		te, err := c.Client.GetHydratedTimeEntryInProgress(p)
+		if err == nil {
+			c.running(p.UserID, p.Workspace, te)
+		}
+
+		return te, err
+	}
+
+	te, tes, err := c.inProgress(p)
+	if te == nil || err != nil {
+		return nil, err
+	}
+
+	h := c.hydrate(*te, tes)
+	return &h, nil
+}
+
+func (c *client) GetTimeEntry(p api.GetTimeEntryParam) (
+	*dto.TimeEntryImpl, error) {
+	if c.offline || IsProvisional(p.TimeEntryID) {
+		te, err := c.find(p.Workspace, p.TimeEntryID)
+		if err != nil {
+			return nil, err
+		}
+
+		t := toImpl(*te)
+		return &t, nil
+	}
+
+	te, err := c.Client.GetTimeEntry(p)
+	if err == nil && te != nil {
+		c.rememberImpl(*te)
+	}
+
+	return te, err
+}
+
+func (c *client) GetHydratedTimeEntry(p api.GetTimeEntryParam) (
+	*dto.TimeEntry, error) {
+	if c.offline || IsProvisional(p.TimeEntryID) {
+		tes, err := c.entries(p.Workspace)
+		if err != nil {
+			return nil, err
+		}
+
+		te, ok := tes[p.TimeEntryID]
+		if !ok {
+			return nil, fmt.Errorf(
+				"time entry %s is %w", p.TimeEntryID, ErrNotAvailable)
+		}
+
+		h := c.hydrate(te, tes)
+		return &h, nil
+	}
+
+	te, err := c.Client.GetHydratedTimeEntry(p)
+	if err == nil && te != nil {
+		c.remember(*te)
+	}
+
+	return te, err
+}
+
+// record adds the change to the journal, returning the time entry changed
+// as it would be after it
+func (c *client) record(op Op) (dto.TimeEntryImpl, error) {
+	var te dto.TimeEntry
+	err := Update(c.path, func(j *Journal) error {
+		op = j.Record(op)
+		te = j.Entries(op.Workspace)[op.TimeEntryID]
+		return nil
+	})
+
+	return toImpl(te), err
+}
+
+// base returns the time entry as known before changing it offline, time
+// entries created offline have no base
+func (c *client) base(workspace, id string) (*dto.TimeEntryImpl, error) {
+	if IsProvisional(id) {
+		return nil, nil
+	}
+
+	te, err := c.find(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	t := toImpl(*te)
+	return &t, nil
+}
+
+func (c *client) CreateTimeEntry(p api.CreateTimeEntryParam) (
+	dto.TimeEntryImpl, error) {
+	if c.offline {
+		return c.record(Op{
+			Type:      OpCreate,
+			Workspace: p.Workspace,
+			Create:    &p,
+		})
+	}
+
+	te, err := c.Client.CreateTimeEntry(p)
+	if err != nil {
+		return te, err
+	}
+
+	c.update(func(j *Journal) bool {
+		c.keep(j, p.Workspace)
+		h := fromImpl(te, dto.TimeEntry{})
+		if p.End != nil || te.UserID != j.UserID {
+			j.Remember(h)
+			return true
+		}
+
+		for id, r := range j.TimeEntries[p.Workspace] {
+			if r.TimeInterval.End == nil && isFromUser(r, te.UserID) {
+				end := p.Start
+				r.TimeInterval = dto.NewTimeInterval(
+					r.TimeInterval.Start, &end)
+				j.TimeEntries[p.Workspace][id] = r
+			}
+		}
+
+		return j.Running(p.Workspace, &h)
+	})
+
+	return te, err
+}
+
+func (c *client) Out(p api.OutParam) error {
+	if !c.offline {
+		err := c.Client.Out(p)
+		if err != nil && !errors.Is(err, dto.ErrNotFound) {
+			return err
+		}
+
+		c.update(func(j *Journal) bool {
+			changed := c.keep(j, p.Workspace)
+			if p.UserID != j.UserID {
+				return changed
+			}
+
+			if tes := j.TimeEntries[p.Workspace]; tes != nil && err == nil {
+				stop(tes, p.UserID, p.End)
+			}
+
+			return j.Running(p.Workspace, nil)
+		})
+
+		return err
+	}
+
+	r, _, err := c.inProgress(api.GetTimeEntryInProgressParam{
+		Workspace: p.Workspace,
+		UserID:    p.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if r == nil {
+		// same error as Clockify, so commands can ignore it
+		return dto.Error{
+			Code:    http.StatusNotFound,
+			Message: "No time entry in progress",
+		}
+	}
+
+	var b *dto.TimeEntryImpl
+	if !IsProvisional(r.ID) {
+		t := toImpl(*r)
+		b = &t
+	}
+
+	_, err = c.record(Op{
+		Type:        OpOut,
+		Workspace:   p.Workspace,
+		TimeEntryID: r.ID,
+		Out:         &p,
+		Base:        b,
+	})
+	return err
+}
+
+func (c *client) UpdateTimeEntry(p api.UpdateTimeEntryParam) (
+	dto.TimeEntryImpl, error) {
+	if !c.offline && !IsProvisional(p.TimeEntryID) {
+		te, err := c.Client.UpdateTimeEntry(p)
+		if err == nil {
+			c.update(func(j *Journal) bool {
+				changed := c.keep(j, p.Workspace)
+				return j.Remember(hydrated(j, te)...) || changed
+			})
+		}
+
+		return te, err
+	}
+
+	b, err := c.base(p.Workspace, p.TimeEntryID)
+	if err != nil {
+		return dto.TimeEntryImpl{}, err
+	}
+
+	return c.record(Op{
+		Type:        OpUpdate,
+		Workspace:   p.Workspace,
+		TimeEntryID: p.TimeEntryID,
+		Update:      &p,
+		Base:        b,
+	})
+}
+
+func (c *client) DeleteTimeEntry(p api.DeleteTimeEntryParam) error {
+	if !c.offline && !IsProvisional(p.TimeEntryID) {
+		if err := c.Client.DeleteTimeEntry(p); err != nil {
+			return err
+		}
+
+		c.update(func(j *Journal) bool {
+			j.Forget(p.Workspace, p.TimeEntryID)
+			return true
+		})
+		return nil
+	}
+
+	b, err := c.base(p.Workspace, p.TimeEntryID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.record(Op{
+		Type:        OpDelete,
+		Workspace:   p.Workspace,
+		TimeEntryID: p.TimeEntryID,
+		Base:        b,
+	})
+	return err
+}
+
+func (c *client) ChangeInvoiced(p api.ChangeInvoicedParam) error {
+	if c.offline {
+		return ErrOffline
+	}
+
+	return c.Client.ChangeInvoiced(p)
+}
@@ -0,0 +1,457 @@
+package offline_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/fakeserver"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
+	"github.com/stretchr/testify/assert"
+)
+
+type env struct {
+	server  *fakeserver.Server
+	remote  api.Client
+	path    string
+	ws      string
+	user    string
+	project dto.Project
+	tag     dto.Tag
+	entry   dto.TimeEntryImpl
+	start   time.Time
+}
+
+func newEnv(t *testing.T) env {
+	s := fakeserver.New(t)
+	c, err := api.NewClientFromUrlAndKey(s.Token, s.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := env{
+		server: s,
+		remote: c.SetRetryPolicy(api.RetryPolicy{}),
+		path:   filepath.Join(t.TempDir(), "journal.json"),
+		start:  time.Now().UTC().Truncate(time.Hour).Add(-48 * time.Hour),
+	}
+
+	e.ws = s.AddWorkspace(dto.Workspace{Name: "Work"}).ID
+	e.user = s.AddUser(e.ws, dto.User{Name: "John Due"}).ID
+	e.project = s.AddProject(dto.Project{WorkspaceID: e.ws, Name: "CLI"})
+	e.tag = s.AddTag(dto.Tag{WorkspaceID: e.ws, Name: "Dev"})
+
+	end := e.start.Add(time.Hour)
+	e.entry = s.AddTimeEntry(dto.TimeEntryImpl{
+		WorkspaceID:  e.ws,
+		UserID:       e.user,
+		Description:  "first",
+		ProjectID:    e.project.ID,
+		TimeInterval: dto.NewTimeInterval(e.start, &end),
+	})
+
+	return e
+}
+
+func (e env) snapshot(t *testing.T) {
+	if err := offline.Snapshot(e.remote, e.path, e.ws, e.user); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (e env) client(offlineMode bool) api.Client {
+	return offline.NewClient(e.remote, e.path, offlineMode)
+}
+
+func (e env) logRange(t *testing.T, c api.Client) []dto.TimeEntry {
+	tes, err := c.LogRange(api.LogRangeParam{
+		Workspace:       e.ws,
+		UserID:          e.user,
+		FirstDate:       e.start.Add(-time.Hour),
+		LastDate:        e.start.Add(24 * time.Hour),
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tes
+}
+
+func TestOfflineChangesAreShownAndSynced(t *testing.T) {
+	e := newEnv(t)
+	e.snapshot(t)
+
+	c := e.client(true)
+	te, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace:   e.ws,
+		Start:       e.start.Add(2 * time.Hour),
+		Description: "offline",
+		ProjectID:   e.project.ID,
+		TagIDs:      []string{e.tag.ID},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "offline-1", te.ID)
+	assert.True(t, offline.IsProvisional(te.ID))
+
+	r, err := c.GetHydratedTimeEntryInProgress(
+		api.GetTimeEntryInProgressParam{Workspace: e.ws, UserID: e.user})
+	if assert.NoError(t, err) && assert.NotNil(t, r) {
+		assert.Equal(t, te.ID, r.ID)
+		assert.Equal(t, "CLI", r.Project.Name)
+		assert.Equal(t, []dto.Tag{e.tag}, r.Tags)
+	}
+
+	_, err = c.UpdateTimeEntry(api.UpdateTimeEntryParam{
+		Workspace:   e.ws,
+		TimeEntryID: e.entry.ID,
+		Start:       e.entry.TimeInterval.Start,
+		End:         e.entry.TimeInterval.End,
+		Description: "changed offline",
+		ProjectID:   e.project.ID,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	err = c.Out(api.OutParam{
+		Workspace: e.ws,
+		UserID:    e.user,
+		End:       e.start.Add(3 * time.Hour),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tes := e.logRange(t, c)
+	if assert.Len(t, tes, 2) {
+		assert.Equal(t, "offline", tes[0].Description)
+		assert.NotNil(t, tes[0].TimeInterval.End)
+		assert.Equal(t, "changed offline", tes[1].Description)
+	}
+
+	assert.Len(t, e.server.TimeEntries(), 1, "nothing should be sent")
+	assert.Error(t, c.ChangeInvoiced(api.ChangeInvoicedParam{
+		Workspace: e.ws, TimeEntryIDs: []string{e.entry.ID}}))
+
+	rs, err := offline.Sync(e.client(false), e.path, offline.SyncOptions{})
+	if !assert.NoError(t, err) || !assert.Len(t, rs, 3) {
+		return
+	}
+
+	for _, r := range rs {
+		assert.Equal(t, offline.StatusSynced, r.Status, r.Reason)
+	}
+	assert.Equal(t, "offline-1", rs[0].Op.TimeEntryID)
+	assert.False(t, offline.IsProvisional(rs[0].TimeEntryID))
+	assert.Equal(t, rs[0].TimeEntryID, rs[2].TimeEntryID)
+
+	tes = e.logRange(t, e.remote)
+	if assert.Len(t, tes, 2) {
+		assert.Equal(t, rs[0].TimeEntryID, tes[0].ID)
+		assert.Equal(t, "offline", tes[0].Description)
+		if assert.NotNil(t, tes[0].TimeInterval.End) {
+			assert.Equal(t, e.start.Add(3*time.Hour),
+				*tes[0].TimeInterval.End)
+		}
+		assert.Equal(t, "changed offline", tes[1].Description)
+	}
+
+	rs, err = offline.Sync(e.client(false), e.path, offline.SyncOptions{})
+	if assert.NoError(t, err) {
+		assert.Len(t, rs, 0)
+	}
+}
+
+func TestSyncConflicts(t *testing.T) {
+	e := newEnv(t)
+	e.snapshot(t)
+
+	c := e.client(true)
+	update := api.UpdateTimeEntryParam{
+		Workspace:   e.ws,
+		TimeEntryID: e.entry.ID,
+		Start:       e.entry.TimeInterval.Start,
+		End:         e.entry.TimeInterval.End,
+		Description: "changed offline",
+	}
+	if _, err := c.UpdateTimeEntry(update); !assert.NoError(t, err) {
+		return
+	}
+
+	remote := update
+	remote.Description = "changed remotely"
+	if _, err := e.remote.UpdateTimeEntry(remote); !assert.NoError(t, err) {
+		return
+	}
+
+	rs, err := offline.Sync(e.client(false), e.path, offline.SyncOptions{})
+	if assert.NoError(t, err) && assert.Len(t, rs, 1) {
+		assert.Equal(t, offline.StatusConflict, rs[0].Status)
+		assert.Equal(t, "time entry was changed remotely", rs[0].Reason)
+	}
+
+	tes := e.logRange(t, e.remote)
+	if assert.Len(t, tes, 1) {
+		assert.Equal(t, "changed remotely", tes[0].Description)
+	}
+
+	rs, err = offline.Sync(e.client(false), e.path, offline.SyncOptions{
+		Force: true,
+	})
+	if assert.NoError(t, err) && assert.Len(t, rs, 1) {
+		assert.Equal(t, offline.StatusSynced, rs[0].Status)
+	}
+
+	tes = e.logRange(t, e.remote)
+	if assert.Len(t, tes, 1) {
+		assert.Equal(t, "changed offline", tes[0].Description)
+	}
+}
+
+func TestSyncFailuresAndDiscard(t *testing.T) {
+	e := newEnv(t)
+
+	c := e.client(true)
+	_, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace: e.ws,
+		Start:     e.start,
+		ProjectID: "000000000000000000000999",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if !assert.NoError(t, c.Out(api.OutParam{
+		Workspace: e.ws, UserID: e.user, End: e.start.Add(time.Hour)})) {
+		return
+	}
+
+	rs, err := offline.Sync(e.client(false), e.path, offline.SyncOptions{})
+	if assert.NoError(t, err) && assert.Len(t, rs, 2) {
+		assert.Equal(t, offline.StatusFailed, rs[0].Status)
+		assert.Equal(t, "Project doesn't belong to Workspace", rs[0].Reason)
+		assert.Equal(t, offline.StatusSkipped, rs[1].Status)
+	}
+
+	j, err := offline.Open(e.path)
+	if assert.NoError(t, err) {
+		assert.Len(t, j.Ops, 2, "changes should be kept")
+	}
+
+	rs, err = offline.Sync(e.client(false), e.path, offline.SyncOptions{
+		Discard: true,
+	})
+	if assert.NoError(t, err) {
+		assert.Len(t, rs, 2)
+	}
+
+	j, err = offline.Open(e.path)
+	if assert.NoError(t, err) {
+		assert.Len(t, j.Ops, 0)
+	}
+	assert.Len(t, e.server.TimeEntries(), 1)
+}
+
+func TestOfflineUnknownEntries(t *testing.T) {
+	e := newEnv(t)
+	c := e.client(true)
+
+	_, err := c.GetTimeEntry(api.GetTimeEntryParam{
+		Workspace: e.ws, TimeEntryID: e.entry.ID})
+	assert.EqualError(t, err, "time entry "+e.entry.ID+
+		" is not available while offline")
+
+	r, err := c.GetTimeEntryInProgress(api.GetTimeEntryInProgressParam{
+		Workspace: e.ws, UserID: e.user})
+	assert.ErrorIs(t, err, offline.ErrRunningUnknown)
+	assert.Nil(t, r)
+
+	err = c.Out(api.OutParam{Workspace: e.ws, UserID: e.user, End: e.start})
+	assert.ErrorIs(t, err, offline.ErrRunningUnknown)
+	assert.False(t, errors.Is(err, dto.ErrNotFound))
+}
+
+func TestOfflineKeepsWhatWasDoneOnline(t *testing.T) {
+	e := newEnv(t)
+	on := e.client(false)
+
+	start := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	te, err := on.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace: e.ws, Start: start, Description: "online"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	off := e.client(true)
+	u, err := off.GetMe()
+	if assert.NoError(t, err) {
+		assert.Equal(t, e.user, u.ID)
+	}
+
+	_, err = off.GetWorkspace(api.GetWorkspace{ID: e.ws})
+	assert.NoError(t, err)
+
+	end := start.Add(30 * time.Minute)
+	if !assert.NoError(t, off.Out(api.OutParam{
+		Workspace: e.ws, UserID: e.user, End: end})) {
+		return
+	}
+
+	desc := "changed offline"
+	_, err = off.UpdateTimeEntry(api.UpdateTimeEntryParam{
+		Workspace: e.ws, TimeEntryID: te.ID, Description: desc,
+		Start: start, End: &end})
+	assert.NoError(t, err)
+
+	rs, err := offline.Sync(e.remote, e.path, offline.SyncOptions{})
+	if assert.NoError(t, err) && assert.Len(t, rs, 2) {
+		assert.Equal(t, offline.StatusSynced, rs[0].Status, rs[0].Reason)
+		assert.Equal(t, offline.StatusSynced, rs[1].Status, rs[1].Reason)
+	}
+
+	remote, err := e.remote.GetTimeEntry(api.GetTimeEntryParam{
+		Workspace: e.ws, TimeEntryID: te.ID})
+	if assert.NoError(t, err) && assert.NotNil(t, remote.TimeInterval.End) {
+		assert.Equal(t, desc, remote.Description)
+		assert.True(t, end.Equal(*remote.TimeInterval.End))
+	}
+
+	err = on.Out(api.OutParam{Workspace: e.ws, UserID: e.user, End: end})
+	assert.ErrorIs(t, err, dto.ErrNotFound)
+
+	err = off.Out(api.OutParam{Workspace: e.ws, UserID: e.user, End: end})
+	assert.ErrorIs(t, err, dto.ErrNotFound)
+}
+
+func TestOfflineCustomFields(t *testing.T) {
+	e := newEnv(t)
+	cf := e.server.AddCustomField(dto.WorkspaceCustomField{
+		WorkspaceID: e.ws, Name: "Ticket", Type: dto.CustomFieldTypeText})
+
+	_, err := e.client(true).GetWorkspaceCustomFields(
+		api.GetWorkspaceCustomFieldsParam{Workspace: e.ws})
+	assert.EqualError(t, err, "custom fields of workspace "+e.ws+
+		" are not available while offline")
+
+	e.snapshot(t)
+
+	c := e.client(true)
+	cfs, err := c.GetWorkspaceCustomFields(
+		api.GetWorkspaceCustomFieldsParam{Workspace: e.ws})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []dto.WorkspaceCustomField{cf}, cfs)
+	}
+
+	te, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace:   e.ws,
+		Start:       e.start.Add(2 * time.Hour),
+		Description: "offline",
+		CustomFields: []dto.CustomFieldValue{
+			{CustomFieldID: cf.ID, Value: "TK-1"}},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []dto.CustomFieldValue{
+		{CustomFieldID: cf.ID, Value: "TK-1"}}, te.CustomFields)
+
+	_, err = offline.Sync(e.client(false), e.path, offline.SyncOptions{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tes := e.server.TimeEntries()
+	if assert.Len(t, tes, 2) {
+		assert.Equal(t, []dto.CustomFieldValue{
+			{CustomFieldID: cf.ID, Value: "TK-1"}}, tes[1].CustomFields)
+	}
+}
+
+func TestSnapshotKeepsOnlyRecentEntriesOfTheUser(t *testing.T) {
+	e := newEnv(t)
+	other := e.server.AddUser(e.ws, dto.User{Name: "Other"})
+
+	end := e.start.Add(time.Hour)
+	e.server.AddTimeEntry(dto.TimeEntryImpl{
+		WorkspaceID:  e.ws,
+		UserID:       other.ID,
+		TimeInterval: dto.NewTimeInterval(e.start, &end),
+	})
+
+	old := time.Now().Add(-offline.SnapshotWindow - 24*time.Hour)
+	oldEnd := old.Add(time.Hour)
+	e.server.AddTimeEntry(dto.TimeEntryImpl{
+		WorkspaceID:  e.ws,
+		UserID:       e.user,
+		TimeInterval: dto.NewTimeInterval(old, &oldEnd),
+	})
+
+	e.snapshot(t)
+
+	j, err := offline.Open(e.path)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, e.user, j.UserID)
+	if assert.Len(t, j.TimeEntries[e.ws], 1) {
+		assert.Contains(t, j.TimeEntries[e.ws], e.entry.ID)
+	}
+
+	stale := j.TimeEntries[e.ws][e.entry.ID]
+	stale.ID = "stale"
+	stale.TimeInterval = dto.NewTimeInterval(old, &oldEnd)
+	j.TimeEntries[e.ws]["stale"] = stale
+	if !assert.NoError(t, j.Save()) {
+		return
+	}
+
+	// fetching while online drops the entries outside of the window
+	if _, err := e.client(false).GetTimeEntry(api.GetTimeEntryParam{
+		Workspace: e.ws, TimeEntryID: e.entry.ID}); !assert.NoError(t, err) {
+		return
+	}
+
+	j, err = offline.Open(e.path)
+	if assert.NoError(t, err) {
+		assert.NotContains(t, j.TimeEntries[e.ws], "stale")
+		assert.Contains(t, j.TimeEntries[e.ws], e.entry.ID)
+	}
+}
+
+func TestOnlineCreateStopsOnlyEntriesOfTheUser(t *testing.T) {
+	e := newEnv(t)
+	e.snapshot(t)
+
+	other := e.server.AddUser(e.ws, dto.User{Name: "Other"})
+	if err := offline.Update(e.path, func(j *offline.Journal) error {
+		j.TimeEntries[e.ws]["running"] = dto.TimeEntry{
+			ID:           "running",
+			WorkspaceID:  e.ws,
+			User:         &other,
+			TimeInterval: dto.NewTimeInterval(e.start, nil),
+		}
+		return nil
+	}); !assert.NoError(t, err) {
+		return
+	}
+
+	_, err := e.client(false).CreateTimeEntry(api.CreateTimeEntryParam{
+		Workspace: e.ws,
+		Start:     e.start.Add(2 * time.Hour),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	j, err := offline.Open(e.path)
+	if assert.NoError(t, err) {
+		assert.Nil(t, j.TimeEntries[e.ws]["running"].TimeInterval.End)
+	}
+}
@@ -0,0 +1,457 @@
+// Package offline allows the CLI to change time entries without reaching
+// Clockify, the changes are recorded on a journal and replayed later by
+// Sync.
+//
+// A snapshot of the user's recent time entries, the workspace and its custom
+// fields is kept on the journal too (see Snapshot), so they can be shown
+// together with the pending changes.
+package offline
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// provisionalPrefix starts the IDs given to time entries created offline
+const provisionalPrefix = "offline-"
+
+// SnapshotWindow is how far back the time entries of the user are kept on the
+// journal's snapshot, older ones are removed from it
+const SnapshotWindow = 30 * 24 * time.Hour
+
+// IsProvisional returns true if the id was created offline, and was not
+// synced yet
+func IsProvisional(id string) bool {
+	return strings.HasPrefix(id, provisionalPrefix)
+}
+
+// DefaultPath returns where the journal is stored when no other file is set,
+// inside the user's config directory
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "clockify-cli", "journal.json"), nil
+}
+
+// OpType is the kind of change recorded
+type OpType string
+
+const (
+	OpCreate = OpType("create")
+	OpOut    = OpType("out")
+	OpUpdate = OpType("update")
+	OpDelete = OpType("delete")
+)
+
+// Op is a change made offline, Base is how the time entry was known when
+// the change was made, it is used to find conflicts with remote changes
+type Op struct {
+	Type        OpType                    `json:"type"`
+	Workspace   string                    `json:"workspace"`
+	TimeEntryID string                    `json:"timeEntryId"`
+	RecordedAt  time.Time                 `json:"recordedAt"`
+	Create      *api.CreateTimeEntryParam `json:"create,omitempty"`
+	Out         *api.OutParam             `json:"out,omitempty"`
+	Update      *api.UpdateTimeEntryParam `json:"update,omitempty"`
+	Base        *dto.TimeEntryImpl        `json:"base,omitempty"`
+}
+
+// Journal keeps the changes not synced yet, and a snapshot of the time
+// entries of UserID, workspaces and custom fields fetched while online.
+// RefreshedAt is when the running time entry of each workspace was last
+// fetched from Clockify
+type Journal struct {
+	path string
+
+	Seq          int                                   `json:"seq"`
+	Me           *dto.User                             `json:"me,omitempty"`
+	UserID       string                                `json:"userId,omitempty"`
+	RefreshedAt  map[string]time.Time                  `json:"refreshedAt,omitempty"`
+	Ops          []Op                                  `json:"ops"`
+	Workspaces   map[string]dto.Workspace              `json:"workspaces"`
+	CustomFields map[string][]dto.WorkspaceCustomField `json:"customFields,omitempty"`
+	TimeEntries  map[string]map[string]dto.TimeEntry   `json:"timeEntries"`
+}
+
+// Open loads the journal from the file, if the file does not exist a empty
+// journal is returned
+func Open(path string) (*Journal, error) {
+	j := &Journal{
+		path:         path,
+		RefreshedAt:  map[string]time.Time{},
+		Ops:          []Op{},
+		Workspaces:   map[string]dto.Workspace{},
+		CustomFields: map[string][]dto.WorkspaceCustomField{},
+		TimeEntries:  map[string]map[string]dto.TimeEntry{},
+	}
+
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return j, nil
+	}
+	if err != nil {
+		return j, err
+	}
+
+	if err := json.Unmarshal(b, j); err != nil {
+		return j, err
+	}
+
+	if j.RefreshedAt == nil {
+		j.RefreshedAt = map[string]time.Time{}
+	}
+
+	if j.Workspaces == nil {
+		j.Workspaces = map[string]dto.Workspace{}
+	}
+
+	if j.CustomFields == nil {
+		j.CustomFields = map[string][]dto.WorkspaceCustomField{}
+	}
+
+	if j.TimeEntries == nil {
+		j.TimeEntries = map[string]map[string]dto.TimeEntry{}
+	}
+
+	return j, nil
+}
+
+// Update loads the journal, changes it using fn and saves it if fn does not
+// fail
+func Update(path string, fn func(*Journal) error) error {
+	j, err := Open(path)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(j); err != nil {
+		return err
+	}
+
+	return j.Save()
+}
+
+// Save persists the journal
+func (j *Journal) Save() error {
+	b, err := json.Marshal(j)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(j.path), 0o700); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(j.path), "*.tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), j.path)
+}
+
+// Record appends the change to the journal, creating a provisional ID for
+// new time entries
+func (j *Journal) Record(op Op) Op {
+	if op.Type == OpCreate && op.TimeEntryID == "" {
+		j.Seq++
+		op.TimeEntryID = provisionalPrefix + strconv.Itoa(j.Seq)
+	}
+
+	if op.RecordedAt.IsZero() {
+		op.RecordedAt = time.Now().UTC()
+	}
+
+	j.Ops = append(j.Ops, op)
+	return op
+}
+
+// SetMe sets the user of the token, when it is other user the snapshot is
+// reset and kept for it. Returns true if the journal changed
+func (j *Journal) SetMe(u dto.User) bool {
+	if j.Me != nil && reflect.DeepEqual(*j.Me, u) && j.UserID != "" {
+		return false
+	}
+
+	if j.Me != nil && j.Me.ID != u.ID || j.UserID == "" {
+		j.TimeEntries = map[string]map[string]dto.TimeEntry{}
+		j.RefreshedAt = map[string]time.Time{}
+		j.UserID = u.ID
+	}
+
+	j.Me = &u
+	return true
+}
+
+// Running keeps te as the time entry running for the user of the journal on
+// the workspace, nil means nothing is running. Other time entries of the
+// user without end are removed from the snapshot, as their end is unknown
+func (j *Journal) Running(workspace string, te *dto.TimeEntry) bool {
+	if j.UserID == "" {
+		return false
+	}
+
+	for id, r := range j.TimeEntries[workspace] {
+		if r.TimeInterval.End == nil && isFromUser(r, j.UserID) &&
+			(te == nil || te.ID != id) {
+			delete(j.TimeEntries[workspace], id)
+		}
+	}
+
+	if te != nil {
+		j.Remember(*te)
+	}
+
+	j.RefreshedAt[workspace] = time.Now().UTC()
+	return true
+}
+
+// knowsRunning checks if the running time entry of the workspace is known,
+// because it was fetched from Clockify or was started or stopped offline
+func (j *Journal) knowsRunning(workspace string) bool {
+	if _, ok := j.RefreshedAt[workspace]; ok {
+		return true
+	}
+
+	for _, op := range j.Ops {
+		if op.Workspace == workspace && (op.Type == OpOut ||
+			op.Type == OpCreate && op.Create.End == nil) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Remember keeps the time entries of the user on the snapshot, if they are
+// running or started inside of the SnapshotWindow, the ones outside of it are
+// removed. Returns true if the snapshot changed
+func (j *Journal) Remember(tes ...dto.TimeEntry) bool {
+	since := time.Now().Add(-SnapshotWindow)
+	changed := j.prune(since)
+	if j.UserID == "" {
+		return changed
+	}
+
+	for _, te := range tes {
+		if te.ID == "" || IsProvisional(te.ID) ||
+			!isFromUser(te, j.UserID) || !inWindow(te, since) {
+			continue
+		}
+
+		m, ok := j.TimeEntries[te.WorkspaceID]
+		if !ok {
+			m = map[string]dto.TimeEntry{}
+			j.TimeEntries[te.WorkspaceID] = m
+		}
+
+		if old, ok := m[te.ID]; ok && reflect.DeepEqual(old, te) {
+			continue
+		}
+
+		m[te.ID] = te
+		changed = true
+	}
+
+	return changed
+}
+
+// prune removes the time entries outside of the window from the snapshot
+func (j *Journal) prune(since time.Time) bool {
+	changed := false
+	for w, tes := range j.TimeEntries {
+		for id, te := range tes {
+			if !inWindow(te, since) {
+				delete(tes, id)
+				changed = true
+			}
+		}
+
+		if len(tes) == 0 {
+			delete(j.TimeEntries, w)
+		}
+	}
+
+	return changed
+}
+
+// inWindow checks if the time entry is running or started after since
+func inWindow(te dto.TimeEntry, since time.Time) bool {
+	return te.TimeInterval.End == nil || te.TimeInterval.Start.After(since)
+}
+
+// Forget removes the time entry from the snapshot
+func (j *Journal) Forget(workspace, id string) {
+	delete(j.TimeEntries[workspace], id)
+}
+
+// Entries returns the time entries of the workspace as they would be after
+// the changes not synced yet were applied, keyed by their ID
+func (j *Journal) Entries(workspace string) map[string]dto.TimeEntry {
+	tes := make(map[string]dto.TimeEntry, len(j.TimeEntries[workspace]))
+	for id, te := range j.TimeEntries[workspace] {
+		tes[id] = te
+	}
+
+	for _, op := range j.Ops {
+		if op.Workspace == workspace {
+			apply(tes, op)
+		}
+	}
+
+	return tes
+}
+
+// running returns the time entry without end of the user, if any
+func running(tes map[string]dto.TimeEntry, userID string) *dto.TimeEntry {
+	for _, te := range tes {
+		if te.TimeInterval.End == nil && isFromUser(te, userID) {
+			return &te
+		}
+	}
+
+	return nil
+}
+
+// isFromUser checks if the entry belongs to the user, entries created
+// offline belong to whoever is using the CLI, so they have no user
+func isFromUser(te dto.TimeEntry, userID string) bool {
+	return userID == "" || te.User == nil || te.User.ID == userID
+}
+
+func apply(tes map[string]dto.TimeEntry, op Op) {
+	switch op.Type {
+	case OpCreate:
+		p := op.Create
+		if p.End == nil {
+			stop(tes, "", p.Start)
+		}
+
+		te := dto.TimeEntry{
+			ID:           op.TimeEntryID,
+			WorkspaceID:  op.Workspace,
+			Description:  p.Description,
+			ProjectID:    p.ProjectID,
+			TimeInterval: dto.NewTimeInterval(p.Start, copyTime(p.End)),
+			Tags:         tagsOf(p.TagIDs),
+			CustomFields: p.CustomFields,
+		}
+
+		if p.Billable != nil {
+			te.Billable = *p.Billable
+		}
+
+		if p.TaskID != "" {
+			te.Task = &dto.Task{ID: p.TaskID, ProjectID: p.ProjectID}
+		}
+
+		tes[te.ID] = te
+	case OpOut:
+		stop(tes, op.Out.UserID, op.Out.End)
+	case OpUpdate:
+		te, ok := tes[op.TimeEntryID]
+		if !ok {
+			te = dto.TimeEntry{ID: op.TimeEntryID, WorkspaceID: op.Workspace}
+		}
+
+		p := op.Update
+		te.Billable = p.Billable
+		te.Description = p.Description
+		te.TimeInterval = dto.NewTimeInterval(p.Start, copyTime(p.End))
+		te.Tags = tagsOf(p.TagIDs)
+		te.CustomFields = p.CustomFields
+
+		if te.ProjectID != p.ProjectID {
+			te.Project = nil
+		}
+		te.ProjectID = p.ProjectID
+
+		te.Task = nil
+		if p.TaskID != "" {
+			te.Task = &dto.Task{ID: p.TaskID, ProjectID: p.ProjectID}
+		}
+
+		tes[te.ID] = te
+	case OpDelete:
+		delete(tes, op.TimeEntryID)
+	}
+}
+
+func stop(tes map[string]dto.TimeEntry, userID string, end time.Time) {
+	te := running(tes, userID)
+	if te == nil {
+		return
+	}
+
+	te.TimeInterval = dto.NewTimeInterval(te.TimeInterval.Start, &end)
+	tes[te.ID] = *te
+}
+
+func tagsOf(ids []string) []dto.Tag {
+	ts := make([]dto.Tag, len(ids))
+	for i := range ids {
+		ts[i] = dto.Tag{ID: ids[i]}
+	}
+
+	return ts
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	c := *t
+	return &c
+}
+
+// toImpl returns the time entry without the related entities
+func toImpl(te dto.TimeEntry) dto.TimeEntryImpl {
+	t := dto.TimeEntryImpl{
+		ID:           te.ID,
+		Billable:     te.Billable,
+		Description:  te.Description,
+		IsLocked:     te.IsLocked,
+		ProjectID:    te.ProjectID,
+		TimeInterval: te.TimeInterval,
+		WorkspaceID:  te.WorkspaceID,
+		TagIDs:       make([]string, len(te.Tags)),
+		CustomFields: te.CustomFields,
+	}
+
+	for i := range te.Tags {
+		t.TagIDs[i] = te.Tags[i].ID
+	}
+
+	if te.Task != nil {
+		t.TaskID = te.Task.ID
+	}
+
+	if te.User != nil {
+		t.UserID = te.User.ID
+	}
+
+	return t
+}
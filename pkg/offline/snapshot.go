@@ -0,0 +1,56 @@
+package offline
+
+import (
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// Snapshot replaces what the journal at path knows about the workspace with
+// its current state on Clockify: the user, the workspace, its custom fields
+// and the time entries of the user started inside of the SnapshotWindow
+func Snapshot(c api.Client, path, workspace, userID string) error {
+	u, err := c.GetMe()
+	if err != nil {
+		return err
+	}
+
+	w, err := c.GetWorkspace(api.GetWorkspace{ID: workspace})
+	if err != nil {
+		return err
+	}
+
+	cfs, err := c.GetWorkspaceCustomFields(
+		api.GetWorkspaceCustomFieldsParam{Workspace: workspace})
+	if err != nil {
+		return err
+	}
+
+	start := time.Now().Add(-SnapshotWindow)
+	tes, err := c.GetUsersHydratedTimeEntries(api.GetUserTimeEntriesParam{
+		Workspace:       workspace,
+		UserID:          userID,
+		Start:           &start,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return Update(path, func(j *Journal) error {
+		if j.UserID != userID {
+			j.TimeEntries = map[string]map[string]dto.TimeEntry{}
+			j.RefreshedAt = map[string]time.Time{}
+		}
+
+		j.Me = &u
+		j.UserID = userID
+		j.Workspaces[w.ID] = w
+		j.CustomFields[workspace] = cfs
+		j.TimeEntries[workspace] = map[string]dto.TimeEntry{}
+		j.RefreshedAt[workspace] = time.Now().UTC()
+		j.Remember(tes...)
+		return nil
+	})
+}
@@ -0,0 +1,227 @@
+package offline
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// Status is the outcome of replaying a change
+type Status string
+
+const (
+	// StatusSynced means the change was sent to Clockify
+	StatusSynced = Status("synced")
+	// StatusConflict means the time entry was changed remotely after it was
+	// changed offline, so the change was not sent
+	StatusConflict = Status("conflict")
+	// StatusFailed means Clockify refused the change
+	StatusFailed = Status("failed")
+	// StatusSkipped means the change depends on a time entry whose creation
+	// was not synced
+	StatusSkipped = Status("skipped")
+)
+
+// Result is what happened to a change recorded on the journal when syncing,
+// TimeEntryID is the ID of the time entry on Clockify if it was created
+type Result struct {
+	Op          Op
+	TimeEntryID string
+	Status      Status
+	Reason      string
+}
+
+// SyncOptions changes how conflicts and failures are handled
+type SyncOptions struct {
+	// Force sends the changes even if the time entry was changed remotely
+	Force bool
+	// Discard removes the changes that were not synced from the journal,
+	// otherwise they are kept to be synced later
+	Discard bool
+}
+
+// Sync replays the changes recorded on the journal at path using the
+// client, provisional IDs are replaced by the ones created on Clockify.
+//
+// Changes that conflict or fail are reported and kept on the journal unless
+// SyncOptions.Discard is set. If a request fails without a response from
+// Clockify the sync stops, keeping the remaining changes.
+func Sync(c api.Client, path string, o SyncOptions) ([]Result, error) {
+	j, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := j.Ops
+	ids := map[string]string{}
+	kept := []Op{}
+	rs := make([]Result, 0, len(ops))
+
+	for i := range ops {
+		op := resolve(ops[i], ids)
+		r := Result{Op: ops[i], TimeEntryID: op.TimeEntryID}
+
+		switch {
+		case op.Type != OpCreate && IsProvisional(op.TimeEntryID):
+			r.Status = StatusSkipped
+			r.Reason = fmt.Sprintf(
+				"time entry %s was not created", op.TimeEntryID)
+		default:
+			var id string
+			if id, r.Reason, err = replay(c, op, o.Force); err != nil {
+				var apiErr dto.Error
+				if !errors.As(err, &apiErr) {
+					return rs, err
+				}
+
+				r.Status = StatusFailed
+				r.Reason = apiErr.Message
+				break
+			}
+
+			r.Status = StatusSynced
+			if r.Reason != "" {
+				r.Status = StatusConflict
+				break
+			}
+
+			if op.Type == OpCreate {
+				ids[op.TimeEntryID] = id
+				r.TimeEntryID = id
+			}
+		}
+
+		rs = append(rs, r)
+		if r.Status != StatusSynced && !o.Discard {
+			kept = append(kept, op)
+		}
+
+		if err = Update(path, func(j *Journal) error {
+			j.Ops = append([]Op{}, kept...)
+			for _, op := range ops[i+1:] {
+				j.Ops = append(j.Ops, resolve(op, ids))
+			}
+
+			return nil
+		}); err != nil {
+			return rs, err
+		}
+	}
+
+	return rs, nil
+}
+
+// resolve replaces the provisional ID of the change if it was created
+func resolve(op Op, ids map[string]string) Op {
+	id, ok := ids[op.TimeEntryID]
+	if !ok {
+		return op
+	}
+
+	op.TimeEntryID = id
+	if op.Update != nil {
+		u := *op.Update
+		u.TimeEntryID = id
+		op.Update = &u
+	}
+
+	return op
+}
+
+// replay sends the change to Clockify, returning the id of the time entry
+// changed, or why it conflicts with the remote time entry
+func replay(c api.Client, op Op, force bool) (string, string, error) {
+	switch op.Type {
+	case OpCreate:
+		te, err := c.CreateTimeEntry(*op.Create)
+		return te.ID, "", err
+	case OpOut:
+		if !force {
+			r, err := c.GetTimeEntryInProgress(api.GetTimeEntryInProgressParam{
+				Workspace: op.Workspace,
+				UserID:    op.Out.UserID,
+			})
+			if err != nil {
+				return op.TimeEntryID, "", err
+			}
+
+			if r == nil || r.ID != op.TimeEntryID {
+				return op.TimeEntryID, fmt.Sprintf(
+					"time entry %s is not running anymore",
+					op.TimeEntryID), nil
+			}
+		}
+
+		return op.TimeEntryID, "", c.Out(*op.Out)
+	}
+
+	remote, err := c.GetTimeEntry(api.GetTimeEntryParam{
+		Workspace:   op.Workspace,
+		TimeEntryID: op.TimeEntryID,
+	})
+
+	if errors.Is(err, dto.ErrNotFound) {
+		remote, err = nil, nil
+	}
+
+	if err != nil {
+		return op.TimeEntryID, "", err
+	}
+
+	if remote == nil {
+		if op.Type == OpDelete {
+			return op.TimeEntryID, "", nil
+		}
+
+		return op.TimeEntryID, "time entry was deleted remotely", nil
+	}
+
+	if !force && op.Base != nil && !sameEntry(*remote, *op.Base) {
+		return op.TimeEntryID, "time entry was changed remotely", nil
+	}
+
+	if op.Type == OpDelete {
+		return op.TimeEntryID, "", c.DeleteTimeEntry(api.DeleteTimeEntryParam{
+			Workspace:   op.Workspace,
+			TimeEntryID: op.TimeEntryID,
+		})
+	}
+
+	_, err = c.UpdateTimeEntry(*op.Update)
+	return op.TimeEntryID, "", err
+}
+
+// sameEntry checks if the time entries have the same values
+func sameEntry(a, b dto.TimeEntryImpl) bool {
+	if a.Description != b.Description || a.ProjectID != b.ProjectID ||
+		a.TaskID != b.TaskID || a.Billable != b.Billable ||
+		!sameTime(&a.TimeInterval.Start, &b.TimeInterval.Start) ||
+		!sameTime(a.TimeInterval.End, b.TimeInterval.End) ||
+		len(a.TagIDs) != len(b.TagIDs) {
+		return false
+	}
+
+	at := append([]string{}, a.TagIDs...)
+	bt := append([]string{}, b.TagIDs...)
+	sort.Strings(at)
+	sort.Strings(bt)
+	for i := range at {
+		if at[i] != bt[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Truncate(time.Second).Equal(b.Truncate(time.Second))
+}
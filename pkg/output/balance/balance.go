@@ -0,0 +1,92 @@
+package balance
+
+import (
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// Day compares the hours logged on a day with the hours expected for it
+type Day struct {
+	Date       time.Time    `json:"date"`
+	Holiday    string       `json:"holiday,omitempty"`
+	Expected   dto.Duration `json:"expected"`
+	Logged     dto.Duration `json:"logged"`
+	Difference dto.Duration `json:"difference"`
+	Balance    dto.Duration `json:"balance"`
+}
+
+// Week is the sum of the days of a week, Balance is the running balance at
+// the end of the week
+type Week struct {
+	Start      time.Time    `json:"start"`
+	Expected   dto.Duration `json:"expected"`
+	Logged     dto.Duration `json:"logged"`
+	Difference dto.Duration `json:"difference"`
+	Balance    dto.Duration `json:"balance"`
+	Days       []Day        `json:"days"`
+}
+
+// Balance is the overtime (positive) or undertime (negative) of a date range
+type Balance struct {
+	Start    time.Time    `json:"start"`
+	End      time.Time    `json:"end"`
+	Expected dto.Duration `json:"expected"`
+	Logged   dto.Duration `json:"logged"`
+	Balance  dto.Duration `json:"balance"`
+	Weeks    []Week       `json:"weeks"`
+}
+
+// NewBalance compares the time entries with the hours expected for each day
+// between start and end (inclusive), holidays are not expected to be worked
+func NewBalance(
+	start, end time.Time,
+	tes []dto.TimeEntry,
+	expected map[time.Weekday]time.Duration,
+	holidays timehlp.Holidays,
+) Balance {
+	start = timehlp.TruncateDateWithTimezone(start, time.Local)
+	end = timehlp.TruncateDateWithTimezone(end, time.Local)
+
+	logged := make(map[string]time.Duration)
+	for _, te := range tes {
+		e := time.Now()
+		if te.TimeInterval.End != nil {
+			e = *te.TimeInterval.End
+		}
+
+		d := te.TimeInterval.Start.In(time.Local).Format("2006-01-02")
+		logged[d] += e.Sub(te.TimeInterval.Start)
+	}
+
+	b := Balance{Start: start, End: end, Weeks: []Week{}}
+	var w *Week
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if w == nil || d.Weekday() == time.Sunday {
+			b.Weeks = append(b.Weeks, Week{Start: d, Days: []Day{}})
+			w = &b.Weeks[len(b.Weeks)-1]
+		}
+
+		day := Day{Date: d}
+		day.Holiday, _ = holidays.Get(d)
+		if day.Holiday == "" {
+			day.Expected.Duration = expected[d.Weekday()]
+		}
+		day.Logged.Duration = logged[d.Format("2006-01-02")]
+		day.Difference.Duration = day.Logged.Duration - day.Expected.Duration
+
+		b.Expected.Duration += day.Expected.Duration
+		b.Logged.Duration += day.Logged.Duration
+		b.Balance.Duration += day.Difference.Duration
+		day.Balance = b.Balance
+
+		w.Expected.Duration += day.Expected.Duration
+		w.Logged.Duration += day.Logged.Duration
+		w.Difference.Duration += day.Difference.Duration
+		w.Balance = b.Balance
+		w.Days = append(w.Days, day)
+	}
+
+	return b
+}
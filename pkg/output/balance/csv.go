@@ -0,0 +1,44 @@
+package balance
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// BalanceCSVPrint will print each day of the balance as a CSV line
+func BalanceCSVPrint(b Balance, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{
+		"date",
+		"week",
+		"holiday",
+		"expected",
+		"logged",
+		"difference",
+		"balance",
+	}); err != nil {
+		return err
+	}
+
+	for _, wk := range b.Weeks {
+		for _, d := range wk.Days {
+			if err := w.Write([]string{
+				d.Date.Format("2006-01-02"),
+				wk.Start.Format("2006-01-02"),
+				d.Holiday,
+				util.DurationToString(d.Expected.Duration),
+				util.DurationToString(d.Logged.Duration),
+				util.DurationToString(d.Difference.Duration),
+				util.DurationToString(d.Balance.Duration),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,54 @@
+package balance
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// BalancePrint will print the days of the balance as a table, with a
+// subtotal line for each week and a total line at the end
+func BalancePrint(b Balance, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"Date", "Day", "Expected", "Logged",
+		"Difference", "Balance", "Holiday"})
+
+	for _, wk := range b.Weeks {
+		for _, d := range wk.Days {
+			tw.Append([]string{
+				d.Date.Format("2006-01-02"),
+				d.Date.Format("Mon"),
+				util.DurationToString(d.Expected.Duration),
+				util.DurationToString(d.Logged.Duration),
+				util.DurationToString(d.Difference.Duration),
+				util.DurationToString(d.Balance.Duration),
+				d.Holiday,
+			})
+		}
+
+		tw.Append([]string{
+			"WEEK",
+			"",
+			util.DurationToString(wk.Expected.Duration),
+			util.DurationToString(wk.Logged.Duration),
+			util.DurationToString(wk.Difference.Duration),
+			util.DurationToString(wk.Balance.Duration),
+			"",
+		})
+	}
+
+	tw.Append([]string{
+		"TOTAL",
+		"",
+		util.DurationToString(b.Expected.Duration),
+		util.DurationToString(b.Logged.Duration),
+		util.DurationToString(b.Balance.Duration),
+		util.DurationToString(b.Balance.Duration),
+		"",
+	})
+
+	tw.Render()
+
+	return nil
+}
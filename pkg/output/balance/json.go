@@ -0,0 +1,11 @@
+package balance
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// BalanceJSONPrint will print the balance as JSON
+func BalanceJSONPrint(b Balance, w io.Writer) error {
+	return json.NewEncoder(w).Encode(b)
+}
@@ -0,0 +1,39 @@
+package balance
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// BalancePrintWithTemplate will print each day of the balance using the
+// format string
+func BalancePrintWithTemplate(format string) func(Balance, io.Writer) error {
+	return func(b Balance, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		days := make([]Day, 0)
+		for _, wk := range b.Weeks {
+			days = append(days, wk.Days...)
+		}
+
+		l := len(days)
+		for i := 0; i < l; i++ {
+			if err := t.Execute(w, struct {
+				Day
+				First bool
+				Last  bool
+			}{
+				Day:   days[i],
+				First: i == 0,
+				Last:  i == (l - 1),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
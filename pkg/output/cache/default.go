@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/olekukonko/tablewriter"
+)
+
+// StatusPrint will print what is stored for each workspace and type of data
+func StatusPrint(ss []cache.Status, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{
+		"Workspace", "Type", "Entries", "Expired", "Size", "Updated At"})
+	tw.SetColumnAlignment([]int{
+		tablewriter.ALIGN_LEFT,
+		tablewriter.ALIGN_LEFT,
+		tablewriter.ALIGN_RIGHT,
+		tablewriter.ALIGN_RIGHT,
+		tablewriter.ALIGN_LEFT,
+		tablewriter.ALIGN_LEFT,
+	})
+
+	lines := make([][]string, len(ss))
+	for i, s := range ss {
+		lines[i] = []string{
+			s.Workspace,
+			string(s.Kind),
+			strconv.Itoa(s.Entries),
+			strconv.Itoa(s.Expired),
+			fmt.Sprintf("%d KB", (s.Size+1023)/1024),
+			s.UpdatedAt.Local().Format("2006-01-02 15:04:05"),
+		}
+	}
+
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
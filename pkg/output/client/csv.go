@@ -34,3 +34,44 @@ func ClientsCSVPrint(clients []dto.Client, out io.Writer) error {
 	w.Flush()
 	return w.Error()
 }
+
+// ClientSummaryCSVPrint will print the client and its projects as CSV, one
+// line for each project
+func ClientSummaryCSVPrint(s ClientSummary, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{
+		"id",
+		"name",
+		"archived",
+		"note",
+		"project.id",
+		"project.name",
+		"project.archived",
+	}); err != nil {
+		return err
+	}
+
+	ps := [][]string{{"", "", ""}}
+	if len(s.Projects) > 0 {
+		ps = make([][]string, len(s.Projects))
+	}
+
+	for i, p := range s.Projects {
+		ps[i] = []string{p.ID, p.Name, fmt.Sprintf("%v", p.Archived)}
+	}
+
+	for _, p := range ps {
+		if err := w.Write(append([]string{
+			s.ID,
+			s.Name,
+			fmt.Sprintf("%v", s.Archived),
+			s.Note,
+		}, p...)); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -1,8 +1,10 @@
 package client
 
 import (
+	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/olekukonko/tablewriter"
@@ -37,3 +39,34 @@ func ClientPrint(cs []dto.Client, w io.Writer) error {
 
 	return nil
 }
+
+// ClientSummaryPrint will print the client and its projects
+func ClientSummaryPrint(s ClientSummary, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"ID", "Name", "Archived", "Note", "Projects"})
+	tw.SetAutoWrapText(false)
+
+	ps := make([]string, len(s.Projects))
+	for i, p := range s.Projects {
+		ps[i] = fmt.Sprintf("%s (%s)", p.Name, p.ID)
+		if p.Archived {
+			ps[i] = ps[i] + " [archived]"
+		}
+	}
+
+	yesNo := map[bool]string{
+		true:  "YES",
+		false: "NO",
+	}
+
+	tw.Append([]string{
+		s.ID,
+		s.Name,
+		yesNo[s.Archived],
+		s.Note,
+		strings.Join(ps, "\n"),
+	})
+	tw.Render()
+
+	return nil
+}
@@ -16,3 +16,8 @@ func ClientJSONPrint(t dto.Client, w io.Writer) error {
 func ClientsJSONPrint(t []dto.Client, w io.Writer) error {
 	return json.NewEncoder(w).Encode(t)
 }
+
+// ClientSummaryJSONPrint will print the client and its projects as JSON
+func ClientSummaryJSONPrint(s ClientSummary, w io.Writer) error {
+	return json.NewEncoder(w).Encode(s)
+}
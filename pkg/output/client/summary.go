@@ -0,0 +1,34 @@
+package client
+
+import "github.com/lucassabreu/clockify-cli/api/dto"
+
+// ProjectSummary is the short version of a project attached to a client
+type ProjectSummary struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Archived bool   `json:"archived"`
+}
+
+// ClientSummary is a client and the projects attached to it
+type ClientSummary struct {
+	dto.Client
+	Projects []ProjectSummary `json:"projects"`
+}
+
+// NewClientSummary creates a ClientSummary using the projects of the client
+func NewClientSummary(c dto.Client, ps []dto.Project) ClientSummary {
+	s := ClientSummary{
+		Client:   c,
+		Projects: make([]ProjectSummary, len(ps)),
+	}
+
+	for i := range ps {
+		s.Projects[i] = ProjectSummary{
+			ID:       ps[i].ID,
+			Name:     ps[i].Name,
+			Archived: ps[i].Archived,
+		}
+	}
+
+	return s
+}
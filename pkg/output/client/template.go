@@ -23,3 +23,17 @@ func ClientPrintWithTemplate(format string) func([]dto.Client, io.Writer) error
 		return nil
 	}
 }
+
+// ClientSummaryPrintWithTemplate will print the client and its projects
+// using the format string
+func ClientSummaryPrintWithTemplate(format string) func(
+	ClientSummary, io.Writer) error {
+	return func(s ClientSummary, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		return t.Execute(w, s)
+	}
+}
@@ -0,0 +1,56 @@
+package estimate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// EstimateCSVPrint will print the estimate as CSV
+func EstimateCSVPrint(e Estimate, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{
+		"project.id",
+		"project.name",
+		"method",
+		"type",
+		"resetOption",
+		"includeNonBillable",
+		"timeEstimate",
+		"budgetEstimate",
+		"tracked",
+		"progress",
+	}); err != nil {
+		return err
+	}
+
+	line := []string{
+		e.ProjectID,
+		e.ProjectName,
+		string(e.Method),
+		string(e.Type),
+		resetToString(e),
+		strconv.FormatBool(e.IncludeNonBillable),
+		"",
+		"",
+		e.Tracked.String(),
+		fmt.Sprintf("%.2f", e.Progress),
+	}
+
+	if e.TimeEstimate != nil {
+		line[6] = e.TimeEstimate.String()
+	}
+
+	if e.BudgetEstimate != nil {
+		line[7] = strconv.FormatUint(uint64(*e.BudgetEstimate), 10)
+	}
+
+	if err := w.Write(line); err != nil {
+		return err
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,56 @@
+package estimate
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// EstimatePrint will print the estimate as a table
+func EstimatePrint(e Estimate, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"Project", "Method", "Type", "Reset", "Estimate",
+		"Tracked", "Progress"})
+
+	tw.Append([]string{
+		e.ProjectName + " (" + e.ProjectID + ")",
+		string(e.Method),
+		string(e.Type),
+		resetToString(e),
+		estimateToString(e),
+		util.DurationToString(e.Tracked.Duration),
+		progressToString(e),
+	})
+	tw.Render()
+
+	return nil
+}
+
+func resetToString(e Estimate) string {
+	if e.ResetOption == nil {
+		return ""
+	}
+
+	return string(*e.ResetOption)
+}
+
+func estimateToString(e Estimate) string {
+	switch {
+	case e.TimeEstimate != nil:
+		return util.DurationToString(e.TimeEstimate.Duration)
+	case e.BudgetEstimate != nil:
+		return fmt.Sprintf("%.2f", float64(*e.BudgetEstimate)/100)
+	default:
+		return ""
+	}
+}
+
+func progressToString(e Estimate) string {
+	if e.TimeEstimate == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%.2f%%", e.Progress)
+}
@@ -0,0 +1,65 @@
+package estimate
+
+import (
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// Estimate is the current estimate of a project and how much of it was used
+type Estimate struct {
+	ProjectID   string `json:"projectId"`
+	ProjectName string `json:"projectName"`
+
+	Method             api.EstimateMethod       `json:"method"`
+	Type               dto.EstimateType         `json:"type,omitempty"`
+	ResetOption        *dto.EstimateResetOption `json:"resetOption,omitempty"`
+	IncludeNonBillable bool                     `json:"includeNonBillable"`
+
+	// TimeEstimate is only set when the method is time
+	TimeEstimate *dto.Duration `json:"timeEstimate,omitempty"`
+	// BudgetEstimate is only set when the method is budget, its amount is in
+	// cents
+	BudgetEstimate *uint `json:"budgetEstimate,omitempty"`
+
+	Tracked dto.Duration `json:"tracked"`
+	// Progress is the percentage of the time estimate already tracked, it
+	// will be zero when the method is not time
+	Progress float64 `json:"progress"`
+}
+
+// NewEstimate reads the estimate of the project
+func NewEstimate(p dto.Project) Estimate {
+	e := Estimate{
+		ProjectID:   p.ID,
+		ProjectName: p.Name,
+		Method:      api.EstimateMethodNone,
+	}
+
+	if p.Duration != nil {
+		e.Tracked = *p.Duration
+	}
+
+	switch {
+	case p.TimeEstimate.Active:
+		e.Method = api.EstimateMethodTime
+		e.Type = p.TimeEstimate.Type
+		e.ResetOption = p.TimeEstimate.ResetOptions
+		e.IncludeNonBillable = p.TimeEstimate.IncludeNonBillable
+
+		t := p.TimeEstimate.Estimate
+		e.TimeEstimate = &t
+		if t.Duration > 0 {
+			e.Progress = float64(e.Tracked.Duration) /
+				float64(t.Duration) * 100
+		}
+	case p.BudgetEstimate.Active:
+		e.Method = api.EstimateMethodBudget
+		e.Type = p.BudgetEstimate.Type
+		e.ResetOption = p.BudgetEstimate.ResetOptions
+
+		b := p.BudgetEstimate.Estimate
+		e.BudgetEstimate = &b
+	}
+
+	return e
+}
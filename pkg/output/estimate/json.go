@@ -0,0 +1,11 @@
+package estimate
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// EstimateJSONPrint will print the estimate as JSON
+func EstimateJSONPrint(e Estimate, w io.Writer) error {
+	return json.NewEncoder(w).Encode(e)
+}
@@ -0,0 +1,19 @@
+package estimate
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// EstimatePrintWithTemplate will print the estimate using the format string
+func EstimatePrintWithTemplate(format string) func(Estimate, io.Writer) error {
+	return func(e Estimate, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		return t.Execute(w, e)
+	}
+}
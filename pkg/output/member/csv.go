@@ -0,0 +1,54 @@
+package member
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// MembersCSVPrint will print the members as CSV
+func MembersCSVPrint(ms []Member, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{
+		"user.id",
+		"user.name",
+		"user.email",
+		"status",
+		"hourlyRate.amount",
+		"hourlyRate.currency",
+		"costRate.amount",
+		"costRate.currency",
+	}); err != nil {
+		return err
+	}
+
+	rate := func(r *dto.Rate) []string {
+		if r == nil {
+			return []string{"", ""}
+		}
+
+		return []string{strconv.FormatInt(r.Amount, 10), r.Currency}
+	}
+
+	for i := 0; i < len(ms); i++ {
+		m := ms[i]
+		line := []string{
+			m.UserID,
+			m.User.Name,
+			m.User.Email,
+			string(m.Status),
+		}
+		line = append(line, rate(m.HourlyRate)...)
+		line = append(line, rate(m.CostRate)...)
+
+		if err := w.Write(line); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,32 @@
+package member
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// MembersPrint will print the members as a table
+func MembersPrint(ms []Member, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{
+		"ID", "Name", "Email", "Status", "Hourly Rate", "Cost Rate"})
+
+	lines := make([][]string, len(ms))
+	for i := 0; i < len(ms); i++ {
+		lines[i] = []string{
+			ms[i].UserID,
+			ms[i].User.Name,
+			ms[i].User.Email,
+			string(ms[i].Status),
+			util.RateToString(ms[i].HourlyRate),
+			util.RateToString(ms[i].CostRate),
+		}
+	}
+
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
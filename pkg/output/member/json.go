@@ -0,0 +1,11 @@
+package member
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// MembersJSONPrint will print the members as JSON
+func MembersJSONPrint(ms []Member, w io.Writer) error {
+	return json.NewEncoder(w).Encode(ms)
+}
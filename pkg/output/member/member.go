@@ -0,0 +1,9 @@
+package member
+
+import "github.com/lucassabreu/clockify-cli/api/dto"
+
+// Member is a project membership along with the user it refers to
+type Member struct {
+	dto.Membership
+	User dto.User `json:"user"`
+}
@@ -0,0 +1,15 @@
+package member
+
+import (
+	"fmt"
+	"io"
+)
+
+// MembersPrintQuietly will only print the user IDs
+func MembersPrintQuietly(ms []Member, w io.Writer) error {
+	for i := 0; i < len(ms); i++ {
+		fmt.Fprintln(w, ms[i].UserID)
+	}
+
+	return nil
+}
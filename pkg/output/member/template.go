@@ -0,0 +1,24 @@
+package member
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// MembersPrintWithTemplate will print each member using the format string
+func MembersPrintWithTemplate(format string) func([]Member, io.Writer) error {
+	return func(ms []Member, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(ms); i++ {
+			if err := t.Execute(w, ms[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,35 @@
+package offline
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
+	"github.com/olekukonko/tablewriter"
+)
+
+// SyncPrint will print what happened to each change recorded offline
+func SyncPrint(rs []offline.Result, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"Change", "Time Entry", "Status", "Reason"})
+	tw.SetAutoWrapText(false)
+
+	lines := make([][]string, len(rs))
+	for i, r := range rs {
+		id := r.TimeEntryID
+		if r.Op.TimeEntryID != r.TimeEntryID {
+			id = r.Op.TimeEntryID + " -> " + r.TimeEntryID
+		}
+
+		lines[i] = []string{
+			string(r.Op.Type),
+			id,
+			string(r.Status),
+			r.Reason,
+		}
+	}
+
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package profit
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// ProfitCSVPrint will print the profitability of each project as a CSV line
+func ProfitCSVPrint(pf Profit, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{
+		"client.id",
+		"client.name",
+		"project.id",
+		"project.name",
+		"duration",
+		"billableDuration",
+		"amount",
+		"cost",
+		"margin",
+		"marginPercentage",
+		"currency",
+	}); err != nil {
+		return err
+	}
+
+	amount := func(a int64) string {
+		return util.RateToString(&dto.Rate{Amount: a})
+	}
+
+	for _, c := range pf.Clients {
+		for _, p := range c.Projects {
+			if err := w.Write([]string{
+				c.ID,
+				c.Name,
+				p.ID,
+				p.Name,
+				util.DurationToString(p.Duration.Duration),
+				util.DurationToString(p.BillableDuration.Duration),
+				amount(p.Amount),
+				amount(p.Cost),
+				amount(p.Margin),
+				fmt.Sprintf("%.2f", p.MarginPercentage),
+				pf.Currency,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,43 @@
+package profit
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// ProfitPrint will print the profitability of each client and its projects
+// as a table, with a total line at the end
+func ProfitPrint(pf Profit, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"Client", "Project", "Duration", "Billable",
+		"Amount", "Cost", "Margin", "Margin %"})
+
+	for _, c := range pf.Clients {
+		tw.Append(line(c.Name, "", c.Totals, pf.Currency))
+		for _, p := range c.Projects {
+			tw.Append(line("", p.Name, p.Totals, pf.Currency))
+		}
+	}
+
+	tw.Append(line("TOTAL", "", pf.Totals, pf.Currency))
+	tw.Render()
+
+	return nil
+}
+
+func line(client, project string, t Totals, currency string) []string {
+	return []string{
+		client,
+		project,
+		util.DurationToString(t.Duration.Duration),
+		util.DurationToString(t.BillableDuration.Duration),
+		util.RateToString(&dto.Rate{Amount: t.Amount, Currency: currency}),
+		util.RateToString(&dto.Rate{Amount: t.Cost, Currency: currency}),
+		util.RateToString(&dto.Rate{Amount: t.Margin, Currency: currency}),
+		fmt.Sprintf("%.2f%%", t.MarginPercentage),
+	}
+}
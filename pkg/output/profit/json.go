@@ -0,0 +1,11 @@
+package profit
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ProfitJSONPrint will print the profitability as JSON
+func ProfitJSONPrint(pf Profit, w io.Writer) error {
+	return json.NewEncoder(w).Encode(pf)
+}
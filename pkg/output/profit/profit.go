@@ -0,0 +1,187 @@
+package profit
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+const (
+	withoutClient  = "Without client"
+	withoutProject = "Without project"
+)
+
+// Totals compares the billable amount with the labour cost of time entries,
+// amounts are in cents
+type Totals struct {
+	Duration         dto.Duration `json:"duration"`
+	BillableDuration dto.Duration `json:"billableDuration"`
+	Amount           int64        `json:"amount"`
+	Cost             int64        `json:"cost"`
+	Margin           int64        `json:"margin"`
+	// MarginPercentage is the margin over the billable amount, when nothing
+	// is billable it is -100% if there is any cost
+	MarginPercentage float64 `json:"marginPercentage"`
+}
+
+// Project is the profitability of a project
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Totals
+}
+
+// Client is the profitability of a client and its projects
+type Client struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Totals
+	Projects []Project `json:"projects"`
+}
+
+// Profit is the profitability of the clients and projects on a date range
+type Profit struct {
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Currency string    `json:"currency"`
+	Totals
+	Clients []Client `json:"clients"`
+}
+
+func (t *Totals) add(te dto.TimeEntry) {
+	e := time.Now()
+	if te.TimeInterval.End != nil {
+		e = *te.TimeInterval.End
+	}
+
+	d := e.Sub(te.TimeInterval.Start)
+	t.Duration.Duration += d
+	if te.Billable {
+		t.BillableDuration.Duration += d
+	}
+
+	t.Amount += te.TotalBillable
+	t.Cost += te.TotalCost
+	t.Margin = t.Amount - t.Cost
+
+	switch {
+	case t.Amount != 0:
+		t.MarginPercentage = float64(t.Margin*100) / float64(t.Amount)
+	case t.Cost != 0:
+		t.MarginPercentage = -100
+	default:
+		t.MarginPercentage = 0
+	}
+}
+
+// NewProfit totals the billable amounts and costs of the time entries by
+// client and project, the time entries must have their amounts and costs
+// already set. The clients of the projects are read from ps
+func NewProfit(
+	start, end time.Time, tes []dto.TimeEntry, ps []dto.Project,
+) (Profit, error) {
+	projects := make(map[string]dto.Project, len(ps))
+	for _, p := range ps {
+		projects[p.ID] = p
+	}
+
+	pf := Profit{Start: start, End: end, Clients: []Client{}}
+	clients := make(map[string]*Client)
+	pIndex := make(map[string]int)
+	for _, te := range tes {
+		if err := pf.checkCurrency(te); err != nil {
+			return pf, err
+		}
+
+		p := dto.Project{Name: withoutProject, ClientName: withoutClient}
+		if te.Project != nil {
+			p = *te.Project
+		} else if te.ProjectID != "" {
+			p.ID = te.ProjectID
+		}
+
+		if fp, ok := projects[p.ID]; ok {
+			p = fp
+		}
+
+		if p.ClientID == "" {
+			p.ClientName = withoutClient
+		}
+
+		c, ok := clients[p.ClientID]
+		if !ok {
+			c = &Client{ID: p.ClientID, Name: p.ClientName,
+				Projects: []Project{}}
+			clients[p.ClientID] = c
+		}
+
+		i, ok := pIndex[p.ID]
+		if !ok {
+			i = len(c.Projects)
+			pIndex[p.ID] = i
+			c.Projects = append(c.Projects, Project{ID: p.ID, Name: p.Name})
+		}
+
+		c.Projects[i].add(te)
+		c.add(te)
+		pf.add(te)
+	}
+
+	for _, c := range clients {
+		sort.Slice(c.Projects, func(i, j int) bool {
+			return less(c.Projects[i].ID, c.Projects[i].Name,
+				c.Projects[j].ID, c.Projects[j].Name)
+		})
+		pf.Clients = append(pf.Clients, *c)
+	}
+
+	sort.Slice(pf.Clients, func(i, j int) bool {
+		return less(pf.Clients[i].ID, pf.Clients[i].Name,
+			pf.Clients[j].ID, pf.Clients[j].Name)
+	})
+
+	return pf, nil
+}
+
+// checkCurrency assures that all amounts and costs are on the same currency,
+// as they can't be summed otherwise
+func (pf *Profit) checkCurrency(te dto.TimeEntry) error {
+	cs := make([]string, 0, 2)
+	if te.TotalBillable != 0 {
+		cs = append(cs, te.HourlyRate.Currency)
+	}
+
+	if te.CostRate != nil && te.TotalCost != 0 {
+		cs = append(cs, te.CostRate.Currency)
+	}
+
+	for _, c := range cs {
+		if pf.Currency == "" {
+			pf.Currency = c
+			continue
+		}
+
+		if c != pf.Currency {
+			return fmt.Errorf(
+				"can't compare amounts in more than one currency (%s and %s)",
+				pf.Currency, c)
+		}
+	}
+
+	return nil
+}
+
+// less sorts by name, leaving the "without" group (empty id) at the end
+func less(idA, nameA, idB, nameB string) bool {
+	if idA == "" || idB == "" {
+		return idB == "" && idA != ""
+	}
+
+	if nameA == nameB {
+		return idA < idB
+	}
+
+	return nameA < nameB
+}
@@ -0,0 +1,47 @@
+package profit
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// ProfitPrintWithTemplate will print the profitability of each project using
+// the format string, the client of the project and the currency are also
+// available
+func ProfitPrintWithTemplate(format string) func(Profit, io.Writer) error {
+	return func(pf Profit, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		l := 0
+		for _, c := range pf.Clients {
+			l += len(c.Projects)
+		}
+
+		i := 0
+		for _, c := range pf.Clients {
+			for _, p := range c.Projects {
+				if err := t.Execute(w, struct {
+					Project
+					Client   Client
+					Currency string
+					First    bool
+					Last     bool
+				}{
+					Project:  p,
+					Client:   c,
+					Currency: pf.Currency,
+					First:    i == 0,
+					Last:     i == (l - 1),
+				}); err != nil {
+					return err
+				}
+				i++
+			}
+		}
+		return nil
+	}
+}
@@ -9,20 +9,49 @@ import (
 
 // ProjectsCSVPrint will print each time entry using the format string
 func ProjectsCSVPrint(ps []dto.Project, out io.Writer) error {
-	w := csv.NewWriter(out)
+	w := NewProjectsCSVWriter(out)
+	if err := w.Write(ps); err != nil {
+		return err
+	}
 
-	if err := w.Write([]string{
+	return w.Close()
+}
+
+// ProjectsCSVWriter prints projects as CSV as they are written, so pages can
+// be printed before all of them are loaded
+type ProjectsCSVWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+// NewProjectsCSVWriter creates a ProjectsCSVWriter printing into out
+func NewProjectsCSVWriter(out io.Writer) *ProjectsCSVWriter {
+	return &ProjectsCSVWriter{w: csv.NewWriter(out)}
+}
+
+func (pw *ProjectsCSVWriter) writeHeader() error {
+	if pw.header {
+		return nil
+	}
+
+	pw.header = true
+	return pw.w.Write([]string{
 		"id",
 		"name",
 		"client.id",
 		"client.name",
-	}); err != nil {
+	})
+}
+
+// Write prints the projects, printing the header first if needed
+func (pw *ProjectsCSVWriter) Write(ps []dto.Project) error {
+	if err := pw.writeHeader(); err != nil {
 		return err
 	}
 
 	for i := 0; i < len(ps); i++ {
 		p := ps[i]
-		if err := w.Write([]string{
+		if err := pw.w.Write([]string{
 			p.ID,
 			p.Name,
 			p.ClientID,
@@ -32,6 +61,16 @@ func ProjectsCSVPrint(ps []dto.Project, out io.Writer) error {
 		}
 	}
 
-	w.Flush()
-	return w.Error()
+	pw.w.Flush()
+	return pw.w.Error()
+}
+
+// Close prints the header if no project was written
+func (pw *ProjectsCSVWriter) Close() error {
+	if err := pw.writeHeader(); err != nil {
+		return err
+	}
+
+	pw.w.Flush()
+	return pw.w.Error()
 }
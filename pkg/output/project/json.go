@@ -16,3 +16,52 @@ func ProjectsJSONPrint(t []dto.Project, w io.Writer) error {
 func ProjectJSONPrint(t dto.Project, w io.Writer) error {
 	return json.NewEncoder(w).Encode(t)
 }
+
+// ProjectsJSONWriter prints projects as a JSON array as they are written, so
+// pages can be printed before all of them are loaded
+type ProjectsJSONWriter struct {
+	out   io.Writer
+	count int
+}
+
+// NewProjectsJSONWriter creates a ProjectsJSONWriter printing into out
+func NewProjectsJSONWriter(out io.Writer) *ProjectsJSONWriter {
+	return &ProjectsJSONWriter{out: out}
+}
+
+// Write prints the projects as items of the array
+func (pw *ProjectsJSONWriter) Write(ps []dto.Project) error {
+	for i := range ps {
+		b, err := json.Marshal(ps[i])
+		if err != nil {
+			return err
+		}
+
+		sep := ","
+		if pw.count == 0 {
+			sep = "["
+		}
+		pw.count++
+
+		if _, err := io.WriteString(pw.out, sep); err != nil {
+			return err
+		}
+
+		if _, err := pw.out.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close ends the array
+func (pw *ProjectsJSONWriter) Close() error {
+	end := "]\n"
+	if pw.count == 0 {
+		end = "[]\n"
+	}
+
+	_, err := io.WriteString(pw.out, end)
+	return err
+}
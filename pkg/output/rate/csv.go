@@ -0,0 +1,65 @@
+package rate
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// ProjectUserRatesCSVPrint will print the rates as CSV
+func ProjectUserRatesCSVPrint(rs []ProjectUserRate, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{
+		"project.id",
+		"project.name",
+		"user.id",
+		"user.name",
+		"user.email",
+		"workspaceHourlyRate.amount",
+		"workspaceHourlyRate.currency",
+		"projectHourlyRate.amount",
+		"projectHourlyRate.currency",
+		"projectCostRate.amount",
+		"projectCostRate.currency",
+		"hourlyRate.amount",
+		"hourlyRate.currency",
+		"costRate.amount",
+		"costRate.currency",
+	}); err != nil {
+		return err
+	}
+
+	rate := func(r *dto.Rate) []string {
+		if r == nil {
+			return []string{"", ""}
+		}
+
+		return []string{strconv.FormatInt(r.Amount, 10), r.Currency}
+	}
+
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		line := []string{
+			r.ProjectID,
+			r.ProjectName,
+			r.UserID,
+			r.UserName,
+			r.UserEmail,
+		}
+		line = append(line, rate(r.WorkspaceHourlyRate)...)
+		line = append(line, rate(r.ProjectHourlyRate)...)
+		line = append(line, rate(r.ProjectCostRate)...)
+		line = append(line, rate(r.HourlyRate)...)
+		line = append(line, rate(r.CostRate)...)
+
+		if err := w.Write(line); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
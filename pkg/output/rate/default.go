@@ -0,0 +1,38 @@
+package rate
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// ProjectUserRatesPrint will print the rates as a table
+func ProjectUserRatesPrint(rs []ProjectUserRate, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"Project", "User", "Workspace Rate",
+		"Project Rate", "Project Cost", "Member Rate", "Member Cost"})
+
+	lines := make([][]string, len(rs))
+	for i := 0; i < len(rs); i++ {
+		user := rs[i].UserName
+		if user == "" {
+			user = rs[i].UserID
+		}
+
+		lines[i] = []string{
+			rs[i].ProjectName,
+			user,
+			util.RateToString(rs[i].WorkspaceHourlyRate),
+			util.RateToString(rs[i].ProjectHourlyRate),
+			util.RateToString(rs[i].ProjectCostRate),
+			util.RateToString(rs[i].HourlyRate),
+			util.RateToString(rs[i].CostRate),
+		}
+	}
+
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package rate
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ProjectUserRatesJSONPrint will print the rates as JSON
+func ProjectUserRatesJSONPrint(rs []ProjectUserRate, w io.Writer) error {
+	return json.NewEncoder(w).Encode(rs)
+}
@@ -0,0 +1,71 @@
+package rate
+
+import "github.com/lucassabreu/clockify-cli/api/dto"
+
+// ProjectUserRate shows the rates of a user on a project side by side with
+// the rates of the workspace and of the project, the most specific one set is
+// the one applied by Clockify
+type ProjectUserRate struct {
+	ProjectID   string `json:"projectId"`
+	ProjectName string `json:"projectName"`
+	UserID      string `json:"userId"`
+	UserName    string `json:"userName"`
+	UserEmail   string `json:"userEmail"`
+
+	WorkspaceHourlyRate *dto.Rate `json:"workspaceHourlyRate"`
+	ProjectHourlyRate   *dto.Rate `json:"projectHourlyRate"`
+	ProjectCostRate     *dto.Rate `json:"projectCostRate"`
+	HourlyRate          *dto.Rate `json:"hourlyRate"`
+	CostRate            *dto.Rate `json:"costRate"`
+}
+
+// NewProjectUserRates reads the rates of each member of the project, workspace
+// rates set for an user have precedence over the workspace default
+func NewProjectUserRates(
+	w dto.Workspace, p dto.Project, users map[string]dto.User,
+) []ProjectUserRate {
+	wr := make(map[string]*dto.Rate, len(w.Memberships))
+	for i := range w.Memberships {
+		if w.Memberships[i].HourlyRate != nil {
+			wr[w.Memberships[i].UserID] = w.Memberships[i].HourlyRate
+		}
+	}
+
+	var ph *dto.Rate
+	if p.HourlyRate.Amount != 0 {
+		r := p.HourlyRate
+		ph = &r
+	}
+
+	rs := make([]ProjectUserRate, 0, len(p.Memberships))
+	for _, m := range p.Memberships {
+		if m.Type == "USERGROUP" {
+			continue
+		}
+
+		r := ProjectUserRate{
+			ProjectID:         p.ID,
+			ProjectName:       p.Name,
+			UserID:            m.UserID,
+			ProjectHourlyRate: ph,
+			ProjectCostRate:   p.CostRate,
+			HourlyRate:        m.HourlyRate,
+			CostRate:          m.CostRate,
+		}
+
+		if u, ok := users[m.UserID]; ok {
+			r.UserName = u.Name
+			r.UserEmail = u.Email
+		}
+
+		r.WorkspaceHourlyRate = wr[m.UserID]
+		if r.WorkspaceHourlyRate == nil && w.HourlyRate.Amount != 0 {
+			wh := w.HourlyRate
+			r.WorkspaceHourlyRate = &wh
+		}
+
+		rs = append(rs, r)
+	}
+
+	return rs
+}
@@ -0,0 +1,26 @@
+package rate
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// ProjectUserRatesPrintWithTemplate will print each rate using the format
+// string
+func ProjectUserRatesPrintWithTemplate(
+	format string) func([]ProjectUserRate, io.Writer) error {
+	return func(rs []ProjectUserRate, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(rs); i++ {
+			if err := t.Execute(w, rs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,58 @@
+package summary
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// SummaryCSVPrint will print each group of the summary as a CSV line, with a
+// id and name column for each field it was grouped by
+func SummaryCSVPrint(s Summary, out io.Writer) error {
+	w := csv.NewWriter(out)
+	l := len(s.GroupBy) * 2
+
+	header := make([]string, l, l+3)
+	for i, by := range s.GroupBy {
+		header[i*2] = string(by) + ".id"
+		header[i*2+1] = string(by) + ".name"
+	}
+
+	if err := w.Write(
+		append(header, "duration", "percentage", "count")); err != nil {
+		return err
+	}
+
+	if err := writeGroups(w, s.Groups, make([]string, l)); err != nil {
+		return err
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func writeGroups(w *csv.Writer, gs []Group, parent []string) error {
+	for _, g := range gs {
+		line := make([]string, len(parent), len(parent)+3)
+		copy(line, parent)
+		line[g.Level*2] = g.ID
+		line[g.Level*2+1] = g.Name
+
+		if err := w.Write(append(line,
+			util.DurationToString(g.Duration.Duration),
+			fmt.Sprintf("%.2f", g.Percentage),
+			strconv.Itoa(g.Count),
+		)); err != nil {
+			return err
+		}
+
+		if err := writeGroups(w, g.Groups, line[:len(parent)]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package summary
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// SummaryPrint will print the summary as a table, with one column for each
+// field it was grouped by and a total line at the end
+func SummaryPrint(s Summary, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader(header(s))
+	tw.AppendBulk(lines(s, "TOTAL"))
+	tw.Render()
+
+	return nil
+}
+
+func header(s Summary) []string {
+	h := make([]string, len(s.GroupBy), len(s.GroupBy)+3)
+	for i, by := range s.GroupBy {
+		h[i] = string(by)
+	}
+
+	return append(h, "Duration", "Percentage", "Entries")
+}
+
+func lines(s Summary, total string) [][]string {
+	gs := s.Flatten()
+	l := len(s.GroupBy)
+	ls := make([][]string, 0, len(gs)+1)
+	for _, g := range gs {
+		line := make([]string, l, l+3)
+		line[g.Level] = g.Name
+		ls = append(ls, append(line,
+			util.DurationToString(g.Duration.Duration),
+			fmt.Sprintf("%.2f%%", g.Percentage),
+			strconv.Itoa(g.Count),
+		))
+	}
+
+	line := make([]string, l, l+3)
+	line[0] = total
+	return append(ls, append(line,
+		util.DurationToString(s.Duration.Duration),
+		fmt.Sprintf("%.2f%%", float64(100)),
+		strconv.Itoa(s.Count),
+	))
+}
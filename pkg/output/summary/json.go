@@ -0,0 +1,11 @@
+package summary
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// SummaryJSONPrint will print the summary as JSON
+func SummaryJSONPrint(s Summary, w io.Writer) error {
+	return json.NewEncoder(w).Encode(s)
+}
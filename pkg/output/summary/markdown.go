@@ -0,0 +1,22 @@
+package summary
+
+import (
+	"io"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// SummaryMarkdownPrint will print the summary as a markdown table
+func SummaryMarkdownPrint(s Summary, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader(header(s))
+	tw.SetAutoFormatHeaders(false)
+	tw.SetBorders(tablewriter.Border{
+		Left: true, Top: false, Right: true, Bottom: false})
+	tw.SetCenterSeparator("|")
+	tw.SetAutoWrapText(false)
+	tw.AppendBulk(lines(s, "**Total**"))
+	tw.Render()
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package summary
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// GroupBy is a field of the time entries used to group them
+type GroupBy string
+
+const (
+	GroupByProject     GroupBy = "project"
+	GroupByClient      GroupBy = "client"
+	GroupByTask        GroupBy = "task"
+	GroupByTag         GroupBy = "tag"
+	GroupByDay         GroupBy = "day"
+	GroupByWeek        GroupBy = "week"
+	GroupByDescription GroupBy = "description"
+	GroupByUser        GroupBy = "user"
+)
+
+// GroupByOptions lists the fields time entries can be grouped by
+var GroupByOptions = []GroupBy{
+	GroupByProject,
+	GroupByClient,
+	GroupByTask,
+	GroupByTag,
+	GroupByDay,
+	GroupByWeek,
+	GroupByDescription,
+	GroupByUser,
+}
+
+const dateFormat = "2006-01-02"
+
+// Summary is the sum of the durations of a list of time entries, grouped by
+// one or more fields
+type Summary struct {
+	GroupBy  []GroupBy    `json:"groupBy"`
+	Duration dto.Duration `json:"duration"`
+	Count    int          `json:"count"`
+	Groups   []Group      `json:"groups"`
+}
+
+// Group is the subtotal of the time entries sharing the same value on a field,
+// Groups will have the subtotals for the next field, if any
+type Group struct {
+	By         GroupBy      `json:"by"`
+	Level      int          `json:"level"`
+	ID         string       `json:"id"`
+	Name       string       `json:"name"`
+	Path       []string     `json:"-"`
+	Duration   dto.Duration `json:"duration"`
+	Percentage float64      `json:"percentage"`
+	Count      int          `json:"count"`
+	Groups     []Group      `json:"groups,omitempty"`
+}
+
+// NewSummary groups the time entries by the fields informed, in order.
+//
+// The percentage of each group is relative to the total duration, time
+// entries with more than one tag are counted in all of their tags.
+func NewSummary(tes []dto.TimeEntry, by []GroupBy) Summary {
+	total := sumDuration(tes)
+	return Summary{
+		GroupBy:  by,
+		Duration: dto.Duration{Duration: total},
+		Count:    len(tes),
+		Groups:   group(tes, by, 0, []string{}, total),
+	}
+}
+
+// Flatten returns the groups and its subgroups depth-first
+func (s Summary) Flatten() []Group {
+	return flatten(s.Groups)
+}
+
+func flatten(gs []Group) []Group {
+	l := make([]Group, 0, len(gs))
+	for _, g := range gs {
+		l = append(l, g)
+		l = append(l, flatten(g.Groups)...)
+	}
+
+	return l
+}
+
+type key struct {
+	id   string
+	name string
+}
+
+func group(
+	tes []dto.TimeEntry, by []GroupBy, level int, path []string,
+	total time.Duration,
+) []Group {
+	if len(by) == 0 {
+		return nil
+	}
+
+	keys := make([]key, 0)
+	entries := make(map[key][]dto.TimeEntry)
+	for _, te := range tes {
+		for _, k := range keysOf(te, by[0]) {
+			if _, ok := entries[k]; !ok {
+				keys = append(keys, k)
+			}
+			entries[k] = append(entries[k], te)
+		}
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return less(by[0], keys[i], keys[j])
+	})
+
+	gs := make([]Group, len(keys))
+	for i, k := range keys {
+		d := sumDuration(entries[k])
+		p := append(append([]string{}, path...), k.name)
+		gs[i] = Group{
+			By:       by[0],
+			Level:    level,
+			ID:       k.id,
+			Name:     k.name,
+			Path:     p,
+			Duration: dto.Duration{Duration: d},
+			Count:    len(entries[k]),
+			Groups:   group(entries[k], by[1:], level+1, p, total),
+		}
+
+		if total != 0 {
+			gs[i].Percentage = float64(d) / float64(total) * 100
+		}
+	}
+
+	return gs
+}
+
+// less sorts days and weeks by date, and the other fields by name, keeping
+// the time entries without a value at the end
+func less(by GroupBy, a, b key) bool {
+	if (a.id == "") != (b.id == "") {
+		return b.id == ""
+	}
+
+	if by == GroupByDay || by == GroupByWeek {
+		return a.id < b.id
+	}
+
+	return strings.ToLower(a.name) < strings.ToLower(b.name)
+}
+
+func keysOf(te dto.TimeEntry, by GroupBy) []key {
+	switch by {
+	case GroupByProject:
+		if te.Project == nil {
+			if te.ProjectID == "" {
+				return []key{{name: "Without project"}}
+			}
+			return []key{{id: te.ProjectID, name: te.ProjectID}}
+		}
+		return []key{{id: te.Project.ID, name: te.Project.Name}}
+	case GroupByClient:
+		if te.Project == nil || te.Project.ClientID == "" {
+			return []key{{name: "Without client"}}
+		}
+		return []key{{id: te.Project.ClientID, name: te.Project.ClientName}}
+	case GroupByTask:
+		if te.Task == nil {
+			return []key{{name: "Without task"}}
+		}
+		return []key{{id: te.Task.ID, name: te.Task.Name}}
+	case GroupByTag:
+		if len(te.Tags) == 0 {
+			return []key{{name: "Without tag"}}
+		}
+
+		ks := make([]key, len(te.Tags))
+		for i, t := range te.Tags {
+			ks[i] = key{id: t.ID, name: t.Name}
+		}
+		return ks
+	case GroupByDay:
+		d := te.TimeInterval.Start.In(time.Local).Format(dateFormat)
+		return []key{{id: d, name: d}}
+	case GroupByWeek:
+		first, _ := timehlp.GetWeekRange(timehlp.TruncateDateWithTimezone(
+			te.TimeInterval.Start.In(time.Local), time.Local))
+		return []key{{
+			id: first.Format(dateFormat),
+			name: first.Format(dateFormat) + " - " +
+				first.AddDate(0, 0, 6).Format(dateFormat),
+		}}
+	case GroupByDescription:
+		if te.Description == "" {
+			return []key{{name: "Without description"}}
+		}
+		return []key{{id: te.Description, name: te.Description}}
+	case GroupByUser:
+		if te.User == nil {
+			return []key{{name: "Without user"}}
+		}
+		return []key{{id: te.User.ID, name: te.User.Name}}
+	}
+
+	return []key{{}}
+}
+
+func sumDuration(tes []dto.TimeEntry) time.Duration {
+	s := time.Duration(0)
+	for _, te := range tes {
+		end := time.Now()
+		if te.TimeInterval.End != nil {
+			end = *te.TimeInterval.End
+		}
+
+		s = s + end.Sub(te.TimeInterval.Start)
+	}
+
+	return s
+}
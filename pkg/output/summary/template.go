@@ -0,0 +1,35 @@
+package summary
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// SummaryPrintWithTemplate will print each group of the summary using the
+// format string
+func SummaryPrintWithTemplate(format string) func(Summary, io.Writer) error {
+	return func(s Summary, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		gs := s.Flatten()
+		l := len(gs)
+		for i := 0; i < l; i++ {
+			if err := t.Execute(w, struct {
+				Group
+				First bool
+				Last  bool
+			}{
+				Group: gs[i],
+				First: i == 0,
+				Last:  i == (l - 1),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
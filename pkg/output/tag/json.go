@@ -0,0 +1,18 @@
+package tag
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// TagJSONPrint will print as JSON
+func TagJSONPrint(t dto.Tag, w io.Writer) error {
+	return json.NewEncoder(w).Encode(t)
+}
+
+// TagsJSONPrint will print as JSON
+func TagsJSONPrint(t []dto.Tag, w io.Writer) error {
+	return json.NewEncoder(w).Encode(t)
+}
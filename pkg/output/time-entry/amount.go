@@ -0,0 +1,90 @@
+package timeentry
+
+import (
+	"sort"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// WithAmounts shows the billable amount (TotalBillable) of each time entry,
+// and the totals by currency
+func WithAmounts() TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.ShowAmounts = true
+		return nil
+	}
+}
+
+// WithCosts shows the labour cost (TotalCost) of each time entry, and the
+// totals by currency
+func WithCosts() TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.ShowCosts = true
+		return nil
+	}
+}
+
+// amountToString formats a amount in cents with its currency
+func amountToString(r dto.Rate) string {
+	return util.RateToString(&r)
+}
+
+// timeEntryAmount is the billable amount of the time entry as a rate
+func timeEntryAmount(te dto.TimeEntry) dto.Rate {
+	return dto.Rate{Amount: te.TotalBillable, Currency: te.HourlyRate.Currency}
+}
+
+// timeEntryCost is the labour cost of the time entry as a rate
+func timeEntryCost(te dto.TimeEntry) dto.Rate {
+	r := dto.Rate{Amount: te.TotalCost}
+	if te.CostRate != nil {
+		r.Currency = te.CostRate.Currency
+	}
+
+	return r
+}
+
+// sumAmounts totals the billable amount of the time entries by currency
+func sumAmounts(tes []dto.TimeEntry) []dto.Rate {
+	return sumByCurrency(tes, timeEntryAmount)
+}
+
+// sumCosts totals the labour cost of the time entries by currency
+func sumCosts(tes []dto.TimeEntry) []dto.Rate {
+	return sumByCurrency(tes, timeEntryCost)
+}
+
+func sumByCurrency(
+	tes []dto.TimeEntry, value func(dto.TimeEntry) dto.Rate,
+) []dto.Rate {
+	totals := make(map[string]int64)
+	for _, te := range tes {
+		v := value(te)
+		if v.Amount == 0 {
+			continue
+		}
+
+		totals[v.Currency] += v.Amount
+	}
+
+	rs := make([]dto.Rate, 0, len(totals))
+	for c, a := range totals {
+		rs = append(rs, dto.Rate{Amount: a, Currency: c})
+	}
+
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Currency < rs[j].Currency
+	})
+
+	return rs
+}
+
+func amountsToString(rs []dto.Rate) []string {
+	s := make([]string, len(rs))
+	for i := range rs {
+		s[i] = amountToString(rs[i])
+	}
+
+	return s
+}
@@ -3,16 +3,28 @@ package timeentry
 import (
 	"encoding/csv"
 	"io"
+	"strconv"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
 )
 
-// TimeEntriesCSVPrint will print each time entry using the format string
-func TimeEntriesCSVPrint(timeEntries []dto.TimeEntry, out io.Writer) error {
+// TimeEntriesCSVPrint will print each time entry using the format string,
+// WithAmounts adds the billable columns before the tags, custom fields with
+// values are added as columns before the tags too
+func TimeEntriesCSVPrint(
+	timeEntries []dto.TimeEntry, out io.Writer, opts ...TimeEntryOutputOpt,
+) error {
+	options := &TimeEntryOutputOptions{}
+	for _, o := range opts {
+		if err := o(options); err != nil {
+			return err
+		}
+	}
+
 	w := csv.NewWriter(out)
 
-	if err := w.Write([]string{
+	header := []string{
 		"id",
 		"description",
 		"project.id",
@@ -25,8 +37,23 @@ func TimeEntriesCSVPrint(timeEntries []dto.TimeEntry, out io.Writer) error {
 		"user.id",
 		"user.email",
 		"user.name",
-		"tags...",
-	}); err != nil {
+	}
+
+	if options.ShowAmounts {
+		header = append(header,
+			"billable", "hourlyRate", "amount", "currency")
+	}
+
+	if options.ShowCosts {
+		header = append(header, "costRate", "cost", "costCurrency")
+	}
+
+	cfNames := customFieldNames(timeEntries)
+	for _, n := range cfNames {
+		header = append(header, "customField."+n)
+	}
+
+	if err := w.Write(append(header, "tags...")); err != nil {
 		return err
 	}
 
@@ -74,12 +101,87 @@ func TimeEntriesCSVPrint(timeEntries []dto.TimeEntry, out io.Writer) error {
 			te.User.Name,
 		}
 
+		if options.ShowAmounts {
+			arr = append(arr,
+				strconv.FormatBool(te.Billable),
+				amountToString(dto.Rate{Amount: te.HourlyRate.Amount}),
+				amountToString(dto.Rate{Amount: te.TotalBillable}),
+				te.HourlyRate.Currency,
+			)
+		}
+
+		if options.ShowCosts {
+			c := timeEntryCost(te)
+			var r dto.Rate
+			if te.CostRate != nil {
+				r.Amount = te.CostRate.Amount
+			}
+
+			arr = append(arr,
+				amountToString(r),
+				amountToString(dto.Rate{Amount: c.Amount}),
+				c.Currency,
+			)
+		}
+
+		cfs := make(map[string]string, len(te.CustomFields))
+		for _, v := range te.CustomFields {
+			cfs[customFieldName(v)] = v.String()
+		}
+
+		for _, n := range cfNames {
+			arr = append(arr, cfs[n])
+		}
+
 		if err := w.Write(append(
 			arr, tagsToStringSlice(te.Tags)...)); err != nil {
 			return err
 		}
 	}
 
+	if err := writeCSVTotals(w, header, timeEntries, options); err != nil {
+		return err
+	}
+
 	w.Flush()
 	return w.Error()
 }
+
+// writeCSVTotals adds a "total" line for each currency at the end of the CSV,
+// if WithAmounts or WithCosts are used
+func writeCSVTotals(
+	w *csv.Writer, header []string,
+	tes []dto.TimeEntry, options *TimeEntryOutputOptions,
+) error {
+	if !options.ShowAmounts && !options.ShowCosts {
+		return nil
+	}
+
+	idx := make(map[string]int, len(header))
+	for i, h := range header {
+		idx[h] = i
+	}
+
+	for _, c := range currenciesOf(tes, options) {
+		line := make([]string, len(header))
+		line[0] = "total"
+
+		if options.ShowAmounts {
+			line[idx["amount"]] = amountToString(
+				dto.Rate{Amount: totalOf(sumAmounts(tes), c)})
+			line[idx["currency"]] = c
+		}
+
+		if options.ShowCosts {
+			line[idx["cost"]] = amountToString(
+				dto.Rate{Amount: totalOf(sumCosts(tes), c)})
+			line[idx["costCurrency"]] = c
+		}
+
+		if err := w.Write(line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
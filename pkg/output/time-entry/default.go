@@ -37,7 +37,11 @@ const (
 type TimeEntryOutputOptions struct {
 	ShowTasks         bool
 	ShowTotalDuration bool
+	ShowUser          bool
+	ShowAmounts       bool
+	ShowCosts         bool
 	TimeFormat        string
+	WorkweekDays      []string
 }
 
 // WithTimeFormat sets the date-time output format
@@ -65,6 +69,14 @@ func WithTotalDuration() TimeEntryOutputOpt {
 	}
 }
 
+// WithShowUser shows a new column with the user of the time entry
+func WithShowUser() TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.ShowUser = true
+		return nil
+	}
+}
+
 // TimeEntryOutputOpt allows the setting of TimeEntryOutputOptions values
 type TimeEntryOutputOpt func(*TimeEntryOutputOptions) error
 
@@ -87,6 +99,8 @@ func TimeEntriesPrint(opts ...TimeEntryOutputOpt) func([]dto.TimeEntry, io.Write
 		tw := tablewriter.NewWriter(w)
 		taskColumn := 6
 		projectColumn := 4
+		durationColumn := 3
+		userColumn := 1
 		header := []string{"ID", "Start", "End", "Dur",
 			"Project", "Description", "Tags"}
 		if options.ShowTasks {
@@ -97,6 +111,31 @@ func TimeEntriesPrint(opts ...TimeEntryOutputOpt) func([]dto.TimeEntry, io.Write
 			header[taskColumn] = "Task"
 		}
 
+		if options.ShowUser {
+			header = append(
+				header[:userColumn+1],
+				header[userColumn:]...,
+			)
+			header[userColumn] = "User"
+			projectColumn++
+			durationColumn++
+		}
+
+		showCustomFields := len(customFieldNames(timeEntries)) > 0
+		if showCustomFields {
+			header = append(header, "Custom Fields")
+		}
+
+		amountColumn := len(header)
+		if options.ShowAmounts {
+			header = append(header, "Amount")
+		}
+
+		costColumn := len(header)
+		if options.ShowCosts {
+			header = append(header, "Cost")
+		}
+
 		tw.SetHeader(header)
 		tw.SetRowLine(true)
 		if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
@@ -136,13 +175,48 @@ func TimeEntriesPrint(opts ...TimeEntryOutputOpt) func([]dto.TimeEntry, io.Write
 				}
 			}
 
+			if options.ShowUser {
+				line = append(line[:userColumn+1], line[userColumn:]...)
+				line[userColumn] = ""
+				if t.User != nil {
+					line[userColumn] = t.User.Name
+				}
+			}
+
+			if showCustomFields {
+				line = append(line, strings.Join(
+					customFieldsToStringSlice(t.CustomFields), "\n"))
+			}
+
+			if options.ShowAmounts {
+				line = append(line, amountToString(timeEntryAmount(t)))
+			}
+
+			if options.ShowCosts {
+				line = append(line, amountToString(timeEntryCost(t)))
+			}
+
 			tw.Rich(line, colors)
 		}
 
-		if options.ShowTotalDuration {
+		if options.ShowTotalDuration || options.ShowAmounts ||
+			options.ShowCosts {
 			line := make([]string, len(header))
 			line[0] = "TOTAL"
-			line[3] = durationToString(sumTimeEntriesDuration(timeEntries))
+			if options.ShowTotalDuration {
+				line[durationColumn] = durationToString(
+					sumTimeEntriesDuration(timeEntries))
+			}
+
+			if options.ShowAmounts {
+				line[amountColumn] = strings.Join(
+					amountsToString(sumAmounts(timeEntries)), "\n")
+			}
+
+			if options.ShowCosts {
+				line[costColumn] = strings.Join(
+					amountsToString(sumCosts(timeEntries)), "\n")
+			}
 			tw.Append(line)
 		}
 
@@ -162,6 +236,51 @@ func tagsToStringSlice(tags []dto.Tag) []string {
 	return s
 }
 
+// customFieldName returns the name of the custom field, or its id if the
+// name is unknown
+func customFieldName(v dto.CustomFieldValue) string {
+	if v.Name != "" {
+		return v.Name
+	}
+
+	return v.CustomFieldID
+}
+
+func customFieldsToStringSlice(cfs []dto.CustomFieldValue) []string {
+	s := make([]string, 0, len(cfs))
+
+	for _, v := range cfs {
+		if v.String() == "" {
+			continue
+		}
+
+		s = append(s, fmt.Sprintf("%s: %s", customFieldName(v), v))
+	}
+
+	return s
+}
+
+// customFieldNames returns the names of the custom fields with values on
+// the time entries, in the order they first appear
+func customFieldNames(tes []dto.TimeEntry) []string {
+	names := []string{}
+	known := map[string]bool{}
+
+	for _, te := range tes {
+		for _, v := range te.CustomFields {
+			n := customFieldName(v)
+			if known[n] || v.String() == "" {
+				continue
+			}
+
+			known[n] = true
+			names = append(names, n)
+		}
+	}
+
+	return names
+}
+
 func durationToString(d time.Duration) string {
 	p := ""
 	if d < 0 {
@@ -3,6 +3,7 @@ package timeentry
 import (
 	"encoding/json"
 	"io"
+	"sort"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
 )
@@ -12,7 +13,93 @@ func TimeEntryJSONPrint(t dto.TimeEntry, w io.Writer) error {
 	return json.NewEncoder(w).Encode(t)
 }
 
-// TimeEntriesJSONPrint will print as JSON
-func TimeEntriesJSONPrint(t []dto.TimeEntry, w io.Writer) error {
-	return json.NewEncoder(w).Encode(t)
+// CurrencyTotals are the sum of the billable amounts and/or labour costs of
+// time entries on a currency, in cents
+type CurrencyTotals struct {
+	Amount *int64 `json:"amount,omitempty"`
+	Cost   *int64 `json:"cost,omitempty"`
+}
+
+// TimeEntriesWithTotals is the JSON output of time entries when WithAmounts
+// or WithCosts are used
+type TimeEntriesWithTotals struct {
+	TimeEntries []dto.TimeEntry           `json:"timeEntries"`
+	Totals      map[string]CurrencyTotals `json:"totals"`
+}
+
+// TimeEntriesJSONPrint will print as JSON, if WithAmounts or WithCosts are
+// used the time entries will be printed with the totals by currency
+func TimeEntriesJSONPrint(
+	t []dto.TimeEntry, w io.Writer, opts ...TimeEntryOutputOpt,
+) error {
+	options := &TimeEntryOutputOptions{}
+	for _, o := range opts {
+		if err := o(options); err != nil {
+			return err
+		}
+	}
+
+	if !options.ShowAmounts && !options.ShowCosts {
+		return json.NewEncoder(w).Encode(t)
+	}
+
+	totals := make(map[string]CurrencyTotals)
+	for _, c := range currenciesOf(t, options) {
+		ct := CurrencyTotals{}
+		if options.ShowAmounts {
+			a := totalOf(sumAmounts(t), c)
+			ct.Amount = &a
+		}
+
+		if options.ShowCosts {
+			a := totalOf(sumCosts(t), c)
+			ct.Cost = &a
+		}
+
+		totals[c] = ct
+	}
+
+	return json.NewEncoder(w).Encode(TimeEntriesWithTotals{
+		TimeEntries: t,
+		Totals:      totals,
+	})
+}
+
+// currenciesOf returns the currencies with amounts or costs on the time
+// entries, sorted
+func currenciesOf(
+	tes []dto.TimeEntry, options *TimeEntryOutputOptions) []string {
+	rs := make([]dto.Rate, 0)
+	if options.ShowAmounts {
+		rs = append(rs, sumAmounts(tes)...)
+	}
+
+	if options.ShowCosts {
+		rs = append(rs, sumCosts(tes)...)
+	}
+
+	cs := make([]string, 0, len(rs))
+	seen := make(map[string]bool, len(rs))
+	for _, r := range rs {
+		if seen[r.Currency] {
+			continue
+		}
+
+		seen[r.Currency] = true
+		cs = append(cs, r.Currency)
+	}
+
+	sort.Strings(cs)
+	return cs
+}
+
+// totalOf returns the amount of the currency on the totals
+func totalOf(rs []dto.Rate, currency string) int64 {
+	for _, r := range rs {
+		if r.Currency == currency {
+			return r.Amount
+		}
+	}
+
+	return 0
 }
@@ -11,6 +11,15 @@ import (
 var mdTemplate string
 
 // TimeEntriesMarkdownPrint will print time entries in "markdown blocks"
-func TimeEntriesMarkdownPrint(tes []dto.TimeEntry, w io.Writer) error {
-	return TimeEntriesPrintWithTemplate(mdTemplate)(tes, w)
+func TimeEntriesMarkdownPrint(
+	tes []dto.TimeEntry, w io.Writer, opts ...TimeEntryOutputOpt,
+) error {
+	options := TimeEntryOutputOptions{}
+	for _, o := range opts {
+		if err := o(&options); err != nil {
+			return err
+		}
+	}
+
+	return printWithAmountsTemplate(mdTemplate, tes, w, options)
 }
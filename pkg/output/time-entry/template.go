@@ -35,3 +35,55 @@ func TimeEntriesPrintWithTemplate(
 		return nil
 	}
 }
+
+// printWithAmountsTemplate prints each time entry using the format string,
+// adding the formatted billable amount and cost of the time entry and the
+// totals by currency when asked to show them
+func printWithAmountsTemplate(
+	format string,
+	timeEntries []dto.TimeEntry,
+	w io.Writer,
+	options TimeEntryOutputOptions,
+) error {
+	t, err := util.NewTemplate(format)
+	if err != nil {
+		return err
+	}
+
+	var totals, totalCosts []string
+	if options.ShowAmounts {
+		totals = amountsToString(sumAmounts(timeEntries))
+	}
+
+	if options.ShowCosts {
+		totalCosts = amountsToString(sumCosts(timeEntries))
+	}
+
+	l := len(timeEntries)
+	for i := 0; i < l; i++ {
+		if err := t.Execute(w, struct {
+			dto.TimeEntry
+			First        bool
+			Last         bool
+			ShowAmounts  bool
+			Amount       string
+			TotalAmounts []string
+			ShowCosts    bool
+			Cost         string
+			TotalCosts   []string
+		}{
+			TimeEntry:    timeEntries[i],
+			First:        i == 0,
+			Last:         i == (l - 1),
+			ShowAmounts:  options.ShowAmounts,
+			Amount:       amountToString(timeEntryAmount(timeEntries[i])),
+			TotalAmounts: totals,
+			ShowCosts:    options.ShowCosts,
+			Cost:         amountToString(timeEntryCost(timeEntries[i])),
+			TotalCosts:   totalCosts,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+package timeentry
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/olekukonko/tablewriter"
+)
+
+// WithWorkweekDays sets which days of the week are working days, the other
+// days will be hidden on timesheets when there is no time tracked on them
+func WithWorkweekDays(days []string) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.WorkweekDays = days
+		return nil
+	}
+}
+
+type timesheetRow struct {
+	projectID   string
+	projectName string
+	taskID      string
+	taskName    string
+	days        [7]time.Duration
+	total       time.Duration
+}
+
+type timesheetWeek struct {
+	first time.Time
+	rows  []*timesheetRow
+	days  [7]time.Duration
+	total time.Duration
+}
+
+// newTimesheetWeeks splits the time entries by week and by project (and task
+// if showTasks is true), summing their durations for each day of the week
+func newTimesheetWeeks(
+	tes []dto.TimeEntry, start, end time.Time, showTasks bool,
+) []*timesheetWeek {
+	weeks := make([]*timesheetWeek, 0)
+	first, _ := timehlp.GetWeekRange(timehlp.TruncateDateWithTimezone(
+		start.In(time.Local), time.Local))
+	for ; first.Before(end); first = first.AddDate(0, 0, 7) {
+		weeks = append(weeks, &timesheetWeek{first: first})
+	}
+
+	rows := make(map[string]*timesheetRow)
+	for _, te := range tes {
+		s := te.TimeInterval.Start.In(time.Local)
+		var w *timesheetWeek
+		for _, wk := range weeks {
+			if !s.Before(wk.first) && s.Before(wk.first.AddDate(0, 0, 7)) {
+				w = wk
+				break
+			}
+		}
+
+		if w == nil {
+			continue
+		}
+
+		r := timesheetRow{projectName: "Without project"}
+		if te.Project != nil {
+			r.projectID = te.Project.ID
+			r.projectName = te.Project.Name
+		}
+
+		if showTasks && te.Task != nil {
+			r.taskID = te.Task.ID
+			r.taskName = te.Task.Name
+		}
+
+		k := w.first.Format("2006-01-02") + r.projectID + "/" + r.taskID
+		row, ok := rows[k]
+		if !ok {
+			row = &r
+			rows[k] = row
+			w.rows = append(w.rows, row)
+		}
+
+		end := time.Now()
+		if te.TimeInterval.End != nil {
+			end = *te.TimeInterval.End
+		}
+		d := end.Sub(te.TimeInterval.Start)
+
+		wd := s.Weekday()
+		row.days[wd] += d
+		row.total += d
+		w.days[wd] += d
+		w.total += d
+	}
+
+	for _, w := range weeks {
+		rs := w.rows
+		sort.SliceStable(rs, func(i, j int) bool {
+			if (rs[i].projectID == "") != (rs[j].projectID == "") {
+				return rs[j].projectID == ""
+			}
+
+			if rs[i].projectName != rs[j].projectName {
+				return strings.ToLower(rs[i].projectName) <
+					strings.ToLower(rs[j].projectName)
+			}
+
+			if (rs[i].taskID == "") != (rs[j].taskID == "") {
+				return rs[j].taskID == ""
+			}
+
+			return strings.ToLower(rs[i].taskName) <
+				strings.ToLower(rs[j].taskName)
+		})
+	}
+
+	return weeks
+}
+
+// timesheetDays returns which days of the week should be shown, working days
+// are always shown and the other ones only if there is time tracked on them
+func timesheetDays(
+	weeks []*timesheetWeek, workweek []string,
+) (days []time.Weekday, working []bool) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		isWorking := len(workweek) == 0
+		for _, w := range workweek {
+			if strings.EqualFold(w, wd.String()) {
+				isWorking = true
+				break
+			}
+		}
+
+		tracked := false
+		for _, w := range weeks {
+			if w.days[wd] != 0 {
+				tracked = true
+				break
+			}
+		}
+
+		if isWorking || tracked {
+			days = append(days, wd)
+			working = append(working, isWorking)
+		}
+	}
+
+	return days, working
+}
+
+func timesheetDuration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+
+	return durationToString(d)
+}
+
+// TimesheetPrint will print the time entries as a grid of projects (and tasks
+// if WithShowTasks is used) by days of the week, one for each week between
+// start and end. Non-working days are dimmed.
+func TimesheetPrint(
+	start, end time.Time, opts ...TimeEntryOutputOpt,
+) func([]dto.TimeEntry, io.Writer) error {
+	options := &TimeEntryOutputOptions{}
+	for _, o := range opts {
+		err := o(options)
+		if err != nil {
+			return func(te []dto.TimeEntry, w io.Writer) error { return err }
+		}
+	}
+
+	return func(tes []dto.TimeEntry, w io.Writer) error {
+		dim := []int{}
+		if util.IsTerminal(w) {
+			dim = []int{2}
+		}
+
+		weeks := newTimesheetWeeks(tes, start, end, options.ShowTasks)
+		days, working := timesheetDays(weeks, options.WorkweekDays)
+
+		labels := []string{"Project"}
+		if options.ShowTasks {
+			labels = append(labels, "Task")
+		}
+
+		for i, week := range weeks {
+			if i > 0 {
+				if _, err := io.WriteString(w, "\n"); err != nil {
+					return err
+				}
+			}
+
+			tw := tablewriter.NewWriter(w)
+			header := append([]string{}, labels...)
+			colors := make([]tablewriter.Colors, len(labels), len(labels)+8)
+			for j, wd := range days {
+				header = append(header, week.first.AddDate(0, 0, int(wd)).
+					Format("Mon 01/02"))
+				colors = append(colors, []int{})
+				if !working[j] {
+					colors[len(colors)-1] = dim
+				}
+			}
+			header = append(header, "Total")
+			colors = append(colors, []int{})
+
+			tw.SetHeader(header)
+			tw.SetAutoFormatHeaders(false)
+			tw.SetHeaderColor(colors...)
+			tw.SetColumnAlignment(timesheetAlignment(len(labels), len(days)))
+
+			for _, r := range week.rows {
+				line := []string{r.projectName}
+				if options.ShowTasks {
+					line = append(line, r.taskName)
+				}
+
+				for _, wd := range days {
+					line = append(line, timesheetDuration(r.days[wd]))
+				}
+
+				tw.Rich(append(line, durationToString(r.total)), colors)
+			}
+
+			line := make([]string, len(labels))
+			line[0] = "TOTAL"
+			for _, wd := range days {
+				line = append(line, durationToString(week.days[wd]))
+			}
+			tw.Rich(append(line, durationToString(week.total)), colors)
+
+			tw.Render()
+		}
+
+		return nil
+	}
+}
+
+func timesheetAlignment(labels, days int) []int {
+	a := make([]int, labels+days+1)
+	for i := range a {
+		a[i] = tablewriter.ALIGN_RIGHT
+		if i < labels {
+			a[i] = tablewriter.ALIGN_LEFT
+		}
+	}
+
+	return a
+}
+
+// TimesheetCSVPrint will print the time entries as CSV lines of projects (and
+// tasks if WithShowTasks is used) with the durations by days of the week, for
+// each week between start and end
+func TimesheetCSVPrint(
+	start, end time.Time, opts ...TimeEntryOutputOpt,
+) func([]dto.TimeEntry, io.Writer) error {
+	options := &TimeEntryOutputOptions{}
+	for _, o := range opts {
+		err := o(options)
+		if err != nil {
+			return func(te []dto.TimeEntry, w io.Writer) error { return err }
+		}
+	}
+
+	return func(tes []dto.TimeEntry, out io.Writer) error {
+		weeks := newTimesheetWeeks(tes, start, end, options.ShowTasks)
+		days, _ := timesheetDays(weeks, options.WorkweekDays)
+
+		w := csv.NewWriter(out)
+		header := []string{"week", "project.id", "project.name"}
+		if options.ShowTasks {
+			header = append(header, "task.id", "task.name")
+		}
+
+		for _, wd := range days {
+			header = append(header, strings.ToLower(wd.String()))
+		}
+
+		if err := w.Write(append(header, "total")); err != nil {
+			return err
+		}
+
+		for _, week := range weeks {
+			for _, r := range week.rows {
+				line := []string{
+					week.first.Format("2006-01-02"),
+					r.projectID,
+					r.projectName,
+				}
+
+				if options.ShowTasks {
+					line = append(line, r.taskID, r.taskName)
+				}
+
+				for _, wd := range days {
+					line = append(line, durationToString(r.days[wd]))
+				}
+
+				if err := w.Write(
+					append(line, durationToString(r.total))); err != nil {
+					return err
+				}
+			}
+		}
+
+		w.Flush()
+		return w.Error()
+	}
+}
@@ -0,0 +1,69 @@
+package usergroup
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// UserGroupsCSVPrint will print as CSV
+func UserGroupsCSVPrint(gs []dto.UserGroup, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{
+		"id",
+		"name",
+		"users",
+	}); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(gs); i++ {
+		if err := w.Write([]string{
+			gs[i].ID,
+			gs[i].Name,
+			strconv.Itoa(len(gs[i].UserIDs)),
+		}); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// UserGroupSummaryCSVPrint will print the user group and its members as
+// CSV, one line for each member
+func UserGroupSummaryCSVPrint(s UserGroupSummary, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{
+		"id",
+		"name",
+		"user.id",
+		"user.name",
+		"user.email",
+	}); err != nil {
+		return err
+	}
+
+	us := [][]string{{"", "", ""}}
+	if len(s.Users) > 0 {
+		us = make([][]string, len(s.Users))
+	}
+
+	for i, u := range s.Users {
+		us[i] = []string{u.ID, u.Name, u.Email}
+	}
+
+	for _, u := range us {
+		if err := w.Write(append([]string{s.ID, s.Name}, u...)); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,60 @@
+package usergroup
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/olekukonko/tablewriter"
+	"golang.org/x/term"
+)
+
+// UserGroupPrint will print more details
+func UserGroupPrint(gs []dto.UserGroup, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"ID", "Name", "Users"})
+
+	lines := make([][]string, len(gs))
+	for i := 0; i < len(gs); i++ {
+		lines[i] = []string{
+			gs[i].ID,
+			gs[i].Name,
+			strconv.Itoa(len(gs[i].UserIDs)),
+		}
+	}
+
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+		tw.SetColWidth(width / 3)
+	}
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
+
+// UserGroupSummaryPrint will print the user group and its members
+func UserGroupSummaryPrint(s UserGroupSummary, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"ID", "Name", "Users"})
+	tw.SetAutoWrapText(false)
+
+	us := make([]string, len(s.Users))
+	for i, u := range s.Users {
+		us[i] = u.ID
+		if u.Name != "" {
+			us[i] = fmt.Sprintf("%s (%s)", u.Name, u.ID)
+		}
+	}
+
+	tw.Append([]string{
+		s.ID,
+		s.Name,
+		strings.Join(us, "\n"),
+	})
+	tw.Render()
+
+	return nil
+}
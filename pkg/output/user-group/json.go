@@ -0,0 +1,19 @@
+package usergroup
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// UserGroupsJSONPrint will print as JSON
+func UserGroupsJSONPrint(gs []dto.UserGroup, w io.Writer) error {
+	return json.NewEncoder(w).Encode(gs)
+}
+
+// UserGroupSummaryJSONPrint will print the user group and its members as
+// JSON
+func UserGroupSummaryJSONPrint(s UserGroupSummary, w io.Writer) error {
+	return json.NewEncoder(w).Encode(s)
+}
@@ -0,0 +1,17 @@
+package usergroup
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// UserGroupPrintQuietly will only print the IDs
+func UserGroupPrintQuietly(gs []dto.UserGroup, w io.Writer) error {
+	for i := 0; i < len(gs); i++ {
+		fmt.Fprintln(w, gs[i].ID)
+	}
+
+	return nil
+}
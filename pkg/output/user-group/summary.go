@@ -0,0 +1,37 @@
+package usergroup
+
+import "github.com/lucassabreu/clockify-cli/api/dto"
+
+// MemberSummary is the short version of a user on a user group
+type MemberSummary struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// UserGroupSummary is a user group and its members
+type UserGroupSummary struct {
+	dto.UserGroup
+	Users []MemberSummary `json:"users"`
+}
+
+// NewUserGroupSummary creates a UserGroupSummary using the users of the
+// workspace, members not found on it will have only their ids
+func NewUserGroupSummary(g dto.UserGroup, us []dto.User) UserGroupSummary {
+	known := make(map[string]dto.User, len(us))
+	for _, u := range us {
+		known[u.ID] = u
+	}
+
+	s := UserGroupSummary{
+		UserGroup: g,
+		Users:     make([]MemberSummary, len(g.UserIDs)),
+	}
+
+	for i, id := range g.UserIDs {
+		u := known[id]
+		s.Users[i] = MemberSummary{ID: id, Name: u.Name, Email: u.Email}
+	}
+
+	return s
+}
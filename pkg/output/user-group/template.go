@@ -0,0 +1,41 @@
+package usergroup
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// UserGroupPrintWithTemplate will print each user group using the format
+// string
+func UserGroupPrintWithTemplate(
+	format string) func([]dto.UserGroup, io.Writer) error {
+	return func(gs []dto.UserGroup, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(gs); i++ {
+			if err := t.Execute(w, gs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// UserGroupSummaryPrintWithTemplate will print the user group and its
+// members using the format string
+func UserGroupSummaryPrintWithTemplate(format string) func(
+	UserGroupSummary, io.Writer) error {
+	return func(s UserGroupSummary, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		return t.Execute(w, s)
+	}
+}
@@ -0,0 +1,18 @@
+package util
+
+import (
+	"fmt"
+	"time"
+)
+
+// DurationToString formats a duration as hours, minutes and seconds (h:mm:ss)
+func DurationToString(d time.Duration) string {
+	p := ""
+	if d < 0 {
+		p = "-"
+		d = d * -1
+	}
+
+	return p + fmt.Sprintf("%d:%02d:%02d",
+		int64(d.Hours()), int64(d.Minutes())%60, int64(d.Seconds())%60)
+}
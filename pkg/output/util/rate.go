@@ -0,0 +1,21 @@
+package util
+
+import (
+	"fmt"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// RateToString formats a rate (which amount is in cents) with its currency
+func RateToString(r *dto.Rate) string {
+	if r == nil {
+		return ""
+	}
+
+	s := fmt.Sprintf("%.2f", float64(r.Amount)/100)
+	if r.Currency != "" {
+		s = s + " " + r.Currency
+	}
+
+	return s
+}
@@ -0,0 +1,22 @@
+package util
+
+import (
+	"io"
+	"os"
+)
+
+// IsTerminal returns true if the writer is a terminal, so styles and colors
+// can be used on the output
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(interface{ Stat() (os.FileInfo, error) })
+	if !ok {
+		return false
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return fi.Mode()&os.ModeCharDevice != 0
+}
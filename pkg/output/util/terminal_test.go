@@ -0,0 +1,25 @@
+package util_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTerminal(t *testing.T) {
+	assert.False(t, util.IsTerminal(bytes.NewBufferString("")))
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "out"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.False(t, util.IsTerminal(f))
+
+	_ = f.Close()
+	assert.False(t, util.IsTerminal(f), "closed files can't be checked")
+}
@@ -1,12 +1,14 @@
 package search
 
 import (
+	"strings"
+
 	"github.com/lucassabreu/clockify-cli/api"
 	"golang.org/x/sync/errgroup"
 )
 
-// GetUsersByName receives a list of id or names of clients and returns their
-// ids
+// GetUsersByName receives a list of id, names or emails of users and returns
+// their ids
 func GetUsersByName(
 	c api.Client,
 	workspace string,
@@ -33,6 +35,16 @@ func GetUsersByName(
 	for i := 0; i < len(users); i++ {
 		j := i
 		g.Go(func() error {
+			email := strings.TrimSpace(users[j])
+			if strings.Contains(email, "@") {
+				for _, u := range us {
+					if strings.EqualFold(u.Email, email) {
+						users[j] = u.ID
+						return nil
+					}
+				}
+			}
+
 			id, err := findByName(
 				users[j], "user",
 				func() ([]named, error) { return ns, nil },
@@ -48,3 +60,62 @@ func GetUsersByName(
 
 	return users, g.Wait()
 }
+
+// GetUserGroupsByName receives a list of id or names of user groups and
+// returns their ids
+func GetUserGroupsByName(
+	c api.Client,
+	workspace string,
+	groups []string,
+) ([]string, error) {
+	if len(groups) == 0 {
+		return groups, nil
+	}
+
+	gs, err := c.GetUserGroups(api.GetUserGroupsParam{
+		Workspace:       workspace,
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return groups, err
+	}
+
+	ns := make([]named, len(gs))
+	for i := 0; i < len(ns); i++ {
+		ns[i] = gs[i]
+	}
+
+	var g errgroup.Group
+	for i := 0; i < len(groups); i++ {
+		j := i
+		g.Go(func() error {
+			id, err := findByName(
+				groups[j],
+				"user group", func() ([]named, error) { return ns, nil },
+			)
+			if err != nil {
+				return err
+			}
+
+			groups[j] = id
+			return nil
+		})
+	}
+
+	return groups, g.Wait()
+}
+
+// GetUserGroupByName will look for a user group that the id or name
+// Contains the string on group parameter
+func GetUserGroupByName(
+	c api.Client,
+	workspace string,
+	group string,
+) (string, error) {
+	gs, err := GetUserGroupsByName(c, workspace, []string{group})
+	if err != nil {
+		return group, err
+	}
+
+	return gs[0], nil
+}
@@ -0,0 +1,170 @@
+package timeentryhlp
+
+import (
+	"math"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// Rates finds the billable and cost rates applied to time entries following
+// the same hierarchy of Clockify, from the least to the most specific:
+// workspace, workspace member, project, project member and task
+type Rates struct {
+	workspace dto.Workspace
+	members   map[string]dto.Membership
+	projects  map[string]dto.Project
+}
+
+// NewRates creates a Rates for the workspace and projects informed
+func NewRates(w dto.Workspace, ps []dto.Project) Rates {
+	r := Rates{
+		workspace: w,
+		members:   make(map[string]dto.Membership, len(w.Memberships)),
+		projects:  make(map[string]dto.Project, len(ps)),
+	}
+
+	for _, m := range w.Memberships {
+		r.members[m.UserID] = m
+	}
+
+	for _, p := range ps {
+		r.projects[p.ID] = p
+	}
+
+	return r
+}
+
+// rateSelector reads one kind of rate (billable or cost) from each level of
+// the hierarchy, nil means the level does not set it
+type rateSelector struct {
+	workspace  func(dto.Workspace) *dto.Rate
+	membership func(dto.Membership) *dto.Rate
+	project    func(dto.Project) *dto.Rate
+	task       func(dto.Task) *dto.Rate
+}
+
+var hourlyRateSelector = rateSelector{
+	workspace:  func(w dto.Workspace) *dto.Rate { return &w.HourlyRate },
+	membership: func(m dto.Membership) *dto.Rate { return m.HourlyRate },
+	project:    func(p dto.Project) *dto.Rate { return &p.HourlyRate },
+	task:       func(t dto.Task) *dto.Rate { return t.HourlyRate },
+}
+
+var costRateSelector = rateSelector{
+	workspace:  func(w dto.Workspace) *dto.Rate { return w.CostRate },
+	membership: func(m dto.Membership) *dto.Rate { return m.CostRate },
+	project:    func(p dto.Project) *dto.Rate { return p.CostRate },
+	task:       func(t dto.Task) *dto.Rate { return t.CostRate },
+}
+
+// HourlyRate returns the billable rate applied to a time entry, userID is
+// used when the time entry does not have its user
+func (r Rates) HourlyRate(te dto.TimeEntry, userID string) dto.Rate {
+	return r.rate(te, userID, hourlyRateSelector)
+}
+
+// CostRate returns the cost rate applied to a time entry, userID is used when
+// the time entry does not have its user
+func (r Rates) CostRate(te dto.TimeEntry, userID string) dto.Rate {
+	return r.rate(te, userID, costRateSelector)
+}
+
+// rate finds the most specific rate set for the time entry, projects and
+// tasks with a zero amount fallback to the previous levels
+func (r Rates) rate(
+	te dto.TimeEntry, userID string, s rateSelector) dto.Rate {
+	if te.User != nil {
+		userID = te.User.ID
+	}
+
+	rate := dto.Rate{}
+	if wr := s.workspace(r.workspace); wr != nil {
+		rate = *wr
+	}
+
+	if m, ok := r.members[userID]; ok {
+		if mr := s.membership(m); mr != nil {
+			rate = *mr
+		}
+	}
+
+	projectID := te.ProjectID
+	if te.Project != nil {
+		projectID = te.Project.ID
+	}
+
+	if p, ok := r.projects[projectID]; ok {
+		if pr := s.project(p); pr != nil && pr.Amount != 0 {
+			rate = *pr
+		}
+
+		for _, m := range p.Memberships {
+			if mr := s.membership(m); m.UserID == userID && mr != nil {
+				rate = *mr
+				break
+			}
+		}
+	}
+
+	if te.Task != nil {
+		if tr := s.task(*te.Task); tr != nil && tr.Amount != 0 {
+			rate = *tr
+		}
+	}
+
+	if rate.Currency == "" {
+		rate.Currency = r.workspace.HourlyRate.Currency
+	}
+
+	return rate
+}
+
+// WithAmounts returns the time entries with their HourlyRate and
+// TotalBillable (the billable amount) set using the rates, non-billable time
+// entries have no amount
+func (r Rates) WithAmounts(
+	tes []dto.TimeEntry, userID string,
+) []dto.TimeEntry {
+	l := make([]dto.TimeEntry, len(tes))
+	for i, te := range tes {
+		te.HourlyRate = r.HourlyRate(te, userID)
+		te.TotalBillable = 0
+		if te.Billable {
+			te.TotalBillable = amount(te, te.HourlyRate)
+		}
+
+		l[i] = te
+	}
+
+	return l
+}
+
+// WithCosts returns the time entries with their CostRate and TotalCost (the
+// labour cost) set using the rates, every time entry has a cost, billable or
+// not
+func (r Rates) WithCosts(
+	tes []dto.TimeEntry, userID string,
+) []dto.TimeEntry {
+	l := make([]dto.TimeEntry, len(tes))
+	for i, te := range tes {
+		c := r.CostRate(te, userID)
+		te.CostRate = &c
+		te.TotalCost = amount(te, c)
+
+		l[i] = te
+	}
+
+	return l
+}
+
+// amount calculates how much is the time entry worth for the rate, in cents
+func amount(te dto.TimeEntry, r dto.Rate) int64 {
+	end := time.Now()
+	if te.TimeInterval.End != nil {
+		end = *te.TimeInterval.End
+	}
+
+	return int64(math.Round(
+		float64(r.Amount) * end.Sub(te.TimeInterval.Start).Hours()))
+}
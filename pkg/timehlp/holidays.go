@@ -0,0 +1,118 @@
+package timehlp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+const dateFormat = "2006-01-02"
+
+// Holidays are the days that are not expected to be worked, keyed by their
+// date (2006-01-02) with a description of why
+type Holidays map[string]string
+
+// Get returns the description of the holiday on the date, if there is one
+func (h Holidays) Get(t time.Time) (string, bool) {
+	d, ok := h[t.Format(dateFormat)]
+	return d, ok
+}
+
+// ReadHolidaysFile reads the holidays from a calendar file, see ReadHolidays
+// for its format
+func ReadHolidaysFile(path string) (Holidays, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadHolidays(f)
+}
+
+// ReadHolidays reads a calendar where each line is a date or a range of dates
+// followed by a optional description, empty lines and lines starting with
+// "#" are ignored. Like:
+//
+//	# holidays
+//	2022-12-25 Christmas
+//	2023-01-02..2023-01-06 vacations
+func ReadHolidays(r io.Reader) (Holidays, error) {
+	h := make(Holidays)
+	s := bufio.NewScanner(r)
+	for l := 1; s.Scan(); l++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		date := strings.Fields(line)[0]
+		desc := "day off"
+		if d := strings.TrimSpace(line[len(date):]); d != "" {
+			desc = d
+		}
+
+		first, last, err := parseDateRange(date)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"line %d of the calendar is not valid: %w", l, err)
+		}
+
+		for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+			h[d.Format(dateFormat)] = desc
+		}
+	}
+
+	return h, s.Err()
+}
+
+func parseDateRange(s string) (first, last time.Time, err error) {
+	parts := strings.SplitN(s, "..", 2)
+	if first, err = time.Parse(dateFormat, parts[0]); err != nil {
+		return
+	}
+
+	last = first
+	if len(parts) == 2 {
+		if last, err = time.Parse(dateFormat, parts[1]); err != nil {
+			return
+		}
+	}
+
+	if last.Before(first) {
+		err = fmt.Errorf("range %s ends before it starts", s)
+	}
+
+	return
+}
+
+// ParseHours reads a amount of hours as a number (like "8" or "7.5") or as a
+// duration (like "7h30m")
+func ParseHours(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if h, err := strconv.ParseFloat(s, 64); err == nil {
+		if h < 0 {
+			return 0, fmt.Errorf("%s is not a valid amount of hours", s)
+		}
+
+		return time.Duration(h * float64(time.Hour)), nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("%s is not a valid amount of hours", s)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,77 @@
+package timehlp_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadHolidays(t *testing.T) {
+	h, err := timehlp.ReadHolidays(strings.NewReader(heredoc.Doc(`
+		# holidays
+		2022-12-25 Christmas
+
+		2023-01-02..2023-01-04   vacations
+		2023-02-01
+		2023-04-07	Good Friday
+		2023-04-21    Tiradentes  day
+	`)))
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, timehlp.Holidays{
+		"2022-12-25": "Christmas",
+		"2023-01-02": "vacations",
+		"2023-01-03": "vacations",
+		"2023-01-04": "vacations",
+		"2023-02-01": "day off",
+		"2023-04-07": "Good Friday",
+		"2023-04-21": "Tiradentes  day",
+	}, h)
+
+	d, ok := h.Get(time.Date(2023, 1, 3, 0, 0, 0, 0, time.Local))
+	assert.True(t, ok)
+	assert.Equal(t, "vacations", d)
+
+	_, ok = h.Get(time.Date(2023, 1, 5, 0, 0, 0, 0, time.Local))
+	assert.False(t, ok)
+}
+
+func TestReadHolidaysInvalid(t *testing.T) {
+	for _, c := range []string{
+		"christmas",
+		"2022-13-25",
+		"2022-12-31..2022-12-01",
+		"2022-12-25..tomorrow",
+	} {
+		_, err := timehlp.ReadHolidays(strings.NewReader("# ok\n" + c))
+		if assert.Error(t, err, c) {
+			assert.Regexp(t, "line 2 of the calendar is not valid", err.Error())
+		}
+	}
+}
+
+func TestParseHours(t *testing.T) {
+	for s, d := range map[string]time.Duration{
+		"8":     8 * time.Hour,
+		"7.5":   7*time.Hour + 30*time.Minute,
+		"6h30m": 6*time.Hour + 30*time.Minute,
+		" 0 ":   0,
+	} {
+		h, err := timehlp.ParseHours(s)
+		if assert.NoError(t, err, s) {
+			assert.Equal(t, d, h, s)
+		}
+	}
+
+	for _, s := range []string{"eight", "-1", "-2h", ""} {
+		_, err := timehlp.ParseHours(s)
+		assert.Error(t, err, s)
+	}
+}
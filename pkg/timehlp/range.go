@@ -13,7 +13,7 @@ func GetMonthRange(ref time.Time) (first, last time.Time) {
 // GetWeekRange given a time it returns the first and last date of a week
 func GetWeekRange(ref time.Time) (first, last time.Time) {
 	first = ref.AddDate(0, 0, int(ref.Weekday())*-1)
-	last = first.AddDate(0, 0, 7)
+	last = first.AddDate(0, 0, 6)
 
 	return
 }
@@ -0,0 +1,42 @@
+package timehlp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWeekRange(t *testing.T) {
+	d := func(day int) time.Time {
+		return time.Date(2022, 12, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tts := []struct {
+		name  string
+		ref   time.Time
+		first time.Time
+		last  time.Time
+	}{
+		{name: "sunday", ref: d(11), first: d(11), last: d(17)},
+		{name: "wednesday", ref: d(14), first: d(11), last: d(17)},
+		{name: "saturday", ref: d(17), first: d(11), last: d(17)},
+		{name: "next sunday", ref: d(18), first: d(18), last: d(24)},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			first, last := timehlp.GetWeekRange(tt.ref)
+			assert.Equal(t, tt.first, first)
+			assert.Equal(t, tt.last, last)
+		})
+	}
+}
+
+func TestGetMonthRange(t *testing.T) {
+	first, last := timehlp.GetMonthRange(
+		time.Date(2023, 2, 14, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), first)
+	assert.Equal(t, time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC), last)
+}